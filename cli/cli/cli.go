@@ -58,6 +58,7 @@ type DingoAdm struct {
 	tempDir   string
 	logpath   string
 	config    *configure.DingoAdmConfig
+	timeout   int // timeout of current playbook step, overrides the configured one
 
 	// data pipeline
 	in         io.Reader
@@ -152,8 +153,16 @@ func (dingoadm *DingoAdm) init() error {
 	if err != nil {
 		log.Error("Init SQLite database failed",
 			log.Field("Error", err))
+		if errors.Is(err, storage.ErrSchemaTooNew) {
+			return errno.ERR_DATABASE_SCHEMA_TOO_NEW.E(err)
+		}
 		return errno.ERR_INIT_SQL_DATABASE_FAILED.E(err)
 	}
+	for _, m := range s.Migrated() {
+		log.Info("Apply database migration success",
+			log.Field("Version", m.Version),
+			log.Field("Description", m.Description))
+	}
 
 	// (6) Get hosts
 	var hosts storage.Hosts
@@ -326,6 +335,22 @@ func (dingoadm *DingoAdm) GetHost(host string) (*hosts.HostConfig, error) {
 		F("host: %s", host)
 }
 
+// GetHostLabels returns labels of all hosts (key: host, value: labels)
+func (dingoadm *DingoAdm) GetHostLabels() (map[string][]string, error) {
+	m := map[string][]string{}
+	if len(dingoadm.Hosts()) == 0 {
+		return m, nil
+	}
+	hcs, err := hosts.ParseHosts(dingoadm.Hosts())
+	if err != nil {
+		return nil, err
+	}
+	for _, hc := range hcs {
+		m[hc.GetHost()] = hc.GetLabels()
+	}
+	return m, nil
+}
+
 func (dingoadm *DingoAdm) ParseTopologyData(data string) ([]*topology.DeployConfig, error) {
 	ctx := topology.NewContext()
 	hcs, err := hosts.ParseHosts(dingoadm.Hosts())
@@ -354,6 +379,7 @@ func (dingoadm *DingoAdm) ParseTopology() ([]*topology.DeployConfig, error) {
 
 func (dingoadm *DingoAdm) FilterDeployConfig(deployConfigs []*topology.DeployConfig,
 	options topology.FilterOption) []*topology.DeployConfig {
+	selected := dingoadm.selectHostsByLabels(options.Labels)
 	dcs := []*topology.DeployConfig{}
 	for _, dc := range deployConfigs {
 		dcId := dc.GetId()
@@ -362,7 +388,8 @@ func (dingoadm *DingoAdm) FilterDeployConfig(deployConfigs []*topology.DeployCon
 		serviceId := dingoadm.GetServiceId(dcId)
 		if (options.Id == "*" || options.Id == serviceId) &&
 			(options.Role == "*" || options.Role == role) &&
-			(options.Host == "*" || options.Host == host) {
+			(options.Host == "*" || options.Host == host) &&
+			(selected == nil || selected[host]) {
 			dcs = append(dcs, dc)
 		}
 	}
@@ -370,6 +397,26 @@ func (dingoadm *DingoAdm) FilterDeployConfig(deployConfigs []*topology.DeployCon
 	return dcs
 }
 
+// selectHostsByLabels returns hosts which matched the labels, which
+// shares the same pattern with 'hosts ls -l', nil means all hosts are selected.
+// NOTE: command filters services by labels must validate them by CheckLabels
+// first, which returns the error of parsing hosts instead of matching nothing
+func (dingoadm *DingoAdm) selectHostsByLabels(labels string) map[string]bool {
+	if len(labels) == 0 || labels == hosts.SELECTOR_ALL {
+		return nil
+	}
+
+	selected := map[string]bool{}
+	hcs, err := hosts.ParseHosts(dingoadm.Hosts())
+	if err != nil { // never happen after CheckLabels passed
+		return selected
+	}
+	for _, hc := range hosts.FilterByLabels(hcs, hosts.SplitLabels(labels)) {
+		selected[hc.GetHost()] = true
+	}
+	return selected
+}
+
 func (dingoadm *DingoAdm) FilterDeployConfigByGateway(deployConfigs []*topology.DeployConfig,
 	options topology.FilterOption) *topology.DeployConfig {
 	for _, dc := range deployConfigs {
@@ -421,12 +468,21 @@ func (dingoadm *DingoAdm) GetFilesystemId(host, mountPoint string) string {
 	return utils.MD5Sum(filesystemId)[:12]
 }
 
+// SetExecTimeout sets timeout of commands executed by the following tasks, 0 means the configured one
+func (dingoadm *DingoAdm) SetExecTimeout(timeout int) {
+	dingoadm.timeout = timeout
+}
+
 func (dingoadm *DingoAdm) ExecOptions() module.ExecOptions {
+	timeout := dingoadm.config.GetTimeout()
+	if dingoadm.timeout > 0 {
+		timeout = dingoadm.timeout
+	}
 	return module.ExecOptions{
 		ExecWithSudo:   true,
 		ExecInLocal:    false,
 		ExecSudoAlias:  dingoadm.config.GetSudoAlias(),
-		ExecTimeoutSec: dingoadm.config.GetTimeout(),
+		ExecTimeoutSec: timeout,
 		ExecWithEngine: dingoadm.config.GetEngine(),
 	}
 }
@@ -483,6 +539,23 @@ func (dingoadm *DingoAdm) CheckHost(host string) error {
 	return err
 }
 
+func (dingoadm *DingoAdm) CheckLabels(labels string) error {
+	items := hosts.SplitLabels(labels)
+	if err := hosts.ValidateLabels(items); err != nil {
+		return err
+	} else if len(dingoadm.Hosts()) == 0 {
+		return errno.ERR_NO_HOSTS_MATCHED.F("labels: %s", labels)
+	}
+
+	hcs, err := hosts.ParseHosts(dingoadm.Hosts())
+	if err != nil {
+		return err
+	} else if len(hosts.FilterByLabels(hcs, items)) == 0 {
+		return errno.ERR_NO_HOSTS_MATCHED.F("labels: %s", labels)
+	}
+	return nil
+}
+
 // writer for cobra command error
 func (dingoadm *DingoAdm) Write(p []byte) (int, error) {
 	// trim prefix which generate by cobra
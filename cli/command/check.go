@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	"github.com/dingodb/dingoadm/internal/task/task/checker"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	"github.com/dingodb/dingoadm/internal/tui"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CHECK_EXAMPLE = `Examples:
+  $ dingoadm check                 # Check health of whole cluster
+  $ dingoadm check --role store    # Check health of store services and cluster
+  $ dingoadm doctor --json         # Check health and output findings in JSON format`
+
+	// clock skew which is lower than the precheck limit but worth attention
+	CLOCK_SKEW_WARNING = 5
+)
+
+type checkOptions struct {
+	role   string
+	host   string
+	labels string
+	json   bool
+}
+
+func NewCheckCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options checkOptions
+
+	cmd := &cobra.Command{
+		Use:     "check [OPTIONS]",
+		Aliases: []string{"doctor"},
+		Short:   "Check cluster health and report findings",
+		Args:    cliutil.NoArgs,
+		Example: CHECK_EXAMPLE,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return checkCommonOptions(dingoadm, "*", options.role, options.host, options.labels)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCheck(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.role, "role", "*", "Specify service role")
+	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
+	flags.BoolVar(&options.json, "json", false, "Output findings in JSON format")
+
+	return cmd
+}
+
+func genCheckPlaybook(dingoadm *cli.DingoAdm,
+	dcs []*topology.DeployConfig,
+	options checkOptions) (*playbook.Playbook, error) {
+	selected := dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     "*",
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
+	})
+	if len(selected) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED
+	}
+
+	pb := playbook.NewPlaybook(dingoadm)
+	addStep := func(step int, configs []*topology.DeployConfig) {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: configs,
+			Options: map[string]interface{}{
+				comm.KEY_ALL_DEPLOY_CONFIGS: dcs,
+			},
+			ExecOptions: playbook.ExecOptions{
+				SilentSubBar:  true,
+				SilentMainBar: options.json,
+				SkipError:     true,
+			},
+		})
+	}
+	addStep(playbook.GET_HOST_DATE, dcs)
+	addStep(playbook.PROBE_SERVICE_HEALTH, selected)
+	// cluster level probes run in every coordinator, the first answered one is used
+	coordinators := dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_COORDINATOR)
+	if len(coordinators) > 0 {
+		addStep(playbook.PROBE_CLUSTER_HEALTH, coordinators)
+	}
+	pb.ContinueOnError()
+	return pb, nil
+}
+
+// getHealthFindings collects findings of all probes, the probe which not finished
+// (e.g. SSH connect failed) is regarded as a critical finding
+func getHealthFindings(dingoadm *cli.DingoAdm, dcs []*topology.DeployConfig, options checkOptions) []task.HealthFinding {
+	m := map[string][]task.HealthFinding{}
+	v := dingoadm.MemStorage().Get(comm.KEY_ALL_HEALTH_FINDINGS)
+	if v != nil {
+		m = v.(map[string][]task.HealthFinding)
+	}
+
+	findings := []task.HealthFinding{}
+	selected := dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     "*",
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
+	})
+	for _, dc := range selected {
+		serviceId := dingoadm.GetServiceId(dc.GetId())
+		if dingoadm.IsSkip(dc) {
+			continue
+		} else if _, ok := m[serviceId]; !ok {
+			findings = append(findings, task.HealthFinding{
+				Level:      task.HEALTH_LEVEL_CRITICAL,
+				Item:       task.HEALTH_ITEM_PROBE,
+				Id:         serviceId,
+				Role:       dc.GetRole(),
+				Host:       dc.GetHost(),
+				Message:    "probe service failed",
+				Suggestion: fmt.Sprintf("dingoadm ssh %s", dc.GetHost()),
+			})
+			continue
+		}
+		findings = append(findings, m[serviceId]...)
+	}
+
+	coordinators := dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_COORDINATOR)
+	answered := false
+	for _, dc := range coordinators {
+		if cluster, ok := m[task.ClusterProbeId(dingoadm.GetServiceId(dc.GetId()))]; ok {
+			findings = append(findings, cluster...)
+			answered = true
+			break
+		}
+	}
+	if !answered && len(coordinators) > 0 {
+		findings = append(findings, task.HealthFinding{
+			Level:      task.HEALTH_LEVEL_CRITICAL,
+			Item:       task.HEALTH_ITEM_PROBE,
+			Id:         task.HEALTH_PROBE_CLUSTER,
+			Role:       topology.ROLE_COORDINATOR,
+			Host:       coordinators[0].GetHost(),
+			Message:    fmt.Sprintf("probe cluster failed in all %d coordinators", len(coordinators)),
+			Suggestion: "dingoadm status --role coordinator",
+		})
+	}
+
+	// clock skew across hosts
+	diff, maxT, minT := checker.GetHostTimeDifference(dingoadm)
+	if diff > CLOCK_SKEW_WARNING {
+		level := task.HEALTH_LEVEL_WARNING
+		if diff > checker.MAX_TIME_DIFFERENCE {
+			level = task.HEALTH_LEVEL_CRITICAL
+		}
+		findings = append(findings, task.HealthFinding{
+			Level:      level,
+			Item:       task.HEALTH_ITEM_CLOCK,
+			Id:         task.HEALTH_PROBE_CLUSTER,
+			Role:       "-",
+			Host:       maxT.Host(),
+			Message:    fmt.Sprintf("clock of %s is %d seconds ahead of %s", maxT.Host(), diff, minT.Host()),
+			Suggestion: fmt.Sprintf("dingoadm hosts facts --refresh --host %s -v", maxT.Host()),
+		})
+	}
+	return findings
+}
+
+func runCheck(dingoadm *cli.DingoAdm, options checkOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) generate check playbook
+	pb, err := genCheckPlaybook(dingoadm, dcs, options)
+	if err != nil {
+		return err
+	}
+
+	// 3) run playbook, the failed probe is reported as finding
+	pb.Run()
+
+	// 4) display findings
+	findings := getHealthFindings(dingoadm, dcs, options)
+	ncritical := 0
+	for _, finding := range findings {
+		if finding.Level == task.HEALTH_LEVEL_CRITICAL {
+			ncritical++
+		}
+	}
+	if options.json {
+		bytes, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return err
+		}
+		dingoadm.WriteOutln(string(bytes))
+	} else if len(findings) == 0 {
+		dingoadm.WriteOutln("")
+		dingoadm.WriteOutln(color.GreenString("No findings, cluster is healthy :)"))
+	} else {
+		dingoadm.WriteOutln("")
+		dingoadm.WriteOut(tui.FormatHealthFindings(findings))
+		dingoadm.WriteOutln("")
+		dingoadm.WriteOutln("%d critical, %d warning", ncritical, len(findings)-ncritical)
+	}
+
+	// 5) exit with non-zero code if any critical finding
+	if ncritical > 0 {
+		return errno.ERR_CRITICAL_HEALTH_FINDINGS.F("%d critical findings", ncritical)
+	}
+	return nil
+}
@@ -63,12 +63,14 @@ var (
 )
 
 type cleanOptions struct {
-	id             string
-	role           string
-	host           string
-	only           []string
-	withoutRecycle bool
-	force          bool
+	id              string
+	role            string
+	host            string
+	labels          string
+	only            []string
+	withoutRecycle  bool
+	force           bool
+	continueOnError bool
 }
 
 func checkCleanOptions(dingoadm *cli.DingoAdm, options cleanOptions) error {
@@ -79,7 +81,7 @@ func checkCleanOptions(dingoadm *cli.DingoAdm, options cleanOptions) error {
 				F("clean item: %s", item)
 		}
 	}
-	return checkCommonOptions(dingoadm, options.id, options.role, options.host)
+	return checkCommonOptions(dingoadm, options.id, options.role, options.host, options.labels)
 }
 
 func NewCleanCommand(dingoadm *cli.DingoAdm) *cobra.Command {
@@ -103,9 +105,11 @@ func NewCleanCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify service id")
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
 	flags.StringSliceVarP(&options.only, "only", "o", CLEAN_ITEMS, "Specify clean item")
 	flags.BoolVar(&options.withoutRecycle, "no-recycle", false, "Remove data directory directly instead of recycle chunks")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+	flags.BoolVar(&options.continueOnError, "continue-on-error", false, "Go on with the remaining tasks if some tasks failed")
 
 	return cmd
 }
@@ -114,9 +118,10 @@ func genCleanPlaybook(dingoadm *cli.DingoAdm,
 	dcs []*topology.DeployConfig,
 	options cleanOptions) (*playbook.Playbook, error) {
 	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
-		Id:   options.id,
-		Role: options.role,
-		Host: options.host,
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
 	})
 	if len(dcs) == 0 {
 		return nil, errno.ERR_NO_SERVICES_MATCHED
@@ -187,19 +192,20 @@ func runClean(dingoadm *cli.DingoAdm, options cleanOptions) error {
 	if err != nil {
 		return err
 	}
+	warnMaintenance(dingoadm, dcs, options.id, options.role, options.host, options.labels)
 
 	// 3) confirm by user
 	// 3) force stop
 	if options.force {
 		dingoadm.WriteOutln(tui.PromptForceOpetation("clean service"))
-		return pb.Run()
+		return runPlaybook(dingoadm, pb, options.continueOnError)
 	}
 
-	if pass := tui.ConfirmYes(tui.PromptCleanService(options.role, options.host, options.only)); !pass {
+	if pass := tui.ConfirmYes(tui.PromptCleanService(options.role, options.host, options.labels, options.only)); !pass {
 		dingoadm.WriteOut(tui.PromptCancelOpetation("clean service"))
 		return errno.ERR_CANCEL_OPERATION
 	}
 
 	// 4) run playground
-	return pb.Run()
+	return runPlaybook(dingoadm, pb, options.continueOnError)
 }
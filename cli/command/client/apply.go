@@ -0,0 +1,274 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package client
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	"github.com/dingodb/dingoadm/internal/storage"
+	"github.com/dingodb/dingoadm/internal/task/task/fs"
+	tui "github.com/dingodb/dingoadm/internal/tui/client"
+	tuicomm "github.com/dingodb/dingoadm/internal/tui/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	APPLY_EXAMPLE = `Examples:
+  $ dingoadm client apply -f clients.yaml            # Mount, umount or remount clients as declared in clients.yaml
+  $ dingoadm client apply -f clients.yaml --dry-run  # Only show what would be changed
+  $ dingoadm client apply -f clients.yaml --prune    # Also umount clients which not in clients.yaml on its hosts and fsnames`
+)
+
+type applyOptions struct {
+	filename      string
+	dryRun        bool
+	force         bool
+	prune         bool
+	insecure      bool
+	useLocalImage bool
+	newDingo      bool
+}
+
+func NewApplyCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options applyOptions
+
+	cmd := &cobra.Command{
+		Use:     "apply -f FILE [OPTIONS]",
+		Short:   "Apply client inventory to mount, umount or remount clients",
+		Args:    cliutil.NoArgs,
+		Example: APPLY_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runApply(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.filename, "file", "f", "clients.yaml", "Specify client inventory file")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "Show changes without applying them")
+	flags.BoolVar(&options.force, "force", false, "Never prompt")
+	flags.BoolVar(&options.prune, "prune", false, "Umount clients which not in inventory on its hosts and fsnames")
+	flags.BoolVarP(&options.insecure, "insecure", "k", false, "Mount without precheck")
+	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image to mount")
+	flags.BoolVar(&options.newDingo, "new-dingo", true, "support create rados type fs")
+
+	return cmd
+}
+
+func getStoredClientConfigs(dingoadm *cli.DingoAdm, clients []storage.Client) (map[string]string, error) {
+	configs := map[string]string{}
+	for _, client := range clients {
+		items, err := dingoadm.Storage().GetClientConfig(client.Id)
+		if err != nil {
+			return nil, errno.ERR_SELECT_CLIENT_CONFIG_FAILED.E(err)
+		} else if len(items) > 0 {
+			configs[client.Id] = items[0].Data
+		}
+	}
+	return configs, nil
+}
+
+func diffClients(dingoadm *cli.DingoAdm,
+	mounts []configure.ClientMount,
+	options applyOptions) ([]fs.ClientChange, error) {
+	clients, err := getClients(dingoadm, "")
+	if err != nil {
+		return nil, err
+	}
+	configs, err := getStoredClientConfigs(dingoadm, clients)
+	if err != nil {
+		return nil, err
+	}
+	return fs.DiffClients(mounts, clients, configs, options.prune), nil
+}
+
+func genApplyPlaybook(dingoadm *cli.DingoAdm,
+	changes []fs.ClientChange,
+	options applyOptions) *playbook.Playbook {
+	// remount is umount followed by mount
+	umounts, mounts := []interface{}{}, []interface{}{}
+	for _, change := range changes {
+		switch change.Action {
+		case fs.CLIENT_ACTION_UMOUNT:
+			umounts = append(umounts, change.MountTarget())
+		case fs.CLIENT_ACTION_MOUNT:
+			mounts = append(mounts, change.MountTarget())
+		case fs.CLIENT_ACTION_REMOUNT:
+			umounts = append(umounts, change.MountTarget())
+			mounts = append(mounts, change.MountTarget())
+		}
+	}
+
+	pb := playbook.NewPlaybook(dingoadm)
+	if !options.insecure {
+		addApplyPrecheckSteps(pb, changes)
+	}
+	steps := map[int][]interface{}{
+		playbook.UMOUNT_TARGET: umounts,
+		playbook.MOUNT_TARGET:  mounts,
+	}
+	for _, step := range []int{playbook.UMOUNT_TARGET, playbook.MOUNT_TARGET} {
+		configs := steps[step]
+		if len(configs) == 0 {
+			continue
+		}
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: configs,
+			Options: map[string]interface{}{
+				comm.KEY_USE_LOCAL_IMAGE: options.useLocalImage,
+				comm.KEY_USE_NEW_DINGO:   options.newDingo,
+			},
+			ExecOptions: playbook.ExecOptions{
+				SkipError: true,
+			},
+		})
+	}
+	return pb
+}
+
+// addApplyPrecheckSteps adds the same prechecks as `client mount` for clients
+// which will be mounted, the kernel module is checked once for each host
+func addApplyPrecheckSteps(pb *playbook.Playbook, changes []fs.ClientChange) {
+	hosts := map[string]bool{}
+	s3ccs := []*configure.ClientConfig{}
+	for _, change := range changes {
+		if change.Action != fs.CLIENT_ACTION_MOUNT &&
+			change.Action != fs.CLIENT_ACTION_REMOUNT {
+			continue
+		}
+		if !hosts[change.Host] {
+			hosts[change.Host] = true
+			pb.AddStep(&playbook.PlaybookStep{
+				Type:    playbook.CHECK_KERNEL_MODULE,
+				Configs: []*configure.ClientConfig{change.Config},
+				Options: map[string]interface{}{
+					comm.KEY_CLIENT_HOST:              change.Host,
+					comm.KEY_CHECK_KERNEL_MODULE_NAME: comm.KERNERL_MODULE_FUSE,
+				},
+			})
+		}
+		if change.Config.GetStorageType() != configure.STORAGE_TYPE_RADOS {
+			s3ccs = append(s3ccs, change.Config)
+		}
+	}
+
+	if len(s3ccs) > 0 {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.CHECK_CLIENT_S3,
+			Configs: s3ccs,
+			ExecOptions: playbook.ExecOptions{
+				SilentSubBar: true,
+			},
+		})
+	}
+}
+
+// applyResults compares changes with remaining changes after applied,
+// the change is failed if its mount point still not converged
+func applyResults(changes, remains []fs.ClientChange) map[string]string {
+	pending := map[string]bool{}
+	for _, change := range remains {
+		if change.Action != fs.CLIENT_ACTION_KEEP {
+			pending[change.Key()] = true
+		}
+	}
+
+	results := map[string]string{}
+	for _, change := range changes {
+		if change.Action == fs.CLIENT_ACTION_KEEP {
+			continue
+		} else if pending[change.Key()] {
+			results[change.Key()] = tui.CLIENT_APPLY_RESULT_FAILED
+		} else {
+			results[change.Key()] = tui.CLIENT_APPLY_RESULT_DONE
+		}
+	}
+	return results
+}
+
+func runApply(dingoadm *cli.DingoAdm, options applyOptions) error {
+	// 1) parse client inventory
+	inventory, err := configure.ParseClientInventory(options.filename)
+	if err != nil {
+		return err
+	}
+	hostLabels, err := dingoadm.GetHostLabels()
+	if err != nil {
+		return err
+	}
+	mounts, err := inventory.Resolve(hostLabels)
+	if err != nil {
+		return err
+	}
+
+	// 2) diff inventory with clients
+	changes, err := diffClients(dingoadm, mounts, options)
+	if err != nil {
+		return err
+	}
+	count := map[string]int{}
+	for _, change := range changes {
+		count[change.Action]++
+	}
+	dingoadm.WriteOut("%s", tui.FormatClientChanges(changes, nil))
+	if count[fs.CLIENT_ACTION_KEEP] == len(changes) {
+		dingoadm.WriteOutln("")
+		dingoadm.WriteOutln("All clients are up to date, nothing to apply")
+		return nil
+	} else if options.dryRun {
+		return nil
+	}
+
+	// 3) confirm by user
+	if !options.force {
+		prompt := tuicomm.PromptApplyClients(count[fs.CLIENT_ACTION_MOUNT],
+			count[fs.CLIENT_ACTION_UMOUNT], count[fs.CLIENT_ACTION_REMOUNT])
+		if pass := tuicomm.ConfirmYes(prompt); !pass {
+			dingoadm.WriteOut(tuicomm.PromptCancelOpetation("apply clients"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 4) umount and mount clients in parallel
+	pb := genApplyPlaybook(dingoadm, changes, options)
+	err = pb.Run()
+
+	// 5) display apply result
+	remains, rerr := diffClients(dingoadm, mounts, options)
+	if rerr != nil {
+		return rerr
+	}
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOut("%s", tui.FormatClientChanges(changes, applyResults(changes, remains)))
+	if err != nil {
+		return err
+	}
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOutln(color.GreenString("Apply client inventory %s success ^_^"), options.filename)
+	return nil
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package client
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	tui "github.com/dingodb/dingoadm/internal/tui/client"
+	tuicomm "github.com/dingodb/dingoadm/internal/tui/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHE_STATUS_EXAMPLE = `Examples:
+  $ dingoadm client cache status                   # Display cache status of all clients
+  $ dingoadm client cache status 5f6a7b8c9d0e      # Display cache status of the specified client
+  $ dingoadm client cache status --host machine1   # Display cache status of all clients on 'machine1'`
+
+	CACHE_CLEAN_EXAMPLE = `Examples:
+  $ dingoadm client cache clean 5f6a7b8c9d0e       # Clean cache of the specified stopped client
+  $ dingoadm client cache clean --host machine1    # Clean cache of all stopped clients on 'machine1'`
+
+	CACHE_WARMUP_EXAMPLE = `Examples:
+  $ dingoadm client cache warmup 5f6a7b8c9d0e --path /data/model  # Warmup directory of the specified client
+  $ dingoadm client cache warmup --fsname fs1 --path model        # Warmup 'model' under mount point of clients`
+)
+
+type cacheOptions struct {
+	selector clientSelector
+	force    bool
+	paths    []string
+}
+
+func NewCacheCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage cache of mounted clients",
+		Args:  cliutil.NoArgs,
+		RunE:  cliutil.ShowHelp(dingoadm.Err()),
+	}
+
+	cmd.AddCommand(
+		NewCacheStatusCommand(dingoadm),
+		NewCacheCleanCommand(dingoadm),
+		NewCacheWarmupCommand(dingoadm),
+	)
+	return cmd
+}
+
+func NewCacheStatusCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options cacheOptions
+
+	cmd := &cobra.Command{
+		Use:     "status [ID] [OPTIONS]",
+		Short:   "Display cache status of clients",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: CACHE_STATUS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.selector.id = args[0]
+			}
+			return runCache(dingoadm, task.CLIENT_CACHE_OP_STATUS, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	addSelectorFlags(cmd, &options.selector)
+
+	return cmd
+}
+
+func NewCacheCleanCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options cacheOptions
+
+	cmd := &cobra.Command{
+		Use:     "clean [ID] [OPTIONS]",
+		Short:   "Clean cache of stopped clients",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: CACHE_CLEAN_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.selector.id = args[0]
+			}
+			return runCache(dingoadm, task.CLIENT_CACHE_OP_CLEAN, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	addSelectorFlags(cmd, &options.selector)
+	cmd.Flags().BoolVarP(&options.force, "force", "f", false, "Never prompt")
+
+	return cmd
+}
+
+func NewCacheWarmupCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options cacheOptions
+
+	cmd := &cobra.Command{
+		Use:     "warmup [ID] --path PATH [OPTIONS]",
+		Short:   "Warmup paths into cache of running clients",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: CACHE_WARMUP_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.selector.id = args[0]
+			}
+			return runCache(dingoadm, task.CLIENT_CACHE_OP_WARMUP, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	addSelectorFlags(cmd, &options.selector)
+	cmd.Flags().StringSliceVar(&options.paths, "path", []string{}, "Specify paths to warmup, relative to mount point")
+
+	return cmd
+}
+
+func getClientCacheStatuses(dingoadm *cli.DingoAdm) []task.ClientCacheStatus {
+	statuses := []task.ClientCacheStatus{}
+	v := dingoadm.MemStorage().Get(comm.KEY_ALL_CLIENT_CACHE_STATUS)
+	if v != nil {
+		for _, status := range v.(map[string]task.ClientCacheStatus) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+func parseClientCaches(clients []configClient) ([]task.ClientCache, error) {
+	caches := []task.ClientCache{}
+	for _, c := range clients {
+		cc, err := configure.ParseClientConfigData(c.config, configure.FS_TYPE_VKS_V2)
+		if err != nil {
+			return nil, err
+		}
+		caches = append(caches, task.ClientCache{Client: c.client, Config: cc})
+	}
+	return caches, nil
+}
+
+// attachSharedClients finds clients which share cache host path with the
+// selected ones, the cache is in use if any of them is running
+func attachSharedClients(dingoadm *cli.DingoAdm, caches []task.ClientCache) ([]task.ClientCache, error) {
+	clients, err := selectConfigClients(dingoadm, clientSelector{})
+	if err != nil {
+		return nil, err
+	}
+	all := []task.ClientCache{}
+	for _, c := range clients {
+		// NOTE: cache directories of client with broken config are unknown
+		cc, err := configure.ParseClientConfigData(c.config, configure.FS_TYPE_VKS_V2)
+		if err == nil {
+			all = append(all, task.ClientCache{Client: c.client, Config: cc})
+		}
+	}
+	for i := range caches {
+		caches[i].Shares = task.GetSharedCacheClients(caches[i], all)
+	}
+	return caches, nil
+}
+
+func runCache(dingoadm *cli.DingoAdm, op string, options cacheOptions) error {
+	// 1) validate options
+	if op == task.CLIENT_CACHE_OP_WARMUP && len(options.paths) == 0 {
+		return errno.ERR_WARMUP_CACHE_FAILED.S("no path to warmup, please specify it by --path")
+	}
+
+	// 2) select clients and parse their config
+	clients, err := selectConfigClients(dingoadm, options.selector)
+	if err != nil {
+		return err
+	}
+	caches, err := parseClientCaches(clients)
+	if err != nil {
+		return err
+	}
+	if op == task.CLIENT_CACHE_OP_CLEAN {
+		caches, err = attachSharedClients(dingoadm, caches)
+		if err != nil {
+			return err
+		}
+	}
+	configs := []interface{}{}
+	for _, cache := range caches {
+		configs = append(configs, cache)
+	}
+
+	// 3) confirm by user
+	if op == task.CLIENT_CACHE_OP_CLEAN && !options.force {
+		if pass := tuicomm.ConfirmYes(tuicomm.PromptCleanClientCache(len(configs))); !pass {
+			dingoadm.WriteOut(tuicomm.PromptCancelOpetation("clean client cache"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 4) run playbook
+	pb := playbook.NewPlaybook(dingoadm)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.CLIENT_CACHE,
+		Configs: configs,
+		Options: map[string]interface{}{
+			comm.KEY_CLIENT_CACHE_OPTIONS: task.ClientCacheOptions{
+				Op:    op,
+				Paths: options.paths,
+			},
+		},
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar: op == task.CLIENT_CACHE_OP_STATUS,
+			SkipError:    true,
+		},
+	})
+	err = pb.Run()
+
+	// 5) display cache status
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOut("%s", tui.FormatCacheStatus(getClientCacheStatuses(dingoadm)))
+	if err != nil {
+		return err
+	} else if op != task.CLIENT_CACHE_OP_STATUS {
+		dingoadm.WriteOutln("")
+		dingoadm.WriteOutln(color.GreenString("%s client cache success ^_^"), op)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package client
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	"github.com/dingodb/dingoadm/internal/storage"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	tui "github.com/dingodb/dingoadm/internal/tui/client"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CHECK_EXAMPLE = `Examples:
+  $ dingoadm client check               # Check mount points of all clients
+  $ dingoadm client check 5f6a7b8c9d0e  # Check mount point of the specified client`
+)
+
+type checkOptions struct {
+	id string
+}
+
+func NewCheckCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options checkOptions
+
+	cmd := &cobra.Command{
+		Use:     "check [ID]",
+		Short:   "Check whether mount points of clients are broken",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: CHECK_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.id = args[0]
+			}
+			return runCheck(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+// getClients returns the specified client, or all clients if id is empty
+func getClients(dingoadm *cli.DingoAdm, id string) ([]storage.Client, error) {
+	if len(id) == 0 {
+		clients, err := dingoadm.Storage().GetClients()
+		if err != nil {
+			return nil, errno.ERR_GET_ALL_CLIENTS_FAILED.E(err)
+		}
+		return clients, nil
+	}
+
+	clients, err := dingoadm.Storage().GetClient(id)
+	if err != nil {
+		return nil, errno.ERR_GET_CLIENT_BY_ID_FAILED.E(err)
+	} else if len(clients) == 0 {
+		return nil, errno.ERR_CLIENT_ID_NOT_FOUND.F("id: %s", id)
+	}
+	return clients, nil
+}
+
+func genClientMountPlaybook(dingoadm *cli.DingoAdm,
+	clients []storage.Client,
+	step int,
+	options map[string]interface{}) *playbook.Playbook {
+	configs := []interface{}{}
+	for _, client := range clients {
+		configs = append(configs, client)
+	}
+
+	pb := playbook.NewPlaybook(dingoadm)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    step,
+		Configs: configs,
+		Options: options,
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar: step == playbook.CHECK_CLIENT_MOUNT,
+			SkipError:    true,
+		},
+	})
+	return pb
+}
+
+func getClientMountStatuses(dingoadm *cli.DingoAdm) []task.ClientMountStatus {
+	statuses := []task.ClientMountStatus{}
+	v := dingoadm.MemStorage().Get(comm.KEY_ALL_CLIENT_MOUNT_STATUS)
+	if v != nil {
+		for _, status := range v.(map[string]task.ClientMountStatus) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+func checkClientMounts(dingoadm *cli.DingoAdm, clients []storage.Client) ([]task.ClientMountStatus, error) {
+	pb := genClientMountPlaybook(dingoadm, clients, playbook.CHECK_CLIENT_MOUNT, nil)
+	err := pb.Run()
+	return getClientMountStatuses(dingoadm), err
+}
+
+func displayClientMounts(dingoadm *cli.DingoAdm, statuses []task.ClientMountStatus) {
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOut("%s", tui.FormatMountStatus(statuses))
+}
+
+func runCheck(dingoadm *cli.DingoAdm, options checkOptions) error {
+	// 1) get clients
+	clients, err := getClients(dingoadm, options.id)
+	if err != nil {
+		return err
+	}
+
+	// 2) check mount points of clients in parallel
+	statuses, err := checkClientMounts(dingoadm, clients)
+
+	// 3) display mount status
+	displayClientMounts(dingoadm, statuses)
+	if err != nil {
+		return err
+	}
+	broken := 0
+	for _, status := range statuses {
+		if !status.Healthy() {
+			broken++
+		}
+	}
+	if broken > 0 {
+		return errno.ERR_BROKEN_CLIENT_MOUNTS.
+			F("%d broken, run 'dingoadm client remount' to fix them", broken)
+	}
+	return nil
+}
@@ -46,8 +46,13 @@ func NewClientCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 		NewMountCommand(dingoadm),
 		NewUmountCommand(dingoadm),
 		NewStatusCommand(dingoadm),
+		NewCheckCommand(dingoadm),
+		NewRemountCommand(dingoadm),
+		NewApplyCommand(dingoadm),
+		NewConfigCommand(dingoadm),
+		NewCacheCommand(dingoadm),
 		NewEnterCommand(dingoadm),
-		// NewInstallCommand(curveadm),
+		NewInstallCommand(dingoadm),
 		// NewUninstallCommand(curveadm),
 	)
 	return cmd
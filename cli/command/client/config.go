@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	"github.com/dingodb/dingoadm/internal/storage"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	"github.com/dingodb/dingoadm/internal/task/task/fs"
+	tui "github.com/dingodb/dingoadm/internal/tui/client"
+	tuicomm "github.com/dingodb/dingoadm/internal/tui/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// clientSelector selects dingofs clients by id, filesystem name or host
+type clientSelector struct {
+	id     string
+	fsname string
+	host   string
+}
+
+// configClient is the mounted dingofs client with its stored config
+type configClient struct {
+	client  storage.Client
+	auxInfo fs.AuxInfo
+	config  string
+}
+
+type reloadOptions struct {
+	timeout time.Duration
+	force   bool
+}
+
+func NewConfigCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage config of mounted clients",
+		Args:  cliutil.NoArgs,
+		RunE:  cliutil.ShowHelp(dingoadm.Err()),
+	}
+
+	cmd.AddCommand(
+		NewConfigShowCommand(dingoadm),
+		NewConfigEditCommand(dingoadm),
+		NewConfigApplyCommand(dingoadm),
+	)
+	return cmd
+}
+
+func addSelectorFlags(cmd *cobra.Command, selector *clientSelector) {
+	flags := cmd.Flags()
+	flags.StringVar(&selector.fsname, "fsname", "", "Select clients which mount the filesystem")
+	flags.StringVar(&selector.host, "host", "", "Select clients on the host")
+}
+
+func selectConfigClients(dingoadm *cli.DingoAdm, selector clientSelector) ([]configClient, error) {
+	clients, err := getClients(dingoadm, selector.id)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := []configClient{}
+	for _, client := range clients {
+		auxInfo := fs.AuxInfo{}
+		if client.Kind != topology.KIND_DINGOFS ||
+			json.Unmarshal([]byte(client.AuxInfo), &auxInfo) != nil {
+			continue
+		} else if len(selector.fsname) > 0 && selector.fsname != auxInfo.FSName {
+			continue
+		} else if len(selector.host) > 0 && selector.host != client.Host {
+			continue
+		}
+
+		items, err := dingoadm.Storage().GetClientConfig(client.Id)
+		if err != nil {
+			return nil, errno.ERR_SELECT_CLIENT_CONFIG_FAILED.E(err)
+		}
+		c := configClient{client: client, auxInfo: auxInfo}
+		if len(items) > 0 {
+			c.config = items[0].Data
+		}
+		selected = append(selected, c)
+	}
+
+	if len(selected) == 0 {
+		return nil, errno.ERR_NO_CLIENT_MATCHED
+	}
+	return selected, nil
+}
+
+// genClientReload compares the new config with the stored one, returns nil
+// if nothing changed, config item of container requires mount again
+func genClientReload(c configClient, cc *configure.ClientConfig) (*task.ClientReload, error) {
+	if c.config == cc.GetData() {
+		return nil, nil
+	}
+
+	reload := &task.ClientReload{Client: c.client, Config: cc}
+	old, err := configure.ParseClientConfigData(c.config, configure.FS_TYPE_VKS_V2)
+	if err != nil { // stored config is broken, reload all items
+		reload.Quota = true
+		return reload, nil
+	}
+
+	changes := configure.ClientConfigChanges(old, cc)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	for _, key := range changes {
+		if configure.IsContainerConfig(key) {
+			return nil, errno.ERR_CONFIG_REQUIRE_RECREATE.
+				F("client %s: %s, please umount and mount it again", c.client.Id, key)
+		} else if key == configure.KEY_QUOTA_CAPACITY || key == configure.KEY_QUOTA_INODES {
+			reload.Quota = true
+		}
+	}
+	return reload, nil
+}
+
+// reloadClientConfig shows difference of config for each client,
+// then updates config of clients and restarts them in parallel.
+// The client is remounted gracefully: it waits until no application using
+// the mount point, so the mount point is unavailable while remounting
+func reloadClientConfig(dingoadm *cli.DingoAdm,
+	clients []configClient,
+	filename string,
+	options reloadOptions) error {
+	// 1) parse new client configure
+	cc, err := configure.ParseClientConfig(filename, configure.FS_TYPE_VKS_V2)
+	if err != nil {
+		return err
+	} else if cc.GetKind() != topology.KIND_DINGOFS {
+		return errno.ERR_REQUIRE_CURVEFS_KIND_CLIENT_CONFIGURE_FILE.
+			F("kind: %s", cc.GetKind())
+	}
+
+	// 2) display difference of config for each client
+	configs := []interface{}{}
+	for _, c := range clients {
+		reload, err := genClientReload(c, cc)
+		if err != nil {
+			return err
+		} else if reload == nil {
+			continue
+		}
+		configs = append(configs, *reload)
+		dingoadm.WriteOutln("Client %s (%s:%s):", c.client.Id, c.client.Host, c.auxInfo.MountPoint)
+		dingoadm.Out().Write([]byte(cliutil.Diff(c.config, cc.GetData())))
+		dingoadm.WriteOutln("")
+	}
+	if len(configs) == 0 {
+		dingoadm.WriteOutln("Config of all clients are up to date, nothing to reload")
+		return nil
+	}
+
+	// 3) confirm by user
+	if !options.force {
+		if pass := tuicomm.ConfirmYes(tuicomm.PromptReloadClients(len(configs))); !pass {
+			dingoadm.WriteOut(tuicomm.PromptCancelOpetation("reload client config"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 4) update config and restart clients in parallel
+	pb := playbook.NewPlaybook(dingoadm)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.RELOAD_CLIENT,
+		Configs: configs,
+		Options: map[string]interface{}{
+			comm.KEY_REMOUNT_TIMEOUT: options.timeout,
+		},
+		ExecOptions: playbook.ExecOptions{
+			SkipError: true,
+		},
+	})
+	err = pb.Run()
+
+	// 5) display downtime of each client
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOut("%s", tui.FormatReloadStatus(getClientMountStatuses(dingoadm)))
+	return err
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package client
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CONFIG_APPLY_EXAMPLE = `Examples:
+  $ dingoadm client config apply 5f6a7b8c9d0e -c client.yaml     # Apply config to the specified client
+  $ dingoadm client config apply --fsname fs1 -c client.yaml     # Apply config to clients which mount 'fs1'
+  $ dingoadm client config apply --host machine1 -c client.yaml  # Apply config to clients on 'machine1'
+
+Note: client has no hot-reload, applying config waits until no application using the
+      mount point (at most --timeout), then umounts and restarts the client`
+)
+
+type configApplyOptions struct {
+	selector clientSelector
+	filename string
+	reload   reloadOptions
+}
+
+func NewConfigApplyCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options configApplyOptions
+
+	cmd := &cobra.Command{
+		Use:     "apply [ID] [OPTIONS]",
+		Short:   "Apply config to clients and restart them",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: CONFIG_APPLY_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.selector.id = args[0]
+			}
+			return runConfigApply(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.filename, "conf", "c", "client.yaml", "Specify client configuration file")
+	flags.DurationVar(&options.reload.timeout, "timeout", task.DEFAULT_REMOUNT_TIMEOUT, "Timeout for waiting mount point idle and client remounted")
+	flags.BoolVarP(&options.reload.force, "force", "f", false, "Never prompt")
+	addSelectorFlags(cmd, &options.selector)
+
+	return cmd
+}
+
+func runConfigApply(dingoadm *cli.DingoAdm, options configApplyOptions) error {
+	// 1) select clients
+	clients, err := selectConfigClients(dingoadm, options.selector)
+	if err != nil {
+		return err
+	}
+
+	// 2) reload config for clients
+	return reloadClientConfig(dingoadm, clients, options.filename, options.reload)
+}
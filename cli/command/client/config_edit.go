@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package client
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CONFIG_EDIT_EXAMPLE = `Examples:
+  $ dingoadm client config edit 5f6a7b8c9d0e  # Edit config of the specified client in $EDITOR and apply it
+
+Note: client has no hot-reload, applying config waits until no application using the
+      mount point (at most --timeout), then umounts and restarts the client`
+
+	DEFAULT_EDITOR = "vi"
+)
+
+type configEditOptions struct {
+	id     string
+	reload reloadOptions
+}
+
+func NewConfigEditCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options configEditOptions
+
+	cmd := &cobra.Command{
+		Use:     "edit ID [OPTIONS]",
+		Short:   "Edit config of client and restart it",
+		Args:    cliutil.ExactArgs(1),
+		Example: CONFIG_EDIT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			return runConfigEdit(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.DurationVar(&options.reload.timeout, "timeout", task.DEFAULT_REMOUNT_TIMEOUT, "Timeout for waiting mount point idle and client remounted")
+	flags.BoolVarP(&options.reload.force, "force", "f", false, "Never prompt")
+
+	return cmd
+}
+
+// editFile opens file in editor specified by $EDITOR
+func editFile(dingoadm *cli.DingoAdm, filename string) error {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{DEFAULT_EDITOR}
+	}
+	cmd := exec.Command(editor[0], append(editor[1:], filename)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = dingoadm.Out()
+	cmd.Stderr = dingoadm.Err()
+	if err := cmd.Run(); err != nil {
+		return errno.ERR_EDIT_CONFIG_FAILED.E(err)
+	}
+	return nil
+}
+
+func runConfigEdit(dingoadm *cli.DingoAdm, options configEditOptions) error {
+	// 1) select client
+	clients, err := selectConfigClients(dingoadm, clientSelector{id: options.id})
+	if err != nil {
+		return err
+	}
+
+	// 2) edit stored config in temporary file
+	filename := cliutil.RandFilename(os.TempDir()) + ".yaml"
+	defer os.Remove(filename)
+	err = cliutil.WriteFile(filename, clients[0].config, 0644)
+	if err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+	err = editFile(dingoadm, filename)
+	if err != nil {
+		return err
+	}
+
+	// 3) reload config for client
+	return reloadClientConfig(dingoadm, clients, filename, options.reload)
+}
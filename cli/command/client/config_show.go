@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package client
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CONFIG_SHOW_EXAMPLE = `Examples:
+  $ dingoadm client config show 5f6a7b8c9d0e     # Show config of the specified client
+  $ dingoadm client config show --fsname fs1     # Show config of clients which mount 'fs1'
+  $ dingoadm client config show --host machine1  # Show config of clients on 'machine1'`
+)
+
+type configShowOptions struct {
+	selector clientSelector
+}
+
+func NewConfigShowCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options configShowOptions
+
+	cmd := &cobra.Command{
+		Use:     "show [ID] [OPTIONS]",
+		Short:   "Show config of clients",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: CONFIG_SHOW_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.selector.id = args[0]
+			}
+			return runConfigShow(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	addSelectorFlags(cmd, &options.selector)
+
+	return cmd
+}
+
+func runConfigShow(dingoadm *cli.DingoAdm, options configShowOptions) error {
+	// 1) select clients
+	clients, err := selectConfigClients(dingoadm, options.selector)
+	if err != nil {
+		return err
+	}
+
+	// 2) display stored config of each client
+	for i, c := range clients {
+		if i > 0 {
+			dingoadm.WriteOutln("")
+		}
+		dingoadm.WriteOutln(color.CyanString("# client %s (fsname=%s host=%s mountPoint=%s)"),
+			c.client.Id, c.auxInfo.FSName, c.client.Host, c.auxInfo.MountPoint)
+		if len(c.config) == 0 {
+			dingoadm.WriteOutln("<empty config>")
+			continue
+		}
+		dingoadm.WriteOut("%s", c.config)
+	}
+	return nil
+}
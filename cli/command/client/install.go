@@ -27,38 +27,63 @@
 package client
 
 import (
+	"strings"
+
 	"github.com/dingodb/dingoadm/cli/cli"
 	comm "github.com/dingodb/dingoadm/internal/common"
 	"github.com/dingodb/dingoadm/internal/configure"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/playbook"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
 	cliutil "github.com/dingodb/dingoadm/internal/utils"
 	utils "github.com/dingodb/dingoadm/internal/utils"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
+const (
+	INSTALL_EXAMPLE = `Examples:
+  $ dingoadm client install dingofs --host machine -c client.yaml                                 # Install client extracted from the client image
+  $ dingoadm client install dingofs --host machine --source http://mirror/dingofs.tar.gz          # Install client downloaded from the mirror
+  $ dingoadm client install dingofs --host machine --source /path/to/dingofs.tar.gz               # Install client from the local package
+  $ dingoadm client install dingofs --host machine --fsname fs1 --mount-point /mnt/fs1 --systemd  # Install client and mount 'fs1' by systemd`
+)
+
 var (
 	INSTALL_CURVE_CLIENT_PLAYBOOK_STEPS = []int{
-		playbook.DETECT_OS_RELEASE,
 		playbook.INSTALL_CLIENT,
 	}
 )
 
 type installOptions struct {
-	kind     string
-	host     string
-	filename string
+	kind       string
+	host       string
+	filename   string
+	source     string
+	fsname     string
+	mountPoint string
+	systemd    bool
+	insecure   bool
 }
 
 func checkInstallOptions(curveadm *cli.DingoAdm, options installOptions) error {
 	kind := options.kind
+	source := options.source
 	if kind != topology.KIND_DINGOFS {
 		return errno.ERR_UNSUPPORT_CLIENT_KIND.F("kind: %s", kind)
 	} else if !utils.PathExist(options.filename) {
 		return errno.ERR_CLIENT_CONFIGURE_FILE_NOT_EXIST.
 			F("%s: no such file", utils.AbsPath(options.filename))
+	} else if source != task.CLIENT_PACKAGE_SOURCE_IMAGE &&
+		!task.IsHTTPPackageSource(source) && !utils.PathExist(source) {
+		return errno.ERR_CLIENT_PACKAGE_NOT_FOUND.
+			F("%s: no such file", utils.AbsPath(source))
+	} else if options.systemd && (len(options.fsname) == 0 || len(options.mountPoint) == 0) {
+		return errno.ERR_INVALID_SYSTEMD_MOUNT
+	} else if options.systemd && !strings.HasPrefix(options.mountPoint, "/") {
+		return errno.ERR_FS_MOUNTPOINT_REQUIRE_ABSOLUTE_PATH.
+			F("mount point: %s", options.mountPoint)
 	}
 	return nil
 }
@@ -67,11 +92,13 @@ func NewInstallCommand(curveadm *cli.DingoAdm) *cobra.Command {
 	var options installOptions
 
 	cmd := &cobra.Command{
-		Use:   "install CURVE [OPTIONS]",
-		Short: "Install CurveBS/CurveFS client",
-		Args:  cliutil.NoArgs,
+		Use:     "install KIND [OPTIONS]",
+		Short:   "Install DingoFS client on host",
+		Args:    cliutil.ExactArgs(1),
+		Example: INSTALL_EXAMPLE,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			options.kind = args[0]
+			options.mountPoint = utils.TrimSuffixRepeat(options.mountPoint, "/")
 			return checkInstallOptions(curveadm, options)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -81,8 +108,13 @@ func NewInstallCommand(curveadm *cli.DingoAdm) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.StringVar(&options.host, "host", "local", "Specify install target host")
+	flags.StringVar(&options.host, "host", "localhost", "Specify install target host")
 	flags.StringVarP(&options.filename, "conf", "c", "client.yaml", "Specify client configuration file")
+	flags.StringVar(&options.source, "source", task.CLIENT_PACKAGE_SOURCE_IMAGE, "Specify client package source: image, URL or local file")
+	flags.StringVar(&options.fsname, "fsname", "", "Specify filesystem to mount by systemd")
+	flags.StringVar(&options.mountPoint, "mount-point", "", "Specify mount point to mount by systemd")
+	flags.BoolVar(&options.systemd, "systemd", false, "Create systemd unit which mounts filesystem on boot")
+	flags.BoolVar(&options.insecure, "insecure", false, "Skip verifying certificate of https mirror")
 
 	return cmd
 }
@@ -98,6 +130,14 @@ func genInstallPlaybook(curveadm *cli.DingoAdm,
 			Configs: ccs,
 			Options: map[string]interface{}{
 				comm.KEY_CLIENT_HOST: options.host,
+				comm.KEY_INSTALL_CLIENT_OPTIONS: task.InstallClientOptions{
+					Host:       options.host,
+					Source:     options.source,
+					FSName:     options.fsname,
+					MountPoint: options.mountPoint,
+					Systemd:    options.systemd,
+					Insecure:   options.insecure,
+				},
 			},
 		})
 	}
@@ -106,19 +146,15 @@ func genInstallPlaybook(curveadm *cli.DingoAdm,
 
 func runInstall(dingoadm *cli.DingoAdm, options installOptions) error {
 	// 1) parse client configure
-	cc, err := configure.ParseClientConfig(options.filename, "")
+	cc, err := configure.ParseClientConfig(options.filename, configure.FS_TYPE_VKS_V2)
 	if err != nil {
 		return err
 	} else if options.kind != cc.GetKind() {
-		if options.kind == topology.KIND_CURVEBS {
-			return errno.ERR_REQUIRE_CURVEBS_KIND_CLIENT_CONFIGURE_FILE.
-				F("kind: %s", cc.GetKind())
-		}
 		return errno.ERR_REQUIRE_CURVEFS_KIND_CLIENT_CONFIGURE_FILE.
 			F("kind: %s", cc.GetKind())
 	}
 
-	// 2) generate map playbook
+	// 2) generate install playbook
 	pb, err := genInstallPlaybook(dingoadm, []*configure.ClientConfig{cc}, options)
 	if err != nil {
 		return err
@@ -134,5 +170,9 @@ func runInstall(dingoadm *cli.DingoAdm, options installOptions) error {
 	dingoadm.WriteOutln("")
 	dingoadm.WriteOutln(color.GreenString("Install %s to %s success ^_^"),
 		options.kind, options.host)
+	if options.systemd {
+		dingoadm.WriteOutln("Filesystem %s is mounted on %s by systemd unit %s",
+			options.fsname, options.mountPoint, task.GetClientSystemdUnit(options.mountPoint))
+	}
 	return nil
 }
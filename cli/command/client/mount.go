@@ -45,6 +45,7 @@ const (
 	MOUNT_EXAMPLE = `Examples:
   $ dingoadm mount fs1  /path/to/mount --host machine -c client.yaml   			   # Mount a classic s3 DingoFS 'fs1' to '/path/to/mount'
   $ dingoadm mount fs2  /path/to/mount --host machine -c client.yaml --new-dingo   # Mount a support rados type DingoFS 'fs2' to '/path/to/mount'
+  $ dingoadm mount fs1  /path/to/mount --host machine -c client.yaml --persistent  # Mount 'fs1' which re-mounts after host reboot or client crash
   `
 )
 
@@ -72,6 +73,7 @@ type mountOptions struct {
 	insecure      bool
 	useLocalImage bool
 	newDingo      bool // whether to create a new dingo which support rados fs type
+	persistent    bool
 }
 
 func checkMountOptions(dingoadm *cli.DingoAdm, options mountOptions) error {
@@ -110,6 +112,7 @@ func NewMountCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	flags.BoolVarP(&options.insecure, "insecure", "k", false, "Mount without precheck")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image to mount")
 	flags.BoolVar(&options.newDingo, "new-dingo", true, "support create rados type fs")
+	flags.BoolVar(&options.persistent, "persistent", false, "Restart client and re-mount after host reboot or client crash")
 
 	return cmd
 }
@@ -137,6 +140,7 @@ func genMountPlaybook(dingoadm *cli.DingoAdm,
 					MountFSName: options.mountFSName,
 					MountFSType: options.mountFSType,
 					MountPoint:  utils.TrimSuffixRepeat(options.mountPoint, "/"),
+					Persistent:  options.persistent,
 				},
 				comm.KEY_CLIENT_HOST:              options.host, // for checker
 				comm.KEY_CHECK_KERNEL_MODULE_NAME: comm.KERNERL_MODULE_FUSE,
@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package client
+
+import (
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	"github.com/dingodb/dingoadm/internal/storage"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	tuicomm "github.com/dingodb/dingoadm/internal/tui/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	REMOUNT_EXAMPLE = `Examples:
+  $ dingoadm client remount                  # Remount all clients whose mount point is broken
+  $ dingoadm client remount 5f6a7b8c9d0e     # Remount the specified client
+  $ dingoadm client remount --timeout 5m     # Wait clients remounted at most 5 minutes`
+)
+
+type remountOptions struct {
+	id      string
+	timeout time.Duration
+	force   bool
+}
+
+func NewRemountCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options remountOptions
+
+	cmd := &cobra.Command{
+		Use:     "remount [ID] [OPTIONS]",
+		Short:   "Clean broken mount points and remount clients",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: REMOUNT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.id = args[0]
+			}
+			return runRemount(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.DurationVar(&options.timeout, "timeout", task.DEFAULT_REMOUNT_TIMEOUT, "Timeout for waiting client remounted")
+	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+
+	return cmd
+}
+
+// selectRemountClients selects clients with broken mount point,
+// the specified client is always selected
+func selectRemountClients(clients []storage.Client,
+	statuses []task.ClientMountStatus,
+	options remountOptions) ([]storage.Client, bool) {
+	m := map[string]task.ClientMountStatus{}
+	for _, status := range statuses {
+		m[status.Id] = status
+	}
+
+	selected := []storage.Client{}
+	healthy := false
+	for _, client := range clients {
+		status, ok := m[client.Id]
+		if !ok { // not a dingofs client
+			continue
+		} else if len(options.id) > 0 || !status.Healthy() {
+			selected = append(selected, client)
+			healthy = healthy || status.Healthy()
+		}
+	}
+	return selected, healthy
+}
+
+func runRemount(dingoadm *cli.DingoAdm, options remountOptions) error {
+	// 1) get clients
+	clients, err := getClients(dingoadm, options.id)
+	if err != nil {
+		return err
+	}
+
+	// 2) check mount points of clients in parallel
+	statuses, err := checkClientMounts(dingoadm, clients)
+	if err != nil {
+		displayClientMounts(dingoadm, statuses)
+		return err
+	}
+
+	// 3) select clients to remount
+	selected, healthy := selectRemountClients(clients, statuses, options)
+	if len(selected) == 0 {
+		displayClientMounts(dingoadm, statuses)
+		dingoadm.WriteOutln("")
+		dingoadm.WriteOutln("All client mounts are healthy, nothing to remount")
+		return nil
+	} else if healthy && !options.force {
+		// remount the healthy client interrupts the application which using it
+		if pass := tuicomm.ConfirmYes(tuicomm.PromptRemountClient(options.id)); !pass {
+			dingoadm.WriteOut(tuicomm.PromptCancelOpetation("remount client"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 4) remount clients in parallel
+	pb := genClientMountPlaybook(dingoadm, selected, playbook.REMOUNT_CLIENT,
+		map[string]interface{}{
+			comm.KEY_REMOUNT_TIMEOUT: options.timeout,
+		})
+	err = pb.Run()
+
+	// 5) display mount status
+	displayClientMounts(dingoadm, getClientMountStatuses(dingoadm))
+	return err
+}
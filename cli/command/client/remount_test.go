@@ -0,0 +1,51 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/storage"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectRemountClients(t *testing.T) {
+	assert := assert.New(t)
+
+	clients := []storage.Client{{Id: "c1"}, {Id: "c2"}, {Id: "c3"}, {Id: "c4"}, {Id: "c5"}}
+	statuses := []task.ClientMountStatus{
+		{Id: "c1", Container: task.CLIENT_CONTAINER_RUNNING, State: task.CLIENT_MOUNT_STATE_MOUNTED},
+		{Id: "c2", Container: task.CLIENT_CONTAINER_RUNNING, State: task.CLIENT_MOUNT_STATE_BROKEN},
+		{Id: "c3", Container: "exited", State: task.CLIENT_MOUNT_STATE_MOUNTED},
+		{Id: "c4", Container: task.CLIENT_CONTAINER_RUNNING, State: task.CLIENT_MOUNT_STATE_HUNG},
+		// c5 is not a dingofs client, no status
+	}
+	ids := func(clients []storage.Client) []string {
+		ret := []string{}
+		for _, client := range clients {
+			ret = append(ret, client.Id)
+		}
+		return ret
+	}
+
+	tests := []struct {
+		id      string
+		clients []storage.Client
+		expect  []string
+		healthy bool
+	}{
+		// only unhealthy clients selected
+		{"", clients, []string{"c2", "c3", "c4"}, false},
+		// nothing to remount
+		{"", clients[:1], []string{}, false},
+		// specified client always selected, even if healthy
+		{"c1", clients[:1], []string{"c1"}, true},
+		{"c2", clients[1:2], []string{"c2"}, false},
+		// specified client without status
+		{"c5", clients[4:], []string{}, false},
+	}
+	for _, tt := range tests {
+		selected, healthy := selectRemountClients(tt.clients, statuses, remountOptions{id: tt.id})
+		assert.Equal(tt.expect, ids(selected), tt.id)
+		assert.Equal(tt.healthy, healthy, tt.id)
+	}
+}
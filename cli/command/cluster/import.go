@@ -103,25 +103,9 @@ func importCluster(storage *storage.Storage, dbfile, name string) error {
 		return err
 	}
 
-	// insert cluster
-	err = storage.InsertCluster(name, cluster.UUId, cluster.Description, cluster.Topology)
-	if err != nil {
-		return err
-	}
-
-	// insert service
-	clusters, err := storage.GetClusters(name)
-	if err != nil {
-		return err
-	}
-	clusterId := clusters[0].Id
-	for _, service := range services {
-		err := storage.InsertService(clusterId, service.Id, service.ContainerId)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	// insert cluster with services in one transaction
+	cluster.Name = name
+	return storage.ImportCluster(*cluster, services)
 }
 
 func runImport(curveadm *cli.DingoAdm, options importOptions) error {
@@ -37,10 +37,15 @@ import (
 	"github.com/dingodb/dingoadm/cli/command/client"
 	"github.com/dingodb/dingoadm/cli/command/cluster"
 	"github.com/dingodb/dingoadm/cli/command/config"
+	"github.com/dingodb/dingoadm/cli/command/db"
+	"github.com/dingodb/dingoadm/cli/command/disk"
+	"github.com/dingodb/dingoadm/cli/command/fs"
 	"github.com/dingodb/dingoadm/cli/command/hosts"
+	"github.com/dingodb/dingoadm/cli/command/maintenance"
 	"github.com/dingodb/dingoadm/cli/command/monitor"
 	"github.com/dingodb/dingoadm/cli/command/pfs"
 	"github.com/dingodb/dingoadm/cli/command/playground"
+	"github.com/dingodb/dingoadm/cli/command/store"
 	"github.com/dingodb/dingoadm/cli/command/target"
 	"github.com/dingodb/dingoadm/internal/errno"
 	tools "github.com/dingodb/dingoadm/internal/tools/upgrade"
@@ -58,23 +63,31 @@ var dingoadmExample = `Examples:
   $ dingoadm -u                             # Upgrade dingoadm itself to the latest version`
 
 type rootOptions struct {
-	debug   bool
-	upgrade bool
+	debug       bool
+	upgrade     bool
+	stepRetries map[string]int
+	stepTimeout map[string]int
 }
 
 func addSubCommands(cmd *cobra.Command, dingoadm *cli.DingoAdm) {
 	cmd.AddCommand(
-		client.NewClientCommand(dingoadm),         // dingoadm client
-		cluster.NewClusterCommand(dingoadm),       // dingoadm cluster ...
-		config.NewConfigCommand(dingoadm),         // dingoadm config ...
-		hosts.NewHostsCommand(dingoadm),           // dingoadm hosts ...
-		playground.NewPlaygroundCommand(dingoadm), // dingoadm playground ...
-		target.NewTargetCommand(dingoadm),         // dingoadm target ...
-		pfs.NewPFSCommand(dingoadm),               // dingoadm pfs ...
-		monitor.NewMonitorCommand(dingoadm),       // dingoadm monitor ...
-		gateway.NewGatewayCommand(dingoadm),       // dingoadm gateway ...
+		client.NewClientCommand(dingoadm),           // dingoadm client
+		cluster.NewClusterCommand(dingoadm),         // dingoadm cluster ...
+		config.NewConfigCommand(dingoadm),           // dingoadm config ...
+		db.NewDBCommand(dingoadm),                   // dingoadm db ...
+		disk.NewDiskCommand(dingoadm),               // dingoadm disk ...
+		fs.NewFSCommand(dingoadm),                   // dingoadm fs ...
+		hosts.NewHostsCommand(dingoadm),             // dingoadm hosts ...
+		maintenance.NewMaintenanceCommand(dingoadm), // dingoadm maintenance ...
+		playground.NewPlaygroundCommand(dingoadm),   // dingoadm playground ...
+		store.NewStoreCommand(dingoadm),             // dingoadm store ...
+		target.NewTargetCommand(dingoadm),           // dingoadm target ...
+		pfs.NewPFSCommand(dingoadm),                 // dingoadm pfs ...
+		monitor.NewMonitorCommand(dingoadm),         // dingoadm monitor ...
+		gateway.NewGatewayCommand(dingoadm),         // dingoadm gateway ...
 
 		NewAuditCommand(dingoadm),      // dingoadm audit
+		NewCheckCommand(dingoadm),      // dingoadm check
 		NewCleanCommand(dingoadm),      // dingoadm clean
 		NewCompletionCommand(dingoadm), // dingoadm completion
 		NewDeployCommand(dingoadm),     // dingoadm deploy
@@ -134,6 +147,9 @@ func NewDingoAdmCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 			return fmt.Errorf("dingoadm: '%s' is not a dingoadm command.\n"+
 				"See 'dingoadm --help'", args[0])
 		},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return dingoadm.Config().OverrideStepPolicy(options.stepRetries, options.stepTimeout)
+		},
 		SilenceUsage:          true, // silence usage when an error occurs
 		DisableFlagsInUseLine: true,
 	}
@@ -142,6 +158,10 @@ func NewDingoAdmCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	cmd.PersistentFlags().BoolP("help", "h", false, "Print usage")
 	cmd.Flags().BoolVarP(&options.debug, "debug", "d", false, "Print debug information")
 	cmd.Flags().BoolVarP(&options.upgrade, "upgrade", "u", false, "Upgrade dingoadm itself to the latest version")
+	cmd.PersistentFlags().StringToIntVar(&options.stepRetries, "step-retries", map[string]int{},
+		"Override retries of idempotent playbook steps (e.g. pull_image=3,get_service_status=2)")
+	cmd.PersistentFlags().StringToIntVar(&options.stepTimeout, "step-timeout", map[string]int{},
+		"Override timeout seconds of playbook steps (e.g. pull_image=3600)")
 
 	addSubCommands(cmd, dingoadm)
 	setupRootCommand(cmd, dingoadm)
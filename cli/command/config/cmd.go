@@ -46,6 +46,7 @@ func NewConfigCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 		NewShowCommand(dingoadm),
 		NewDiffCommand(dingoadm),
 		NewCommitCommand(dingoadm),
+		NewRenderCommand(dingoadm),
 	)
 	return cmd
 }
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/task/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	RENDER_EXAMPLE = `Examples:
+  $ dingoadm config render --target k8s -o /path/to/manifests       # Render kubernetes manifests of current cluster
+  $ dingoadm config render --target k8s -o manifests -n dingo-store  # Render kubernetes manifests into namespace 'dingo-store'
+
+Note:
+  Each service is rendered as a StatefulSet (1 replica), a headless Service and a ConfigMap,
+  the pod is scheduled to node which labeled with 'dingoadm.io/host=<host>'.`
+)
+
+type renderOptions struct {
+	target        string
+	output        string
+	namespace     string
+	clusterDomain string
+}
+
+func NewRenderCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options renderOptions
+
+	cmd := &cobra.Command{
+		Use:     "render [OPTIONS]",
+		Short:   "Render cluster topology into manifests of other platform",
+		Args:    utils.NoArgs,
+		Example: RENDER_EXAMPLE,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if options.target != common.K8S_TARGET {
+				return errno.ERR_UNSUPPORT_RENDER_TARGET.F("target: %s", options.target)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRender(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.target, "target", "t", common.K8S_TARGET, "Specify render target")
+	flags.StringVarP(&options.output, "output", "o", "", "Specify output directory")
+	flags.StringVarP(&options.namespace, "namespace", "n", common.K8S_DEFAULT_NAMESPACE, "Specify kubernetes namespace")
+	flags.StringVar(&options.clusterDomain, "cluster-domain", common.K8S_DEFAULT_CLUSTER_DOMAIN, "Specify kubernetes cluster domain")
+	cmd.MarkFlagRequired("output")
+
+	return cmd
+}
+
+func runRender(dingoadm *cli.DingoAdm, options renderOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) render manifests
+	manifests, err := common.RenderK8sManifests(dingoadm, dcs, common.K8sRenderOptions{
+		Namespace:     options.namespace,
+		ClusterDomain: options.clusterDomain,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 3) write manifests into output directory, one file per service
+	if err := os.MkdirAll(options.output, 0755); err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+	for _, manifest := range manifests {
+		data, err := manifest.Encode()
+		if err != nil {
+			return err
+		}
+		filename := path.Join(options.output, fmt.Sprintf("%s.yaml", manifest.Name))
+		if err := utils.WriteFile(filename, data, 0644); err != nil {
+			return errno.ERR_WRITE_FILE_FAILED.E(err)
+		}
+	}
+
+	dingoadm.WriteOutln("Rendered %d services into '%s'", len(manifests), options.output)
+	return nil
+}
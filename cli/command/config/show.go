@@ -34,6 +34,7 @@ import (
 	"github.com/dingodb/dingoadm/cli/cli"
 	"github.com/dingodb/dingoadm/internal/configure"
 	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/tui"
 	cliutil "github.com/dingodb/dingoadm/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -61,17 +62,17 @@ func NewShowCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	return cmd
 }
 
-func decodePoolJSON(data string) (string, error) {
+func decodePoolJSON(data string) (configure.DingoFsClusterTopo, string, error) {
 	pool := configure.DingoFsClusterTopo{}
 	err := json.Unmarshal([]byte(data), &pool)
 	if err != nil {
-		return "", errno.ERR_DECODE_CLUSTER_POOL_JSON_FAILED.E(err)
+		return pool, "", errno.ERR_DECODE_CLUSTER_POOL_JSON_FAILED.E(err)
 	}
 	bytes, err := json.MarshalIndent(pool, "", "    ")
 	if err != nil {
-		return "", errno.ERR_DECODE_CLUSTER_POOL_JSON_FAILED.E(err)
+		return pool, "", errno.ERR_DECODE_CLUSTER_POOL_JSON_FAILED.E(err)
 	}
-	return string(bytes), nil
+	return pool, string(bytes), nil
 }
 
 func runShow(dingoadm *cli.DingoAdm, options showOptions) error {
@@ -89,15 +90,17 @@ func runShow(dingoadm *cli.DingoAdm, options showOptions) error {
 		return nil
 	}
 
-	// 3) OR display cluster pool information
+	// 3) OR display cluster pool information and its placement
 	if len(dingoadm.ClusterPoolData()) == 0 {
 		dingoadm.WriteOutln("<empty pool>")
 		return nil
 	}
-	data, err := decodePoolJSON(dingoadm.ClusterPoolData())
+	pool, data, err := decodePoolJSON(dingoadm.ClusterPoolData())
 	if err != nil {
 		return err
 	}
 	dingoadm.WriteOutln(data)
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOut(tui.FormatPoolPlacement(pool))
 	return nil
 }
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package db
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	BACKUP_EXAMPLE = `Examples:
+  $ dingoadm db backup                        # Backup database into dingoadm data directory
+  $ dingoadm db backup -o /tmp/dingoadm.db    # Backup database into specified file`
+)
+
+type backupOptions struct {
+	output string
+}
+
+func NewBackupCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options backupOptions
+
+	cmd := &cobra.Command{
+		Use:     "backup [OPTIONS]",
+		Short:   "Backup database",
+		Args:    cliutil.NoArgs,
+		Example: BACKUP_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBackup(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.output, "output", "o", "", "Specify the backup file")
+
+	return cmd
+}
+
+func runBackup(dingoadm *cli.DingoAdm, options backupOptions) error {
+	dest := options.output
+	if len(dest) == 0 {
+		now := time.Now().Format("2006-01-02_15-04-05")
+		dest = path.Join(dingoadm.DataDir(), fmt.Sprintf("dingoadm-%s.db", now))
+	}
+	if cliutil.PathExist(dest) {
+		return errno.ERR_BACKUP_DATABASE_FAILED.F("%s: file already exists", dest)
+	}
+
+	err := dingoadm.Storage().Backup(dest)
+	if err != nil {
+		return errno.ERR_BACKUP_DATABASE_FAILED.E(err)
+	}
+	dingoadm.WriteOutln("Backup database to %s", dest)
+	return nil
+}
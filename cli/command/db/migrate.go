@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package db
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func NewMigrateCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply pending database migrations",
+		Args:  cliutil.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrate(dingoadm)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func runMigrate(dingoadm *cli.DingoAdm) error {
+	// NOTE: pending migrations are applied once dingoadm started,
+	// so we also show the migrations which applied at startup
+	applied, err := dingoadm.Storage().Migrate()
+	if err != nil {
+		return errno.ERR_MIGRATE_DATABASE_SCHEMA_FAILED.E(err)
+	}
+	applied = append(dingoadm.Storage().Migrated(), applied...)
+	if len(applied) == 0 {
+		dingoadm.WriteOutln("Database schema is up to date")
+		return nil
+	}
+
+	for _, m := range applied {
+		dingoadm.WriteOutln("Apply migration %d: %s %s", m.Version, m.Description, color.GreenString("[OK]"))
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package db
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/tui"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewStatusCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show database schema version and migrations",
+		Args:  cliutil.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(dingoadm)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func runStatus(dingoadm *cli.DingoAdm) error {
+	status, err := dingoadm.Storage().SchemaStatus()
+	if err != nil {
+		return errno.ERR_GET_SCHEMA_STATUS_FAILED.E(err)
+	}
+
+	dingoadm.WriteOutln("Database       : %s", dingoadm.Config().GetDBUrl())
+	dingoadm.WriteOutln("Schema Version : %d (latest %d)", status.Current, status.Latest)
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOut(tui.FormatSchemaStatus(status))
+	return nil
+}
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/playbook"
@@ -43,6 +44,7 @@ import (
 const (
 	CLEAN_PRECHECK_ENVIRONMENT = playbook.CLEAN_PRECHECK_ENVIRONMENT
 	PULL_IMAGE                 = playbook.PULL_IMAGE
+	PREPARE_DISK               = playbook.PREPARE_DISK
 	CREATE_CONTAINER           = playbook.CREATE_CONTAINER
 	CREATE_MDSV2_CLI_CONTAINER = playbook.CREATE_MDSV2_CLI_CONTAINER
 	SYNC_CONFIG                = playbook.SYNC_CONFIG
@@ -215,6 +217,7 @@ type deployOptions struct {
 	poolset         string
 	poolsetDiskType string
 	useLocalImage   bool
+	forceDisk       bool
 }
 
 func checkDeployOptions(options deployOptions) error {
@@ -250,6 +253,7 @@ func NewDeployCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	flags.StringVar(&options.poolset, "poolset", "default", "Specify the poolset name")
 	flags.StringVar(&options.poolsetDiskType, "poolset-disktype", "ssd", "Specify the disk type of physical pool")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	flags.BoolVar(&options.forceDisk, "force-disk", false, "Format non-empty disks in disk section")
 
 	return cmd
 }
@@ -290,6 +294,7 @@ func precheckBeforeDeploy(dingoadm *cli.DingoAdm,
 	// 2) generate precheck playbook
 	pb, err := genPrecheckPlaybook(dingoadm, dcs, precheckOptions{
 		skipSnapshotClone: utils.Slice2Map(options.skip)[ROLE_SNAPSHOTCLONE],
+		forceDisk:         options.forceDisk,
 	})
 	if err != nil {
 		return err
@@ -360,7 +365,19 @@ func genDeployPlaybook(dingoadm *cli.DingoAdm,
 	steps = skipDeploySteps(dcs, steps, options) // not necessary
 
 	pb := playbook.NewPlaybook(dingoadm)
+	diskConfigs := topology.FilterDiskConfigs(dcs)
 	for _, step := range steps {
+		// prepare disks before container created, which mounts the service directories
+		if step == CREATE_CONTAINER && len(diskConfigs) > 0 {
+			pb.AddStep(&playbook.PlaybookStep{
+				Type:    PREPARE_DISK,
+				Configs: diskConfigs,
+				Options: map[string]interface{}{
+					comm.KEY_FORCE_DISK: options.forceDisk,
+				},
+			})
+		}
+
 		// configs
 		config := dcs
 		if len(DEPLOY_FILTER_ROLE[step]) > 0 {
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package disk
+
+import (
+	"encoding/json"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	"github.com/dingodb/dingoadm/internal/tui"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	STATUS_EXAMPLE = `Examples:
+  $ dingoadm disk status                # Display disks of all services
+  $ dingoadm disk status --role store   # Display disks of store services
+  $ dingoadm disk status --json         # Display disks in JSON format`
+)
+
+var (
+	GET_DISK_STATUS_PLAYBOOK_STEPS = []int{
+		playbook.GET_DISK_STATUS,
+	}
+)
+
+type statusOptions struct {
+	id     string
+	role   string
+	host   string
+	labels string
+	json   bool
+}
+
+func NewStatusCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options statusOptions
+
+	cmd := &cobra.Command{
+		Use:     "status [OPTIONS]",
+		Short:   "Display disk status of service directories",
+		Args:    cliutil.NoArgs,
+		Example: STATUS_EXAMPLE,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if options.labels == "*" {
+				return nil
+			}
+			return dingoadm.CheckLabels(options.labels)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.id, "id", "*", "Specify service id")
+	flags.StringVar(&options.role, "role", "*", "Specify service role")
+	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
+	flags.BoolVar(&options.json, "json", false, "Output disk status in JSON format")
+
+	return cmd
+}
+
+func genStatusPlaybook(dingoadm *cli.DingoAdm,
+	dcs []*topology.DeployConfig,
+	options statusOptions) (*playbook.Playbook, error) {
+	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
+	})
+	dcs = topology.FilterDiskConfigs(dcs)
+	if len(dcs) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED.S("no disk section in matched services")
+	}
+	for _, dc := range dcs {
+		if _, err := dc.GetDisks(); err != nil {
+			return nil, err
+		}
+	}
+
+	steps := GET_DISK_STATUS_PLAYBOOK_STEPS
+	pb := playbook.NewPlaybook(dingoadm)
+	for _, step := range steps {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: dcs,
+			ExecOptions: playbook.ExecOptions{
+				SilentSubBar:  true,
+				SilentMainBar: options.json,
+				SkipError:     true,
+			},
+		})
+	}
+	return pb, nil
+}
+
+// getDiskStatus returns status of all disks in services,
+// the disk which status not gathered (e.g. SSH failed) is marked as unknown
+func getDiskStatus(dingoadm *cli.DingoAdm, dcs []*topology.DeployConfig) []task.DiskStatus {
+	m := map[string]task.DiskStatus{}
+	v := dingoadm.MemStorage().Get(comm.KEY_ALL_DISK_STATUS)
+	if v != nil {
+		m = v.(map[string]task.DiskStatus)
+	}
+
+	statuses := []task.DiskStatus{}
+	for _, dc := range topology.FilterDiskConfigs(dcs) {
+		serviceId := dingoadm.GetServiceId(dc.GetId())
+		disks, err := dc.GetDisks()
+		if err != nil {
+			continue
+		}
+		for _, disk := range disks {
+			status, ok := m[serviceId+":"+disk.Dir]
+			if !ok {
+				status = task.DiskStatus{
+					Id:         serviceId,
+					Role:       dc.GetRole(),
+					Host:       dc.GetHost(),
+					Dir:        disk.Dir,
+					Device:     disk.Device,
+					MountPoint: disk.MountPoint,
+				}
+			}
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+func runStatus(dingoadm *cli.DingoAdm, options statusOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) generate get disk status playbook
+	pb, err := genStatusPlaybook(dingoadm, dcs, options)
+	if err != nil {
+		return err
+	}
+
+	// 3) run playbook
+	err = pb.Run()
+
+	// 4) display disk status
+	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
+	})
+	statuses := getDiskStatus(dingoadm, dcs)
+	if options.json {
+		bytes, jerr := json.MarshalIndent(statuses, "", "  ")
+		if jerr != nil {
+			return jerr
+		}
+		dingoadm.WriteOutln(string(bytes))
+		return err
+	}
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOut("%s", tui.FormatDiskStatus(statuses))
+	return err
+}
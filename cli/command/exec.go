@@ -27,38 +27,87 @@
 package command
 
 import (
+	"encoding/json"
 	"strings"
 
 	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
 	"github.com/dingodb/dingoadm/internal/tools"
+	"github.com/dingodb/dingoadm/internal/tui"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
 	"github.com/spf13/cobra"
 )
 
+const (
+	EXEC_EXAMPLE = `Examples:
+  $ dingoadm exec <Id> ls /dingofs                     # Exec command in service container interactively
+  $ dingoadm exec --role store ls /dingofs             # Exec command in all store services in parallel
+  $ dingoadm exec --host host1 -l zone=az1 -- ls -l /  # Exec command in services selected by host and labels
+  $ dingoadm exec --json <Id> cat /etc/hosts          # Exec command and output result in JSON`
+)
+
+var (
+	EXEC_PLAYBOOK_STEPS = []int{
+		playbook.EXEC_SERVICE_COMMAND,
+	}
+)
+
 type execOptions struct {
-	id  string
-	cmd string
+	id     string
+	cmd    string
+	role   string
+	host   string
+	labels string
+	json   bool
+	fanout bool
 }
 
 func NewExecCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	var options execOptions
 
 	cmd := &cobra.Command{
-		Use:   "exec ID [OPTIONS]",
-		Short: "Exec a cmd in service container",
+		Use:     "exec [OPTIONS] [ID] COMMAND",
+		Short:   "Exec a cmd in service container",
+		Args:    cliutil.RequiresMinArgs(1),
+		Example: EXEC_EXAMPLE,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			options.id = args[0]
-			options.cmd = strings.Join(args[1:], " ")
-			args = args[:1]
-			return dingoadm.CheckId(options.id)
+			// select services by role/host/labels, otherwise the first argument is service id
+			flags := cmd.Flags()
+			selected := flags.Changed("role") || flags.Changed("host") || flags.Changed("labels")
+			options.fanout = selected || options.json
+			if selected {
+				options.id = "*"
+				options.cmd = strings.Join(args, " ")
+			} else {
+				options.id = args[0]
+				options.cmd = strings.Join(args[1:], " ")
+			}
+			if options.fanout && len(options.cmd) == 0 {
+				return errno.ERR_EXEC_COMMAND_REQUIRED
+			}
+			return checkCommonOptions(dingoadm, options.id, options.role, options.host, options.labels)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.fanout {
+				return runExecInServices(dingoadm, options)
+			}
 			return runExec(dingoadm, options)
 		},
 		DisableFlagsInUseLine: true,
 	}
 
+	flags := cmd.Flags()
+	// NOTE: options after ID or COMMAND belong to the command
+	flags.SetInterspersed(false)
+	flags.StringVar(&options.role, "role", "*", "Specify service role")
+	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
+	flags.BoolVar(&options.json, "json", false, "Output per-service results in JSON format")
+
 	return cmd
 }
 
@@ -95,3 +144,108 @@ func runExec(dingoadm *cli.DingoAdm, options execOptions) error {
 	// 4) exec cmd in remote container
 	return tools.ExecCmdInRemoteContainer(dingoadm, dc.GetHost(), containerId, options.cmd)
 }
+
+func genExecPlaybook(dingoadm *cli.DingoAdm,
+	dcs []*topology.DeployConfig, options execOptions) (*playbook.Playbook, error) {
+	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
+	})
+	if len(dcs) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED
+	}
+
+	steps := EXEC_PLAYBOOK_STEPS
+	pb := playbook.NewPlaybook(dingoadm)
+	for _, step := range steps {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: dcs,
+			Options: map[string]interface{}{
+				comm.KEY_EXEC_COMMAND: options.cmd,
+			},
+			ExecOptions: playbook.ExecOptions{
+				SilentSubBar:  true,
+				SilentMainBar: options.json,
+				SkipError:     true,
+			},
+		})
+	}
+	return pb, nil
+}
+
+func getExecResults(dingoadm *cli.DingoAdm, dcs []*topology.DeployConfig) []task.ExecResult {
+	m := map[string]task.ExecResult{}
+	v := dingoadm.MemStorage().Get(comm.KEY_ALL_EXEC_RESULTS)
+	if v != nil {
+		m = v.(map[string]task.ExecResult)
+	}
+
+	results := []task.ExecResult{}
+	for _, dc := range dcs {
+		serviceId := dingoadm.GetServiceId(dc.GetId())
+		if result, ok := m[serviceId]; ok {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// exec in services:
+//  1. parse cluster topology
+//  2. generate playbook
+//  3. run playbook
+//  4. output results grouped by service
+//  5. summarize services which exited with non-zero code
+func runExecInServices(dingoadm *cli.DingoAdm, options execOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) generate playbook
+	pb, err := genExecPlaybook(dingoadm, dcs, options)
+	if err != nil {
+		return err
+	}
+
+	// 3) run playbook
+	err = pb.Run()
+	if err != nil {
+		return err
+	}
+
+	// 4) output results grouped by service
+	results := getExecResults(dingoadm, dcs)
+	if options.json {
+		bytes, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		dingoadm.WriteOutln(string(bytes))
+	} else {
+		for _, result := range results {
+			dingoadm.WriteOutln("")
+			dingoadm.WriteOut(tui.FormatExecResult(result))
+		}
+	}
+
+	// 5) summarize services which exited with non-zero code
+	nfailed := 0
+	for _, result := range results {
+		if result.ExitCode != 0 || len(result.Error) > 0 {
+			nfailed++
+		}
+	}
+	if nfailed == 0 {
+		return nil
+	} else if !options.json {
+		dingoadm.WriteOutln("")
+		dingoadm.WriteOut(tui.FormatExecSummary(results))
+	}
+	return errno.ERR_EXEC_COMMAND_FAILED_IN_SERVICES.
+		F("%d of %d services failed", nfailed, len(results))
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package fs
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	task "github.com/dingodb/dingoadm/internal/task/task/fs"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewFSCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fs",
+		Short: "Manage dingofs filesystems",
+		Args:  cliutil.NoArgs,
+		RunE:  cliutil.ShowHelp(dingoadm.Err()),
+	}
+
+	cmd.AddCommand(
+		NewListCommand(dingoadm),
+		NewCreateCommand(dingoadm),
+		NewInfoCommand(dingoadm),
+		NewDeleteCommand(dingoadm),
+		NewQuotaCommand(dingoadm),
+		NewUsageCommand(dingoadm),
+	)
+	return cmd
+}
+
+// getToolDeployConfig returns the service which dingofs tool runs in,
+// mds-client is preferred and mds is the fallback
+func getToolDeployConfig(dingoadm *cli.DingoAdm, dcs []*topology.DeployConfig) (*topology.DeployConfig, error) {
+	for _, role := range []string{topology.ROLE_FS_MDS_CLI, topology.ROLE_FS_MDS} {
+		for _, dc := range dingoadm.FilterDeployConfigByRole(dcs, role) {
+			if dc.GetKind() == topology.KIND_DINGOFS {
+				return dc, nil
+			}
+		}
+	}
+	return nil, errno.ERR_NO_MDS_FOR_DINGOFS_TOOL
+}
+
+func genFSToolPlaybook(dingoadm *cli.DingoAdm,
+	dcs []*topology.DeployConfig,
+	options task.FSOptions) (*playbook.Playbook, error) {
+	dc, err := getToolDeployConfig(dingoadm, dcs)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := playbook.NewPlaybook(dingoadm)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.RUN_FS_TOOL,
+		Configs: []*topology.DeployConfig{dc},
+		Options: map[string]interface{}{
+			comm.KEY_FS_OPTIONS: options,
+		},
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar: true,
+		},
+	})
+	return pb, nil
+}
+
+// runFSTool runs dingofs tool in cluster and returns its result
+func runFSTool(dingoadm *cli.DingoAdm, options task.FSOptions) (task.FSResult, error) {
+	// 1) parse cluster topology
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return task.FSResult{}, err
+	}
+
+	// 2) generate and run playbook
+	pb, err := genFSToolPlaybook(dingoadm, dcs, options)
+	if err != nil {
+		return task.FSResult{}, err
+	}
+	err = pb.Run()
+	if err != nil {
+		return task.FSResult{}, err
+	}
+
+	// 3) fetch result
+	result := task.FSResult{}
+	if v := dingoadm.MemStorage().Get(comm.KEY_FS_RESULT); v != nil {
+		result = v.(task.FSResult)
+	}
+	return result, nil
+}
+
+func getMountedClients(dingoadm *cli.DingoAdm) (map[string][]task.FSClient, error) {
+	clients, err := dingoadm.Storage().GetClients()
+	if err != nil {
+		return nil, errno.ERR_GET_ALL_CLIENTS_FAILED.E(err)
+	}
+	return task.GetMountedClients(clients), nil
+}
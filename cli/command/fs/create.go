@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package fs
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	task "github.com/dingodb/dingoadm/internal/task/task/fs"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CREATE_EXAMPLE = `Examples:
+  $ dingoadm fs create dingofs1 --s3-ak AK --s3-sk SK --s3-endpoint http://127.0.0.1:9000 --s3-bucket bucket1
+  $ dingoadm fs create dingofs1 --storage-type rados --rados-mon 10.0.10.1:6789 --rados-pool pool1 --rados-user admin --rados-key KEY
+  $ dingoadm fs create dingofs1 --capacity 1024 --inodes 1000000  # Create filesystem with quota`
+)
+
+var (
+	// flag name -> storage flag of dingofs tool
+	S3_STORAGE_FLAGS = map[string]string{
+		"s3-ak":       "s3.ak",
+		"s3-sk":       "s3.sk",
+		"s3-endpoint": "s3.endpoint",
+		"s3-bucket":   "s3.bucketname",
+	}
+	RADOS_STORAGE_FLAGS = map[string]string{
+		"rados-mon":     "rados.mon",
+		"rados-pool":    "rados.poolname",
+		"rados-user":    "rados.username",
+		"rados-key":     "rados.key",
+		"rados-cluster": "rados.clustername",
+	}
+)
+
+type createOptions struct {
+	fsname      string
+	storageType string
+	storage     map[string]*string
+	capacity    int64
+	inodes      int64
+}
+
+func NewCreateCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	options := createOptions{storage: map[string]*string{}}
+
+	cmd := &cobra.Command{
+		Use:     "create NAME [OPTIONS]",
+		Short:   "Create filesystem",
+		Args:    cliutil.ExactArgs(1),
+		Example: CREATE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.fsname = args[0]
+			return runCreate(dingoadm, cmd, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.storageType, "storage-type", task.STORAGE_TYPE_S3, "Specify storage type of filesystem (s3/rados)")
+	for name := range S3_STORAGE_FLAGS {
+		options.storage[name] = flags.String(name, "", "Specify S3 "+name[len("s3-"):])
+	}
+	for name := range RADOS_STORAGE_FLAGS {
+		options.storage[name] = flags.String(name, "", "Specify RADOS "+name[len("rados-"):])
+	}
+	flags.Int64Var(&options.capacity, "capacity", 0, "Specify capacity quota of filesystem in GiB")
+	flags.Int64Var(&options.inodes, "inodes", 0, "Specify inodes quota of filesystem")
+
+	return cmd
+}
+
+// getQuotaFlags returns the quota specified by user, QUOTA_UNSET for the absent one
+func getQuotaFlags(cmd *cobra.Command, capacity, inodes int64) (int64, int64, error) {
+	if !cmd.Flags().Changed("capacity") {
+		capacity = task.QUOTA_UNSET
+	} else if capacity < 0 {
+		return 0, 0, errno.ERR_INVALID_FS_QUOTA.F("capacity: %d", capacity)
+	}
+	if !cmd.Flags().Changed("inodes") {
+		inodes = task.QUOTA_UNSET
+	} else if inodes < 0 {
+		return 0, 0, errno.ERR_INVALID_FS_QUOTA.F("inodes: %d", inodes)
+	}
+	return capacity, inodes, nil
+}
+
+func genCreateOptions(cmd *cobra.Command, options createOptions) (task.FSOptions, error) {
+	storageFlags := S3_STORAGE_FLAGS
+	switch options.storageType {
+	case task.STORAGE_TYPE_S3:
+	case task.STORAGE_TYPE_RADOS:
+		storageFlags = RADOS_STORAGE_FLAGS
+	default:
+		return task.FSOptions{}, errno.ERR_UNSUPPORT_STORAGE_TYPE.
+			F("storage type: %s", options.storageType)
+	}
+
+	// NOTE: the absent storage flags fall back to the dingofs tool configure
+	storage := map[string]string{}
+	for name, key := range storageFlags {
+		if cmd.Flags().Changed(name) {
+			storage[key] = *options.storage[name]
+		}
+	}
+	capacity, inodes, err := getQuotaFlags(cmd, options.capacity, options.inodes)
+	if err != nil {
+		return task.FSOptions{}, err
+	}
+	return task.FSOptions{
+		Op:          task.FS_OP_CREATE,
+		FSName:      options.fsname,
+		StorageType: options.storageType,
+		Storage:     storage,
+		Capacity:    capacity,
+		Inodes:      inodes,
+	}, nil
+}
+
+func runCreate(dingoadm *cli.DingoAdm, cmd *cobra.Command, options createOptions) error {
+	// 1) generate create options
+	fsOptions, err := genCreateOptions(cmd, options)
+	if err != nil {
+		return err
+	}
+
+	// 2) create filesystem by dingofs tool
+	_, err = runFSTool(dingoadm, fsOptions)
+	if err != nil {
+		return err
+	}
+
+	// 3) print success prompt
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOutln(color.GreenString("Create filesystem (fsname=%s, storage=%s) success ^_^"),
+		options.fsname, options.storageType)
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package fs
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	task "github.com/dingodb/dingoadm/internal/task/task/fs"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	DELETE_EXAMPLE = `Examples:
+  $ dingoadm fs delete dingofs1     # Delete filesystem 'dingofs1'
+  $ dingoadm fs delete dingofs1 -f  # Delete filesystem 'dingofs1' without confirmation`
+)
+
+type deleteOptions struct {
+	fsname string
+	force  bool
+}
+
+func NewDeleteCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options deleteOptions
+
+	cmd := &cobra.Command{
+		Use:     "delete NAME [OPTIONS]",
+		Aliases: []string{"rm"},
+		Short:   "Delete filesystem",
+		Args:    cliutil.ExactArgs(1),
+		Example: DELETE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.fsname = args[0]
+			return runDelete(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+
+	return cmd
+}
+
+func runDelete(dingoadm *cli.DingoAdm, options deleteOptions) error {
+	// 1) refuse to delete filesystem which still mounted
+	clients, err := getMountedClients(dingoadm)
+	if err != nil {
+		return err
+	} else if n := len(clients[options.fsname]); n > 0 {
+		return errno.ERR_FILESYSTEM_IS_MOUNTED.
+			F("fsname=%s clients=%d, please umount them first", options.fsname, n)
+	}
+
+	// 2) confirm by user
+	if !options.force {
+		if pass := tui.ConfirmYes(tui.PromptDeleteFS(options.fsname)); !pass {
+			dingoadm.WriteOut(tui.PromptCancelOpetation("delete filesystem"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 3) delete filesystem by dingofs tool
+	_, err = runFSTool(dingoadm, task.FSOptions{
+		Op:     task.FS_OP_DELETE,
+		FSName: options.fsname,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 4) print success prompt
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOutln(color.GreenString("Delete filesystem (fsname=%s) success ^_^"), options.fsname)
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package fs
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	task "github.com/dingodb/dingoadm/internal/task/task/fs"
+	"github.com/dingodb/dingoadm/internal/tui"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	INFO_EXAMPLE = `Examples:
+  $ dingoadm fs info dingofs1  # Display filesystem 'dingofs1' and the clients which mounted it`
+)
+
+type infoOptions struct {
+	fsname string
+}
+
+func NewInfoCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options infoOptions
+
+	cmd := &cobra.Command{
+		Use:     "info NAME",
+		Short:   "Display filesystem information",
+		Args:    cliutil.ExactArgs(1),
+		Example: INFO_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.fsname = args[0]
+			return runInfo(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func runInfo(dingoadm *cli.DingoAdm, options infoOptions) error {
+	// 1) query filesystem by dingofs tool
+	result, err := runFSTool(dingoadm, task.FSOptions{
+		Op:     task.FS_OP_INFO,
+		FSName: options.fsname,
+	})
+	if err != nil {
+		return err
+	} else if len(result.FSInfos) == 0 {
+		return errno.ERR_FILESYSTEM_NOT_FOUND.F("fsname=%s", options.fsname)
+	}
+
+	// 2) print filesystem and its mounted clients
+	clients, err := getMountedClients(dingoadm)
+	if err != nil {
+		return err
+	}
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOut("%s", tui.FormatFSInfos(result.FSInfos, clients))
+	mounted := clients[options.fsname]
+	dingoadm.WriteOutln("")
+	if len(mounted) == 0 {
+		dingoadm.WriteOutln("No client mounted filesystem '%s'", options.fsname)
+		return nil
+	}
+	dingoadm.WriteOutln("Mounted clients:")
+	dingoadm.WriteOut("%s", tui.FormatFSClients(mounted))
+	return nil
+}
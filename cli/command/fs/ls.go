@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package fs
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	task "github.com/dingodb/dingoadm/internal/task/task/fs"
+	"github.com/dingodb/dingoadm/internal/tui"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	LIST_EXAMPLE = `Examples:
+  $ dingoadm fs ls  # List all filesystems with their quota and mounted clients`
+)
+
+type listOptions struct{}
+
+func NewListCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     "ls [OPTIONS]",
+		Aliases: []string{"list"},
+		Short:   "List filesystems",
+		Args:    cliutil.NoArgs,
+		Example: LIST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func runList(dingoadm *cli.DingoAdm, options listOptions) error {
+	// 1) list filesystems by dingofs tool
+	result, err := runFSTool(dingoadm, task.FSOptions{Op: task.FS_OP_LIST})
+	if err != nil {
+		return err
+	}
+
+	// 2) print the raw output if it can't be parsed
+	dingoadm.WriteOutln("")
+	if len(result.FSInfos) == 0 {
+		dingoadm.WriteOutln("%s", result.Output)
+		return nil
+	}
+
+	// 3) print filesystems with mounted clients
+	clients, err := getMountedClients(dingoadm)
+	if err != nil {
+		return err
+	}
+	dingoadm.WriteOut("%s", tui.FormatFSInfos(result.FSInfos, clients))
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package fs
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	task "github.com/dingodb/dingoadm/internal/task/task/fs"
+	"github.com/dingodb/dingoadm/internal/tui"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	QUOTA_SET_EXAMPLE = `Examples:
+  $ dingoadm fs quota set dingofs1 --capacity 1024      # Set capacity quota of 'dingofs1' to 1024 GiB
+  $ dingoadm fs quota set dingofs1 --inodes 1000000     # Set inodes quota of 'dingofs1'
+  $ dingoadm fs quota set dingofs1 --capacity 0         # Remove capacity quota of 'dingofs1'`
+
+	QUOTA_GET_EXAMPLE = `Examples:
+  $ dingoadm fs quota get dingofs1  # Display quota of 'dingofs1'`
+)
+
+type quotaSetOptions struct {
+	fsname   string
+	capacity int64
+	inodes   int64
+}
+
+type quotaGetOptions struct {
+	fsname string
+}
+
+func NewQuotaCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quota",
+		Short: "Manage filesystem quota",
+		Args:  cliutil.NoArgs,
+		RunE:  cliutil.ShowHelp(dingoadm.Err()),
+	}
+
+	cmd.AddCommand(
+		NewQuotaSetCommand(dingoadm),
+		NewQuotaGetCommand(dingoadm),
+	)
+	return cmd
+}
+
+func NewQuotaSetCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options quotaSetOptions
+
+	cmd := &cobra.Command{
+		Use:     "set NAME [OPTIONS]",
+		Short:   "Set filesystem quota",
+		Args:    cliutil.ExactArgs(1),
+		Example: QUOTA_SET_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.fsname = args[0]
+			capacity, inodes, err := getQuotaFlags(cmd, options.capacity, options.inodes)
+			if err != nil {
+				return err
+			} else if capacity == task.QUOTA_UNSET && inodes == task.QUOTA_UNSET {
+				return errno.ERR_INVALID_FS_QUOTA.S("at least one of --capacity and --inodes is required")
+			}
+			options.capacity, options.inodes = capacity, inodes
+			return runQuotaSet(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&options.capacity, "capacity", 0, "Specify capacity quota of filesystem in GiB, 0 means unlimited")
+	flags.Int64Var(&options.inodes, "inodes", 0, "Specify inodes quota of filesystem, 0 means unlimited")
+
+	return cmd
+}
+
+func NewQuotaGetCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options quotaGetOptions
+
+	cmd := &cobra.Command{
+		Use:     "get NAME",
+		Short:   "Display filesystem quota",
+		Args:    cliutil.ExactArgs(1),
+		Example: QUOTA_GET_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.fsname = args[0]
+			return runQuotaGet(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func runQuotaSet(dingoadm *cli.DingoAdm, options quotaSetOptions) error {
+	// 1) set quota by dingofs tool
+	_, err := runFSTool(dingoadm, task.FSOptions{
+		Op:       task.FS_OP_QUOTA_SET,
+		FSName:   options.fsname,
+		Capacity: options.capacity,
+		Inodes:   options.inodes,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 2) print success prompt
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOutln(color.GreenString("Set quota of filesystem (fsname=%s) success ^_^"), options.fsname)
+	return nil
+}
+
+func runQuotaGet(dingoadm *cli.DingoAdm, options quotaGetOptions) error {
+	// 1) get quota by dingofs tool
+	result, err := runFSTool(dingoadm, task.FSOptions{
+		Op:     task.FS_OP_QUOTA_GET,
+		FSName: options.fsname,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 2) print quota
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOut("%s", tui.FormatFSUsage(result.FSInfos))
+	return nil
+}
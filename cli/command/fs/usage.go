@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package fs
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	task "github.com/dingodb/dingoadm/internal/task/task/fs"
+	"github.com/dingodb/dingoadm/internal/tui"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	USAGE_EXAMPLE = `Examples:
+  $ dingoadm fs usage            # Display usage of all filesystems
+  $ dingoadm fs usage dingofs1   # Display usage of filesystem 'dingofs1'`
+)
+
+type usageOptions struct {
+	fsname string
+}
+
+func NewUsageCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options usageOptions
+
+	cmd := &cobra.Command{
+		Use:     "usage [NAME]",
+		Short:   "Display filesystem usage",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: USAGE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.fsname = args[0]
+			}
+			return runUsage(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func runUsage(dingoadm *cli.DingoAdm, options usageOptions) error {
+	// 1) get usage by dingofs tool
+	result, err := runFSTool(dingoadm, task.FSOptions{
+		Op:     task.FS_OP_USAGE,
+		FSName: options.fsname,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 2) print usage, the raw output if it can't be parsed
+	dingoadm.WriteOutln("")
+	if len(result.FSInfos) == 0 {
+		dingoadm.WriteOutln("%s", result.Output)
+		return nil
+	}
+	dingoadm.WriteOut("%s", tui.FormatFSUsage(result.FSInfos))
+	return nil
+}
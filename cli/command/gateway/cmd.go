@@ -38,6 +38,7 @@ func NewGatewayCommand(curveadm *cli.DingoAdm) *cobra.Command {
 
 	cmd.AddCommand(
 		NewStartGatewayCommand(curveadm),
+		NewStopGatewayCommand(curveadm),
 	)
 	return cmd
 }
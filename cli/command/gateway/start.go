@@ -23,18 +23,24 @@
 package gateway
 
 import (
+	"encoding/json"
+
 	"github.com/dingodb/dingoadm/cli/cli"
-	comm "github.com/dingodb/dingoadm/internal/common"
 	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/playbook"
+	"github.com/dingodb/dingoadm/internal/task/task/fs"
 	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
 const (
 	START_GATEWAY_EXAMPLE = `Examples:
-  $ dingoadm gateway start --host dingfs1 --listen-address=:9000 --console-address=:9001 --mountpoint=/home/dingofs/client`
+  $ dingoadm gateway start -c gateway.yaml                                         # Start all gateway instances declared in gateway.yaml
+  $ dingoadm gateway start gateway1 -c gateway.yaml                                # Start gateway instance 'gateway1' declared in gateway.yaml
+  $ dingoadm gateway start gateway1 /mnt/dingofs --host dingo7232 -c gateway.yaml  # Start gateway when no instances declared in gateway.yaml`
 )
 
 type startOptions struct {
@@ -54,87 +60,156 @@ func NewStartGatewayCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	var options startOptions
 
 	cmd := &cobra.Command{
-		// Use:     "start --host={hostName} --listen-address={listenAddr} --console-address={consoleAddr} --mountpoint={path} ",
-		Use:     "start {name} {mountpoint} --host dingo7232 -c gateway.yaml ",
-		Short:   "start s3 gateway",
-		Args:    cliutil.ExactArgs(2),
+		Use:     "start [NAME [MOUNT_POINT]] [OPTIONS]",
+		Short:   "Start s3 gateway",
+		Args:    cliutil.RequiresMaxArgs(2),
 		Example: START_GATEWAY_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			options.name = args[0]
-			options.mountPoint = args[1]
+			if len(args) > 0 {
+				options.name = args[0]
+			}
+			if len(args) > 1 {
+				options.mountPoint = args[1]
+			}
 			return runStart(dingoadm, options)
 		},
 		DisableFlagsInUseLine: true,
 	}
 
 	flags := cmd.Flags()
-	flags.StringVar(&options.host, "host", "localhost", "Specify target host")
+	flags.StringVar(&options.host, "host", "localhost", "Specify target host, only for gateway not declared in instances")
 	flags.StringVarP(&options.fileName, "conf", "c", "gateway.yaml", "Specify gateway configuration file")
 
 	return cmd
 }
 
-func runStart(dingoadm *cli.DingoAdm, options startOptions) error {
+// selectGateways returns gateways to start: all declared instances, the instance
+// specified by name, or the one built from arguments if no instance declared
+func selectGateways(gcs []*configure.GatewayConfig, options startOptions) ([]*configure.GatewayConfig, error) {
+	if !gcs[0].IsDeclared() {
+		if len(options.mountPoint) == 0 {
+			return nil, errno.ERR_INVALID_GATEWAY_CONFIGURE.
+				F("no instances declared in %s, please specify name and mount point", options.fileName)
+		}
+		gcs[0].SetInstance(options.name, options.host, options.mountPoint)
+		return gcs, nil
+	} else if len(options.mountPoint) > 0 {
+		return nil, errno.ERR_INVALID_GATEWAY_CONFIGURE.
+			F("instances declared in %s, please specify gateway by name only", options.fileName)
+	} else if len(options.name) == 0 {
+		return gcs, nil
+	}
 
-	// 1) generate mount playbook
-	pb, err := genStartPlaybook(dingoadm, options)
-	if err != nil {
-		return err
+	for _, gc := range gcs {
+		if gc.GetName() == options.name {
+			return []*configure.GatewayConfig{gc}, nil
+		}
 	}
+	return nil, errno.ERR_GATEWAY_INSTANCE_NOT_FOUND.F("name: %s", options.name)
+}
 
-	// 2) run playground
-	err = pb.Run()
+func getStartedGateways(dingoadm *cli.DingoAdm) ([]configure.GatewayInfo, error) {
+	items, err := dingoadm.Storage().GetGateways()
 	if err != nil {
-		return err
+		return nil, errno.ERR_GET_ALL_GATEWAYS_FAILED.E(err)
 	}
+	gateways := []configure.GatewayInfo{}
+	for _, item := range items {
+		gateway := configure.GatewayInfo{}
+		if err := json.Unmarshal([]byte(item.Data), &gateway); err == nil {
+			gateways = append(gateways, gateway)
+		}
+	}
+	return gateways, nil
+}
 
-	// 3) print success prompt
-	// curveadm.WriteOutln(color.GreenString("start gateway success !\n gateway listen address: %s%s \n gateway console address: %s%s \n"),
-	//	options.host, options.listenAddress, options.host, options.consoleAddress)
+// checkGatewayFS checks the filesystem bound to gateway is the one which
+// mounted by client on the same host and mount point
+func checkGatewayFS(dingoadm *cli.DingoAdm, gcs []*configure.GatewayConfig) error {
+	clients, err := dingoadm.Storage().GetClients()
+	if err != nil {
+		return errno.ERR_GET_ALL_CLIENTS_FAILED.E(err)
+	}
+	mounted := map[string]string{} // key: host:mountpoint, value: fsname
+	for _, client := range clients {
+		auxInfo := fs.AuxInfo{}
+		if client.Kind != topology.KIND_DINGOFS ||
+			json.Unmarshal([]byte(client.AuxInfo), &auxInfo) != nil {
+			continue
+		}
+		mounted[client.Host+":"+auxInfo.MountPoint] = auxInfo.FSName
+	}
 
+	for _, gc := range gcs {
+		if len(gc.GetFSName()) == 0 {
+			continue
+		}
+		fsname, ok := mounted[gc.GetHost()+":"+gc.GetMountPoint()]
+		if !ok {
+			return errno.ERR_GATEWAY_FS_NOT_FOUND.
+				F("gateway %s: no client mounted on %s:%s", gc.GetName(), gc.GetHost(), gc.GetMountPoint())
+		} else if fsname != gc.GetFSName() {
+			return errno.ERR_GATEWAY_FS_NOT_FOUND.
+				F("gateway %s: filesystem '%s' mounted on %s:%s, but '%s' expected",
+					gc.GetName(), fsname, gc.GetHost(), gc.GetMountPoint(), gc.GetFSName())
+		}
+	}
 	return nil
 }
 
-func genStartPlaybook(dingoadm *cli.DingoAdm, options startOptions) (*playbook.Playbook, error) {
-	steps := START_GATEWAY_PLAYBOOK_STEPS
-	pb := playbook.NewPlaybook(dingoadm)
+func runStart(dingoadm *cli.DingoAdm, options startOptions) error {
+	// 1) parse gateway configure and select gateways
+	gcs, err := configure.ParseGatewayConfig(options.fileName)
+	if err != nil {
+		return err
+	}
+	for _, warning := range gcs[0].GetWarnings() {
+		dingoadm.WriteOutln(color.YellowString("WARNING: %s: %s"), options.fileName, warning)
+	}
+	gcs, err = selectGateways(gcs, options)
+	if err != nil {
+		return err
+	}
 
-	// parse client configure
-	gc, err := configure.ParseGatewayConfig(options.fileName)
+	// 2) precheck: configure, port conflicts and filesystem
+	started, err := getStartedGateways(dingoadm)
+	if err != nil {
+		return err
+	}
+	err = configure.ValidateGatewayConfigs(gcs, started)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	listenPort := gc.GetListenPort()
-	if listenPort == "" {
-		listenPort = "19000"
+	err = checkGatewayFS(dingoadm, gcs)
+	if err != nil {
+		return err
 	}
-	gatewayListenAddr := ":" + listenPort
 
-	consolePort := gc.GetConsolePort()
-	if consolePort == "" {
-		consolePort = "19001"
+	// 3) generate start playbook
+	pb := genStartPlaybook(dingoadm, gcs)
+
+	// 4) run playground
+	err = pb.Run()
+	if err != nil {
+		return err
 	}
-	gatewayConsoleAddr := ":" + consolePort
 
-	mdsAddr := gc.GetDingofsMDSAddr()
-	if mdsAddr == "" {
-		return nil, errno.ERR_GATEWAY_MDSADDR_EMPTY
+	// 5) print success prompt
+	for _, gc := range gcs {
+		dingoadm.WriteOutln(color.GreenString("Start gateway %s success ^_^ (host=%s listenAddr=%s consoleAddr=%s)"),
+			gc.GetName(), gc.GetHost(), gc.GetListenAddr(), gc.GetConsoleAddr())
 	}
+	return nil
+}
 
+func genStartPlaybook(dingoadm *cli.DingoAdm, gcs []*configure.GatewayConfig) *playbook.Playbook {
+	steps := START_GATEWAY_PLAYBOOK_STEPS
+	pb := playbook.NewPlaybook(dingoadm)
 	for _, step := range steps {
-
 		pb.AddStep(&playbook.PlaybookStep{
 			Type:    step,
-			Configs: gc,
-			Options: map[string]interface{}{
-				comm.GATEWAY_NAME:         options.name,
-				comm.GATEWAY_HOST:         options.host,
-				comm.GATEWAY_LISTEN_ADDR:  gatewayListenAddr,
-				comm.GATEWAY_CONSOLE_ADDR: gatewayConsoleAddr,
-				comm.GATEWAY_MOUNTPOINT:   options.mountPoint,
-				comm.MDSADDR:              mdsAddr,
-			},
+			Configs: gcs,
 		})
 	}
-	return pb, nil
+	return pb
 }
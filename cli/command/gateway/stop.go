@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package gateway
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	STOP_GATEWAY_EXAMPLE = `Examples:
+  $ dingoadm gateway stop gateway1  # Stop gateway 'gateway1' and remove its container`
+)
+
+type stopOptions struct {
+	name string
+}
+
+var (
+	STOP_GATEWAY_PLAYBOOK_STEPS = []int{
+		playbook.STOP_GATEWAY,
+	}
+)
+
+func NewStopGatewayCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options stopOptions
+
+	cmd := &cobra.Command{
+		Use:     "stop NAME",
+		Short:   "Stop s3 gateway",
+		Args:    cliutil.ExactArgs(1),
+		Example: STOP_GATEWAY_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.name = args[0]
+			return runStop(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func getStartedGateway(dingoadm *cli.DingoAdm, name string) (configure.GatewayInfo, error) {
+	started, err := getStartedGateways(dingoadm)
+	if err != nil {
+		return configure.GatewayInfo{}, err
+	}
+	for _, info := range started {
+		if info.Name == name {
+			return info, nil
+		}
+	}
+	return configure.GatewayInfo{}, errno.ERR_GATEWAY_NOT_STARTED.F("name: %s", name)
+}
+
+func genStopPlaybook(dingoadm *cli.DingoAdm, info configure.GatewayInfo) *playbook.Playbook {
+	steps := STOP_GATEWAY_PLAYBOOK_STEPS
+	pb := playbook.NewPlaybook(dingoadm)
+	for _, step := range steps {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: []interface{}{info},
+		})
+	}
+	return pb
+}
+
+func runStop(dingoadm *cli.DingoAdm, options stopOptions) error {
+	// 1) get the gateway recorded when started
+	info, err := getStartedGateway(dingoadm, options.name)
+	if err != nil {
+		return err
+	}
+
+	// 2) generate stop playbook
+	pb := genStopPlaybook(dingoadm, info)
+
+	// 3) run playground
+	err = pb.Run()
+	if err != nil {
+		return err
+	}
+
+	// 4) print success prompt
+	dingoadm.WriteOutln(color.GreenString("Stop gateway %s success ^_^"), info.Name)
+	return nil
+}
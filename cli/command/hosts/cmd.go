@@ -48,6 +48,7 @@ func NewHostsCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 		NewListCommand(dingoadm),
 		NewSSHCommand(dingoadm),
 		NewPlaybookCommand(dingoadm),
+		NewFactsCommand(dingoadm),
 	)
 	return cmd
 }
@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package hosts
+
+import (
+	"encoding/json"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/hosts"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	"github.com/dingodb/dingoadm/internal/tui"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FACTS_EXAMPLE = `Examples:
+  $ dingoadm hosts facts                     # Show facts of all hosts, gather it if not cached
+  $ dingoadm hosts facts --refresh           # Re-gather facts of all hosts
+  $ dingoadm hosts facts --host host1 -v     # Show verbose facts of host 'host1'
+  $ dingoadm hosts facts -l store --json     # Show facts of hosts which belong to label 'store' in JSON`
+)
+
+type factsOptions struct {
+	refresh bool
+	hosts   []string
+	labels  string
+	verbose bool
+	json    bool
+}
+
+func NewFactsCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options factsOptions
+
+	cmd := &cobra.Command{
+		Use:     "facts [OPTIONS]",
+		Short:   "Gather and show host facts",
+		Args:    cliutil.NoArgs,
+		Example: FACTS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFacts(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&options.refresh, "refresh", false, "Re-gather facts even if cached")
+	flags.StringSliceVar(&options.hosts, "host", []string{}, "Specify the host")
+	flags.StringVarP(&options.labels, "labels", "l", "", "Specify the host labels")
+	flags.BoolVarP(&options.verbose, "verbose", "v", false, "Verbose output for facts")
+	flags.BoolVar(&options.json, "json", false, "Output facts in JSON format")
+
+	return cmd
+}
+
+func filterFactsHosts(dingoadm *cli.DingoAdm, options factsOptions) ([]*hosts.HostConfig, error) {
+	data := dingoadm.Hosts()
+	if len(data) == 0 {
+		return []*hosts.HostConfig{}, nil
+	}
+
+	hcs, err := filter(data, hosts.SplitLabels(options.labels))
+	if err != nil || len(options.hosts) == 0 {
+		return hcs, err
+	}
+
+	out := []*hosts.HostConfig{}
+	for _, host := range options.hosts {
+		found := false
+		for _, hc := range hcs {
+			if hc.GetHost() == host {
+				out = append(out, hc)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errno.ERR_HOST_NOT_FOUND.F("host: %s", host)
+		}
+	}
+	return out, nil
+}
+
+func gatherFacts(dingoadm *cli.DingoAdm, hcs []*hosts.HostConfig) error {
+	pb := playbook.NewPlaybook(dingoadm)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.GATHER_HOST_FACTS,
+		Configs: hcs,
+		ExecOptions: playbook.ExecOptions{
+			SkipError: true,
+		},
+	})
+	err := pb.Run()
+	dingoadm.WriteOutln("")
+	return err
+}
+
+func runFacts(dingoadm *cli.DingoAdm, options factsOptions) error {
+	// 1) filter hosts
+	hcs, err := filterFactsHosts(dingoadm, options)
+	if err != nil {
+		return err
+	}
+
+	// 2) gather facts for hosts which not cached (or all hosts if refresh)
+	gather := []*hosts.HostConfig{}
+	for _, hc := range hcs {
+		facts, err := configure.GetHostFacts(dingoadm, hc.GetHost())
+		if err != nil {
+			return err
+		} else if facts == nil || options.refresh {
+			gather = append(gather, hc)
+		}
+	}
+	if len(gather) > 0 {
+		err = gatherFacts(dingoadm, gather)
+	}
+
+	// 3) display facts which gathered successfully
+	facts := []*hosts.HostFacts{}
+	for _, hc := range hcs {
+		f, err := configure.GetHostFacts(dingoadm, hc.GetHost())
+		if err != nil {
+			return err
+		} else if f != nil {
+			facts = append(facts, f)
+		}
+	}
+
+	if options.json {
+		bytes, err := json.MarshalIndent(facts, "", "  ")
+		if err != nil {
+			return errno.ERR_ENCODE_HOST_FACTS_FAILED.E(err)
+		}
+		dingoadm.WriteOutln(string(bytes))
+	} else {
+		dingoadm.WriteOut(tui.FormatHostFacts(facts, options.verbose))
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package maintenance
+
+import (
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	PROMPT_REFRESH_TARGETS_FAILED = "Refresh monitor targets failed, please run 'dingoadm monitor reload --targets' later"
+)
+
+func NewMaintenanceCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Manage maintenance of hosts",
+		Args:  cliutil.NoArgs,
+		RunE:  cliutil.ShowHelp(dingoadm.Err()),
+	}
+
+	cmd.AddCommand(
+		NewEnterCommand(dingoadm),
+		NewExitCommand(dingoadm),
+	)
+	return cmd
+}
+
+// refreshMonitorTargets rewrites scrape targets, which labels targets on hosts
+// in maintenance to suppress their alerts
+func refreshMonitorTargets(dingoadm *cli.DingoAdm) error {
+	if len(dingoadm.Monitor().Monitor) == 0 {
+		return nil
+	}
+	mcs, err := configure.ParseMonitor(dingoadm)
+	if err != nil {
+		return err
+	}
+	ems, err := configure.ParseExternalMonitor(dingoadm, dingoadm.Monitor().Monitor, configure.INFO_TYPE_DATA)
+	if err != nil {
+		return err
+	}
+
+	pb := playbook.NewPlaybook(dingoadm)
+	if len(mcs) > 0 {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.SYNC_MONITOR_CLIENT_TARGET,
+			Configs: mcs,
+		})
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.SYNC_MONITOR_SERVER_TARGET,
+			Configs: mcs,
+		})
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.RELOAD_PROMETHEUS_CONFIG,
+			Configs: mcs,
+		})
+	}
+	if len(ems) > 0 {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.PUBLISH_MONITOR_TARGET,
+			Configs: ems,
+		})
+	}
+	return pb.Run()
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package maintenance
+
+import (
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	log "github.com/dingodb/dingoadm/pkg/log/glg"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ENTER_EXAMPLE = `Examples:
+  $ dingoadm maintenance enter --host server-host1                     # Stop all services on host for maintenance
+  $ dingoadm maintenance enter --host server-host1 --reason "disk swap"  # Record the reason of maintenance`
+)
+
+type enterOptions struct {
+	host    string
+	reason  string
+	timeout time.Duration
+	force   bool
+}
+
+func NewEnterCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options enterOptions
+
+	cmd := &cobra.Command{
+		Use:     "enter [OPTIONS]",
+		Short:   "Put host into maintenance",
+		Args:    cliutil.NoArgs,
+		Example: ENTER_EXAMPLE,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return dingoadm.CheckHost(options.host)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEnter(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.host, "host", "", "Specify host")
+	flags.StringVar(&options.reason, "reason", "", "Specify reason of maintenance")
+	flags.DurationVar(&options.timeout, "timeout", task.DEFAULT_STORE_LEADER_TIMEOUT, "Timeout for waiting leaders transferred")
+	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+	cmd.MarkFlagRequired("host")
+
+	return cmd
+}
+
+func genEnterPlaybook(dingoadm *cli.DingoAdm,
+	dcs, selected []*topology.DeployConfig,
+	options enterOptions) *playbook.Playbook {
+	pb := playbook.NewPlaybook(dingoadm)
+
+	// 1) transfer leaders to stores on other hosts
+	stores := dingoadm.FilterDeployConfigByRole(selected, topology.ROLE_STORE)
+	if len(stores) > 0 {
+		excludes := []int{}
+		for _, dc := range stores {
+			excludes = append(excludes, dc.GetDingoInstanceId())
+		}
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.TRANSFER_STORE_LEADER,
+			Configs: stores,
+			Options: map[string]interface{}{
+				comm.KEY_ALL_DEPLOY_CONFIGS:    dcs,
+				comm.KEY_STORE_LEADER_EXCLUDES: excludes,
+				comm.KEY_STORE_LEADER_TIMEOUT:  options.timeout,
+			},
+		})
+	}
+
+	// 2) stop services tier by tier in reverse order of start
+	for _, tier := range topology.ReverseTiers(topology.SplitTiers(selected)) {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.STOP_SERVICE,
+			Configs: tier,
+		})
+	}
+	return pb
+}
+
+// newMaintenanceInfo records services on host in the order they are stopped
+func newMaintenanceInfo(dingoadm *cli.DingoAdm,
+	selected []*topology.DeployConfig,
+	options enterOptions) configure.MaintenanceInfo {
+	info := configure.MaintenanceInfo{
+		Host:      options.host,
+		Reason:    options.reason,
+		EnterTime: time.Now(),
+	}
+	for _, tier := range topology.ReverseTiers(topology.SplitTiers(selected)) {
+		for _, dc := range tier {
+			info.Services = append(info.Services, dingoadm.GetServiceId(dc.GetId()))
+		}
+	}
+	return info
+}
+
+func runEnter(dingoadm *cli.DingoAdm, options enterOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return err
+	}
+	selected := dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     "*",
+		Role:   "*",
+		Host:   options.host,
+		Labels: "*",
+	})
+	if len(selected) == 0 {
+		return errno.ERR_NO_SERVICES_MATCHED.F("host: %s", options.host)
+	}
+
+	// 2) check whether host already in maintenance
+	maintenances, err := configure.GetMaintenances(dingoadm)
+	if err != nil {
+		return err
+	} else if _, ok := maintenances[options.host]; ok {
+		return errno.ERR_HOST_ALREADY_IN_MAINTENANCE.F("host: %s", options.host)
+	}
+
+	// 3) confirm by user
+	if !options.force {
+		if pass := tui.ConfirmYes(tui.PromptEnterMaintenance(options.host)); !pass {
+			dingoadm.WriteOut(tui.PromptCancelOpetation("enter maintenance"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 4) mark host in maintenance, the mark kept even if stop failed,
+	//    so the mutating commands warn before touching it
+	info := newMaintenanceInfo(dingoadm, selected, options)
+	if err := configure.SaveMaintenance(dingoadm, info); err != nil {
+		return err
+	}
+
+	// 5) transfer leaders and stop services
+	pb := genEnterPlaybook(dingoadm, dcs, selected, options)
+	if err := pb.Run(); err != nil {
+		return err
+	}
+
+	// 6) suppress alerts of targets on host
+	dingoadm.WriteOutln("")
+	if err := refreshMonitorTargets(dingoadm); err != nil {
+		log.Warn("Refresh monitor targets failed", log.Field("Error", err))
+		dingoadm.WriteOutln(PROMPT_REFRESH_TARGETS_FAILED)
+	}
+
+	// 7) print success prompt
+	dingoadm.WriteOutln("Host '%s' entered maintenance, %d services stopped", options.host, len(selected))
+	return nil
+}
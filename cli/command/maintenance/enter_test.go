@@ -0,0 +1,51 @@
+package maintenance
+
+import (
+	"testing"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMaintenanceInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx := topology.NewContext()
+	ctx.Add("host1", "10.0.0.1")
+	dcs, err := topology.ParseTopology(`
+kind: dingo-store
+global:
+  container_image: dingodatabase/dingo-store:latest
+
+coordinator_services:
+  config:
+    server.port: 6500
+    raft.port: 7500
+  deploy:
+    - host: host1
+
+store_services:
+  config:
+    server.port: 6600
+    raft.port: 7600
+  deploy:
+    - host: host1
+`, ctx)
+	assert.Nil(err)
+
+	dingoadm := &cli.DingoAdm{}
+	services := map[string]string{}
+	for _, dc := range dcs {
+		services[dc.GetRole()] = dingoadm.GetServiceId(dc.GetId())
+	}
+
+	// stores stopped before coordinators, whatever the order in topology
+	for _, selected := range [][]*topology.DeployConfig{dcs, {dcs[1], dcs[0]}} {
+		info := newMaintenanceInfo(dingoadm, selected, enterOptions{host: "host1", reason: "disk swap"})
+		assert.Equal("host1", info.Host)
+		assert.Equal("disk swap", info.Reason)
+		assert.False(info.EnterTime.IsZero())
+		assert.Equal([]string{services[topology.ROLE_STORE], services[topology.ROLE_COORDINATOR]}, info.Services)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package maintenance
+
+import (
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	log "github.com/dingodb/dingoadm/pkg/log/glg"
+	"github.com/spf13/cobra"
+)
+
+const (
+	EXIT_EXAMPLE = `Examples:
+  $ dingoadm maintenance exit --host server-host1                # Start all services on host and wait them healthy
+  $ dingoadm maintenance exit --host server-host1 --timeout 5m   # Wait every service healthy at most 5 minutes`
+)
+
+type exitOptions struct {
+	host    string
+	timeout time.Duration
+}
+
+func NewExitCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options exitOptions
+
+	cmd := &cobra.Command{
+		Use:     "exit [OPTIONS]",
+		Short:   "Bring host back from maintenance",
+		Args:    cliutil.NoArgs,
+		Example: EXIT_EXAMPLE,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return dingoadm.CheckHost(options.host)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExit(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.host, "host", "", "Specify host")
+	flags.DurationVar(&options.timeout, "timeout", task.DEFAULT_HEALTH_GATE_TIMEOUT, "Timeout for waiting service healthy")
+	cmd.MarkFlagRequired("host")
+
+	return cmd
+}
+
+func genExitPlaybook(dingoadm *cli.DingoAdm,
+	dcs, selected []*topology.DeployConfig,
+	options exitOptions) *playbook.Playbook {
+	pb := playbook.NewPlaybook(dingoadm)
+
+	// 1) start services tier by tier, the next tier starts
+	//    only after all services in this tier healthy
+	for _, tier := range topology.SplitTiers(selected) {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.START_SERVICE,
+			Configs: tier,
+		})
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.WAIT_SERVICE_HEALTHY,
+			Configs: tier,
+			Options: map[string]interface{}{
+				comm.KEY_HEALTH_GATE_TIMEOUT: options.timeout,
+			},
+		})
+	}
+
+	// 2) bring leaders back to the stores on host
+	coordinators := dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_COORDINATOR)
+	stores := dingoadm.FilterDeployConfigByRole(selected, topology.ROLE_STORE)
+	if len(stores) > 0 && len(coordinators) > 0 {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    playbook.BALANCE_STORE_LEADER,
+			Configs: coordinators[:1],
+			Options: map[string]interface{}{
+				comm.KEY_ALL_DEPLOY_CONFIGS: dcs,
+			},
+		})
+	}
+	return pb
+}
+
+func runExit(dingoadm *cli.DingoAdm, options exitOptions) error {
+	// 1) check whether host in maintenance
+	maintenances, err := configure.GetMaintenances(dingoadm)
+	if err != nil {
+		return err
+	} else if _, ok := maintenances[options.host]; !ok {
+		return errno.ERR_HOST_NOT_IN_MAINTENANCE.F("host: %s", options.host)
+	}
+
+	// 2) parse cluster topology
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return err
+	}
+	selected := dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     "*",
+		Role:   "*",
+		Host:   options.host,
+		Labels: "*",
+	})
+
+	// 3) start services with health gates, the host keeps
+	//    in maintenance until all services back
+	pb := genExitPlaybook(dingoadm, dcs, selected, options)
+	if err := pb.Run(); err != nil {
+		return err
+	}
+
+	// 4) unmark host and recover alerts of targets on host
+	if err := configure.RemoveMaintenance(dingoadm, options.host); err != nil {
+		return err
+	}
+	dingoadm.WriteOutln("")
+	if err := refreshMonitorTargets(dingoadm); err != nil {
+		log.Warn("Refresh monitor targets failed", log.Field("Error", err))
+		dingoadm.WriteOutln(PROMPT_REFRESH_TARGETS_FAILED)
+	}
+
+	// 5) print success prompt
+	dingoadm.WriteOutln("Host '%s' exited maintenance, %d services started", options.host, len(selected))
+	return nil
+}
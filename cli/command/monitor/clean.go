@@ -132,7 +132,7 @@ func runClean(dingoadm *cli.DingoAdm, options cleanOptions) error {
 
 	if !options.force {
 		// 3) confirm by user
-		if pass := tui.ConfirmYes(tui.PromptCleanService(options.role, options.host, options.only)); !pass {
+		if pass := tui.ConfirmYes(tui.PromptCleanService(options.role, options.host, "*", options.only)); !pass {
 			dingoadm.WriteOut(tui.PromptCancelOpetation("clean monitor service"))
 			return errno.ERR_CANCEL_OPERATION
 		}
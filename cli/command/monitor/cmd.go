@@ -50,6 +50,7 @@ func NewMonitorCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 		NewRestartCommand(dingoadm),
 		NewReloadCommand(dingoadm),
 		NewUpgradeCommand(dingoadm),
+		NewSDCommand(dingoadm),
 		config.NewConfigCommand(dingoadm),
 	)
 	return cmd
@@ -50,11 +50,19 @@ var (
 		playbook.CREATE_MONITOR_CONTAINER,
 		playbook.SYNC_MONITOR_ORIGIN_CONFIG,
 		playbook.SYNC_MONITOR_ALT_CONFIG,
+		playbook.SYNC_MONITOR_CLIENT_TARGET,
+		playbook.SYNC_MONITOR_SERVER_TARGET,
 		playbook.CLEAN_CONFIG_CONTAINER,
 		playbook.START_MONITOR_SERVICE,
 		playbook.SYNC_GRAFANA_DASHBOARD,
 		playbook.SYNC_HOSTS_MAPPING,
 	}
+
+	// external prometheus/grafana only get targets and dashboards published
+	MONITOR_EXTERNAL_STEPS = []int{
+		playbook.PUBLISH_MONITOR_TARGET,
+		playbook.PROVISION_GRAFANA_DASHBOARD,
+	}
 )
 
 type deployOptions struct {
@@ -71,6 +79,7 @@ type deployOptions struct {
  *     4.1) start node_exporter container
  *     4.2) start prometheus container
  *     4.3) start grafana container
+ *   5) publish targets and dashboards into external prometheus/grafana
  */
 func NewDeployCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	var options deployOptions
@@ -93,7 +102,7 @@ func NewDeployCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 }
 
 func genDeployPlaybook(dingoadm *cli.DingoAdm,
-	mcs []*configure.MonitorConfig, options deployOptions) (*playbook.Playbook, error) {
+	mcs, ems []*configure.MonitorConfig, options deployOptions) (*playbook.Playbook, error) {
 	steps := MONITOR_DEPLOY_STEPS
 	if options.useLocalImage {
 		// remove PULL_MONITOR_IMAGE step
@@ -122,9 +131,34 @@ func genDeployPlaybook(dingoadm *cli.DingoAdm,
 			Configs: mcs,
 		})
 	}
+	if len(ems) == 0 {
+		return pb, nil
+	}
+	for _, step := range MONITOR_EXTERNAL_STEPS {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: ems,
+		})
+	}
 	return pb, nil
 }
 
+func printExternalHint(dingoadm *cli.DingoAdm, ems []*configure.MonitorConfig) {
+	for _, em := range ems {
+		if em.GetRole() != configure.ROLE_EXTERNAL_PROMETHEUS {
+			continue
+		}
+		switch em.GetSDType() {
+		case configure.SD_TYPE_FILE:
+			dingoadm.WriteOutln("Targets published into %s, add it into 'file_sd_configs' of prometheus",
+				em.GetSDFile(dingoadm.ClusterName()))
+		case configure.SD_TYPE_HTTP:
+			dingoadm.WriteOutln("Run 'dingoadm monitor sd' to serve targets on %s, add it into 'http_sd_configs' of prometheus",
+				em.GetSDListen())
+		}
+	}
+}
+
 func runDeploy(dingoadm *cli.DingoAdm, options deployOptions) error {
 	// 1) parse cluster topology and get services' hosts
 	mcs, err := configure.ParseMonitorInfo(dingoadm, options.filename, configure.INFO_TYPE_FILE)
@@ -132,6 +166,11 @@ func runDeploy(dingoadm *cli.DingoAdm, options deployOptions) error {
 		return err
 	}
 
+	ems, err := configure.ParseExternalMonitor(dingoadm, options.filename, configure.INFO_TYPE_FILE)
+	if err != nil {
+		return err
+	}
+
 	// 2) save monitor data
 	data, err := utils.ReadFile(options.filename)
 	if err != nil {
@@ -146,7 +185,7 @@ func runDeploy(dingoadm *cli.DingoAdm, options deployOptions) error {
 	}
 
 	// 4) generate deploy playbook
-	pb, err := genDeployPlaybook(dingoadm, mcs, options)
+	pb, err := genDeployPlaybook(dingoadm, mcs, ems, options)
 	if err != nil {
 		return err
 	}
@@ -160,5 +199,6 @@ func runDeploy(dingoadm *cli.DingoAdm, options deployOptions) error {
 	// 6) print success prompt
 	dingoadm.WriteOutln("")
 	dingoadm.WriteOutln(color.GreenString("Deploy monitor success ^_^"))
+	printExternalHint(dingoadm, ems)
 	return nil
 }
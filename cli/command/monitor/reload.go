@@ -38,23 +38,41 @@ var (
 	MONITOR_RELOAD_STEPS = []int{
 		playbook.CREATE_MONITOR_CONTAINER,
 		playbook.SYNC_MONITOR_ALT_CONFIG,
+		playbook.SYNC_MONITOR_CLIENT_TARGET,
+		playbook.SYNC_MONITOR_SERVER_TARGET,
 		playbook.CLEAN_CONFIG_CONTAINER,
 		playbook.RESTART_MONITOR_SERVICE,
 	}
+
+	// prometheus watches file_sd targets, but the jobs and alert rules added
+	// into prometheus.yml take effect after reloaded, no restart required
+	MONITOR_RELOAD_TARGET_STEPS = []int{
+		playbook.SYNC_MONITOR_CLIENT_TARGET,
+		playbook.SYNC_MONITOR_SERVER_TARGET,
+		playbook.RELOAD_PROMETHEUS_CONFIG,
+	}
+)
+
+const (
+	RELOAD_EXAMPLE = `Examples:
+  $ dingoadm monitor reload                # reload all monitor services
+  $ dingoadm monitor reload --targets      # refresh scrape targets after mount/umount or maintenance`
 )
 
 type reloadOptions struct {
-	id   string
-	role string
-	host string
+	id      string
+	role    string
+	host    string
+	targets bool
 }
 
 func NewReloadCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	var options reloadOptions
 	cmd := &cobra.Command{
-		Use:   "reload [OPTIONS]",
-		Short: "Reload monitor service",
-		Args:  cliutil.NoArgs,
+		Use:     "reload [OPTIONS]",
+		Short:   "Reload monitor service",
+		Args:    cliutil.NoArgs,
+		Example: RELOAD_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runReload(dingoadm, options)
 		},
@@ -65,25 +83,34 @@ func NewReloadCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify monitor service id")
 	flags.StringVar(&options.role, "role", "*", "Specify monitor service role")
 	flags.StringVar(&options.host, "host", "*", "Specify monitor service host")
+	flags.BoolVar(&options.targets, "targets", false, "Only refresh scrape targets of clients and gateways")
 
 	return cmd
 }
 
 func genReloadPlaybook(dingoadm *cli.DingoAdm,
-	mcs []*configure.MonitorConfig,
+	mcs, ems []*configure.MonitorConfig,
 	options reloadOptions) (*playbook.Playbook, error) {
-	mcs = configure.FilterMonitorConfig(dingoadm, mcs, configure.FilterMonitorOption{
+	filter := configure.FilterMonitorOption{
 		Id:   options.id,
 		Role: options.role,
 		Host: options.host,
-	})
-	if len(mcs) == 0 {
+	}
+	mcs = configure.FilterMonitorConfig(dingoadm, mcs, filter)
+	ems = configure.FilterMonitorConfig(dingoadm, ems, filter)
+	if len(mcs) == 0 && len(ems) == 0 {
 		return nil, errno.ERR_NO_SERVICES_MATCHED
 	}
 
 	steps := MONITOR_RELOAD_STEPS
+	if options.targets {
+		steps = MONITOR_RELOAD_TARGET_STEPS
+	}
 	pb := playbook.NewPlaybook(dingoadm)
 	for _, step := range steps {
+		if len(mcs) == 0 {
+			break
+		}
 		if step == playbook.CREATE_MONITOR_CONTAINER || step == playbook.CLEAN_CONFIG_CONTAINER {
 			pb.AddStep(&playbook.PlaybookStep{
 				Type:    step,
@@ -100,6 +127,20 @@ func genReloadPlaybook(dingoadm *cli.DingoAdm,
 			Configs: mcs,
 		})
 	}
+
+	externalSteps := MONITOR_EXTERNAL_STEPS
+	if options.targets {
+		externalSteps = []int{playbook.PUBLISH_MONITOR_TARGET}
+	}
+	for _, step := range externalSteps {
+		if len(ems) == 0 {
+			break
+		}
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: ems,
+		})
+	}
 	return pb, nil
 }
 
@@ -109,19 +150,28 @@ func runReload(dingoadm *cli.DingoAdm, options reloadOptions) error {
 	if err != nil {
 		return err
 	}
+	ems, err := configure.ParseExternalMonitor(dingoadm, dingoadm.Monitor().Monitor, configure.INFO_TYPE_DATA)
+	if err != nil {
+		return err
+	}
 
 	// 2) generate reload playbook
-	pb, err := genReloadPlaybook(dingoadm, mcs, options)
+	pb, err := genReloadPlaybook(dingoadm, mcs, ems, options)
 	if err != nil {
 		return err
 	}
 
-	// 3) confirm by user
-	if pass := tui.ConfirmYes(tui.PromptReloadService(options.id, options.role, options.host)); !pass {
+	// 3) refresh targets only, which never interrupts service
+	if options.targets {
+		return pb.Run()
+	}
+
+	// 4) confirm by user
+	if pass := tui.ConfirmYes(tui.PromptReloadService(options.id, options.role, options.host, "*")); !pass {
 		dingoadm.WriteOut(tui.PromptCancelOpetation("reload monitor service"))
 		return errno.ERR_CANCEL_OPERATION
 	}
 
-	// 4) run playground
+	// 5) run playground
 	return pb.Run()
 }
@@ -105,7 +105,7 @@ func runRestart(dingoadm *cli.DingoAdm, options restartOptions) error {
 	}
 
 	// 3) confirm by user
-	if pass := tui.ConfirmYes(tui.PromptRestartService(options.id, options.role, options.host)); !pass {
+	if pass := tui.ConfirmYes(tui.PromptRestartService(options.id, options.role, options.host, "*")); !pass {
 		dingoadm.WriteOut(tui.PromptCancelOpetation("restart monitor service"))
 		return errno.ERR_CANCEL_OPERATION
 	}
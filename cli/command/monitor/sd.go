@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package monitor
+
+import (
+	"net/http"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/errno"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	SD_TARGETS_PATH = "/targets"
+
+	SD_EXAMPLE = `Examples:
+  $ dingoadm monitor sd                   # serve targets on the address declared in monitor.yaml
+  $ dingoadm monitor sd --listen :9099    # serve targets on the specified address`
+)
+
+type sdOptions struct {
+	listen string
+}
+
+func NewSDCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options sdOptions
+
+	cmd := &cobra.Command{
+		Use:     "sd [OPTIONS]",
+		Short:   "Serve HTTP service discovery for external prometheus",
+		Args:    cliutil.NoArgs,
+		Example: SD_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSD(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.listen, "listen", "", "Specify listen address, default is 'sd_listen' in monitor.yaml")
+
+	return cmd
+}
+
+func runSD(dingoadm *cli.DingoAdm, options sdOptions) error {
+	// 1) parse external prometheus
+	ems, err := configure.ParseExternalMonitor(dingoadm, dingoadm.Monitor().Monitor, configure.INFO_TYPE_DATA)
+	if err != nil {
+		return err
+	}
+	var em *configure.MonitorConfig
+	for _, mc := range ems {
+		if mc.GetRole() == configure.ROLE_EXTERNAL_PROMETHEUS {
+			em = mc
+		}
+	}
+	if em == nil {
+		return errno.ERR_EXTERNAL_PROMETHEUS_NOT_FOUND
+	}
+
+	// 2) serve targets, which rebuilt on every request to catch up mount/umount
+	mux := http.NewServeMux()
+	mux.HandleFunc(SD_TARGETS_PATH, func(w http.ResponseWriter, r *http.Request) {
+		target, err := configure.ParseExternalTarget(dingoadm, em)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(target))
+	})
+
+	listen := cliutil.Choose(len(options.listen) > 0, options.listen, em.GetSDListen())
+	dingoadm.WriteOutln("Serving prometheus targets on http://%s%s", listen, SD_TARGETS_PATH)
+	err = http.ListenAndServe(listen, mux)
+	return errno.ERR_START_SERVICE_DISCOVERY_FAILED.E(err)
+}
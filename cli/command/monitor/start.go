@@ -105,7 +105,7 @@ func runStart(dingoadm *cli.DingoAdm, options startOptions) error {
 	}
 
 	// 3) confirm by user
-	if pass := tui.ConfirmYes(tui.PromptStartService(options.id, options.role, options.host)); !pass {
+	if pass := tui.ConfirmYes(tui.PromptStartService(options.id, options.role, options.host, "*")); !pass {
 		dingoadm.WriteOut(tui.PromptCancelOpetation("start monitor service"))
 		return errno.ERR_CANCEL_OPERATION
 	}
@@ -108,7 +108,7 @@ func runStop(dingoadm *cli.DingoAdm, options stopOptions) error {
 
 	if !options.force {
 		// 3) confirm by user
-		pass := tui.ConfirmYes(tui.PromptStopService(options.id, options.role, options.host))
+		pass := tui.ConfirmYes(tui.PromptStopService(options.id, options.role, options.host, "*"))
 		if !pass {
 			dingoadm.WriteOut(tui.PromptCancelOpetation("stop monitor service"))
 			return errno.ERR_CANCEL_OPERATION
@@ -112,7 +112,7 @@ func displayTitle(dingoadm *cli.DingoAdm, mcs []*configure.MonitorConfig, option
 	} else {
 		dingoadm.WriteOutln(color.YellowString("Upgrade %d services one by one", total))
 	}
-	dingoadm.WriteOutln(tui.PromptUpgradeService(options.id, options.role, options.host))
+	dingoadm.WriteOutln(tui.PromptUpgradeService(options.id, options.role, options.host, "*"))
 }
 
 func upgradeAtOnce(dingoadm *cli.DingoAdm, mcs []*configure.MonitorConfig, options upgradeOptions) error {
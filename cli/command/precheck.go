@@ -45,7 +45,8 @@ const (
 	PRECHECK_EXAMPLE = `Examples:
   $ dingoadm precheck                         # Check all items
   $ dingoadm precheck --skip topology         # Check all items except topology
-  $ dingoadm precheck --skip topology,kernel  # Check all items except topology and kernel`
+  $ dingoadm precheck --skip topology,kernel  # Check all items except topology and kernel
+  $ dingoadm precheck --force-disk            # Check all items and allow to format non-empty disks`
 )
 
 const (
@@ -56,6 +57,7 @@ const (
 	CHECK_ITEM_NERWORK    = "network"
 	CHECK_ITEM_DATE       = "date"
 	CHECK_ITEM_SERVICE    = "service"
+	CHECK_ITEM_DISK       = "disk"
 )
 
 var (
@@ -70,6 +72,8 @@ var (
 		// playbook.CHECK_NETWORK_FIREWALL,
 		playbook.GET_HOST_DATE, // date
 		playbook.CHECK_HOST_DATE,
+		playbook.CHECK_HOST_MEMORY, // service
+		playbook.CHECK_DISK,        // disk
 	}
 
 	PRECHECK_POST_STEPS = []int{
@@ -88,6 +92,8 @@ var (
 		playbook.CHECK_HOST_DATE:             CHECK_ITEM_DATE,
 		playbook.CHECK_CHUNKFILE_POOL:        CHECK_ITEM_SERVICE,
 		playbook.CHECK_S3:                    CHECK_ITEM_SERVICE,
+		playbook.CHECK_HOST_MEMORY:           CHECK_ITEM_SERVICE,
+		playbook.CHECK_DISK:                  CHECK_ITEM_DISK,
 	}
 
 	CHECK_ITEMS = []string{
@@ -98,6 +104,7 @@ var (
 		CHECK_ITEM_NERWORK,
 		CHECK_ITEM_DATE,
 		CHECK_ITEM_SERVICE,
+		CHECK_ITEM_DISK,
 	}
 )
 
@@ -105,6 +112,7 @@ type precheckOptions struct {
 	skipSnapshotClone bool
 	skip              []string
 	useLocalImage     bool
+	forceDisk         bool
 	//only              []string
 }
 
@@ -139,6 +147,7 @@ func NewPrecheckCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	usage := fmt.Sprintf("Specify skipped check item (%s)", strings.Join(CHECK_ITEMS, ","))
 	flags.StringSliceVar(&options.skip, "skip", []string{}, usage)
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	flags.BoolVar(&options.forceDisk, "force-disk", false, "Allow to format non-empty disks in disk section")
 	//flags.StringSliceVar(&options.only, "only", CHECK_ITEMS, usage)
 
 	return cmd
@@ -192,6 +201,16 @@ func genPrecheckPlaybook(dingoadm *cli.DingoAdm,
 			configs = configs[:1]
 		case playbook.CHECK_CHUNKFILE_POOL:
 			configs = dingoadm.FilterDeployConfigByRole(dcs, ROLE_CHUNKSERVER)
+		case playbook.CHECK_HOST_MEMORY:
+			configs = dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_DINGODB_EXECUTOR)
+			if len(configs) == 0 {
+				continue
+			}
+		case playbook.CHECK_DISK:
+			configs = topology.FilterDiskConfigs(dcs)
+			if len(configs) == 0 {
+				continue
+			}
 		}
 
 		pb.AddStep(&playbook.PlaybookStep{
@@ -202,6 +221,7 @@ func genPrecheckPlaybook(dingoadm *cli.DingoAdm,
 				comm.KEY_CHECK_WITH_WEAK:          false,
 				comm.KEY_CHECK_SKIP_SNAPSHOECLONE: options.skipSnapshotClone,
 				comm.KEY_SKIP_CHECKS_ROLES:        skipRoles,
+				comm.KEY_FORCE_DISK:               options.forceDisk,
 			},
 			ExecOptions: playbook.ExecOptions{
 				SilentSubBar: step == playbook.CHECK_HOST_DATE,
@@ -30,37 +30,57 @@ package command
 
 import (
 	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/playbook"
-	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	"github.com/dingodb/dingoadm/internal/tui"
+	tuicomm "github.com/dingodb/dingoadm/internal/tui/common"
 	cliutil "github.com/dingodb/dingoadm/internal/utils"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
+const (
+	RELOAD_EXAMPLE = `Examples:
+  $ dingoadm reload                      # Reload all services, restart only if changed config can't be hot reloaded
+  $ dingoadm reload --role store         # Reload services which role is 'store'
+  $ dingoadm reload --id <Id> --restart  # Reload service and always restart it`
+)
+
 var (
+	// NOTE: changed gflags of dingo-store and mds v2 are set at runtime,
+	// the others are restarted by RELOAD_RESTART_PLAYBOOK_STEPS
 	RELOAD_PLAYBOOK_STEPS = []int{
+		playbook.DIFF_SERVICE_CONFIG,
 		playbook.SYNC_CONFIG,
+		playbook.HOT_RELOAD_CONFIG,
+	}
+
+	RELOAD_RESTART_PLAYBOOK_STEPS = []int{
 		playbook.RESTART_SERVICE,
 	}
 )
 
 type reloadOptions struct {
-	id   string
-	role string
-	host string
+	id      string
+	role    string
+	host    string
+	labels  string
+	restart bool
 }
 
 func NewReloadCommand(curveadm *cli.DingoAdm) *cobra.Command {
 	var options reloadOptions
 
 	cmd := &cobra.Command{
-		Use:   "reload [OPTIONS]",
-		Short: "Reload service",
-		Args:  cliutil.NoArgs,
+		Use:     "reload [OPTIONS]",
+		Short:   "Reload service",
+		Args:    cliutil.NoArgs,
+		Example: RELOAD_EXAMPLE,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return checkCommonOptions(curveadm, options.id, options.role, options.host)
+			return checkCommonOptions(curveadm, options.id, options.role, options.host, options.labels)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runReload(curveadm, options)
@@ -72,23 +92,30 @@ func NewReloadCommand(curveadm *cli.DingoAdm) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify service id")
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
+	flags.BoolVar(&options.restart, "restart", false, "Restart services even if config hot reloaded or unchanged")
 
 	return cmd
 }
 
-func genReloadPlaybook(curveadm *cli.DingoAdm,
+func filterReloadDeployConfig(curveadm *cli.DingoAdm,
 	dcs []*topology.DeployConfig,
-	options reloadOptions) (*playbook.Playbook, error) {
+	options reloadOptions) ([]*topology.DeployConfig, error) {
 	dcs = curveadm.FilterDeployConfig(dcs, topology.FilterOption{
-		Id:   options.id,
-		Role: options.role,
-		Host: options.host,
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
 	})
 	if len(dcs) == 0 {
 		return nil, errno.ERR_NO_SERVICES_MATCHED
 	}
+	return dcs, nil
+}
 
-	steps := RELOAD_PLAYBOOK_STEPS
+func genReloadPlaybook(curveadm *cli.DingoAdm,
+	dcs []*topology.DeployConfig,
+	steps []int) *playbook.Playbook {
 	pb := playbook.NewPlaybook(curveadm)
 	for _, step := range steps {
 		pb.AddStep(&playbook.PlaybookStep{
@@ -96,9 +123,65 @@ func genReloadPlaybook(curveadm *cli.DingoAdm,
 			Configs: dcs,
 		})
 	}
-	return pb, nil
+	return pb
 }
 
+// genReloadRestartPlaybook restarts services tier by tier as 'restart' does,
+// the next tier restarts after this tier healthy
+func genReloadRestartPlaybook(curveadm *cli.DingoAdm,
+	dcs []*topology.DeployConfig) *playbook.Playbook {
+	pb := playbook.NewPlaybook(curveadm)
+	tiers := topology.SplitTiers(dcs)
+	for i, tier := range tiers {
+		for _, step := range RELOAD_RESTART_PLAYBOOK_STEPS {
+			pb.AddStep(&playbook.PlaybookStep{
+				Type:    step,
+				Configs: tier,
+				Tier:    i,
+			})
+		}
+		if i < len(tiers)-1 {
+			pb.AddStep(&playbook.PlaybookStep{
+				Type:    playbook.WAIT_SERVICE_HEALTHY,
+				Configs: tier,
+				Tier:    i,
+			})
+		}
+	}
+	return pb
+}
+
+func getReloadResults(curveadm *cli.DingoAdm, dcs []*topology.DeployConfig) []task.ReloadResult {
+	m := map[string]task.ReloadResult{}
+	v := curveadm.MemStorage().Get(comm.KEY_ALL_RELOAD_RESULTS)
+	if v != nil {
+		m = v.(map[string]task.ReloadResult)
+	}
+
+	results := []task.ReloadResult{}
+	for _, dc := range dcs {
+		serviceId := curveadm.GetServiceId(dc.GetId())
+		result, ok := m[serviceId]
+		if !ok { // e.g. skipped service
+			result = task.ReloadResult{
+				Id:   serviceId,
+				Role: dc.GetRole(),
+				Host: dc.GetHost(),
+				Path: task.RELOAD_PATH_RESTART,
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// reload:
+//  1. parse cluster topology
+//  2. filter services
+//  3. confirm by user
+//  4. sync config and set changed gflags at runtime
+//  5. restart services which config can't be hot reloaded
+//  6. print the path each service took
 func runReload(curveadm *cli.DingoAdm, options reloadOptions) error {
 	// 1) parse cluster topology
 	dcs, err := curveadm.ParseTopology()
@@ -106,25 +189,48 @@ func runReload(curveadm *cli.DingoAdm, options reloadOptions) error {
 		return err
 	}
 
-	// 2) generate restart playbook
-	pb, err := genReloadPlaybook(curveadm, dcs, options)
+	// 2) filter services
+	dcs, err = filterReloadDeployConfig(curveadm, dcs, options)
 	if err != nil {
 		return err
 	}
+	warnMaintenance(curveadm, dcs, "*", "*", "*", "*")
 
 	// 3) confirm by user
-	if pass := tui.ConfirmYes(tui.PromptReloadService(options.id, options.role, options.host)); !pass {
-		curveadm.WriteOut(tui.PromptCancelOpetation("reload service"))
+	if pass := tuicomm.ConfirmYes(tuicomm.PromptReloadService(options.id, options.role, options.host, options.labels)); !pass {
+		curveadm.WriteOut(tuicomm.PromptCancelOpetation("reload service"))
 		return errno.ERR_CANCEL_OPERATION
 	}
 
-	// 4) run playground
-	err = pb.Run()
+	// 4) sync config and set changed gflags at runtime
+	err = genReloadPlaybook(curveadm, dcs, RELOAD_PLAYBOOK_STEPS).Run()
 	if err != nil {
 		return err
 	}
 
-	// 5) print success prompt
+	// 5) restart services which config can't be hot reloaded
+	results := getReloadResults(curveadm, dcs)
+	restarts := []*topology.DeployConfig{}
+	for i, result := range results {
+		if options.restart && result.Path != task.RELOAD_PATH_RESTART {
+			results[i].Path = task.RELOAD_PATH_RESTART
+			results[i].Reason = "restart required by user"
+		}
+		if results[i].Path == task.RELOAD_PATH_RESTART {
+			restarts = append(restarts, dcs[i])
+		}
+	}
+	if len(restarts) > 0 {
+		curveadm.WriteOutln("")
+		err = genReloadRestartPlaybook(curveadm, restarts).Run()
+		if err != nil {
+			return err
+		}
+	}
+
+	// 6) print the path each service took
+	curveadm.WriteOutln("")
+	curveadm.WriteOut(tui.FormatReloadResults(results))
 	curveadm.WriteOutln("")
 	curveadm.WriteOutln(color.GreenString("Reload success :)"))
 	return nil
@@ -47,10 +47,13 @@ var (
 )
 
 type restartOptions struct {
-	id    string
-	role  string
-	host  string
-	force bool
+	id              string
+	role            string
+	host            string
+	labels          string
+	force           bool
+	transferLeader  bool
+	continueOnError bool
 }
 
 func NewRestartCommand(dingoadm *cli.DingoAdm) *cobra.Command {
@@ -61,7 +64,7 @@ func NewRestartCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 		Short: "Restart service",
 		Args:  cliutil.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return checkCommonOptions(dingoadm, options.id, options.role, options.host)
+			return checkCommonOptions(dingoadm, options.id, options.role, options.host, options.labels)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runRestart(dingoadm, options)
@@ -73,7 +76,10 @@ func NewRestartCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify service id")
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+	flags.BoolVar(&options.continueOnError, "continue-on-error", false, "Go on with the remaining tasks if some tasks failed")
+	flags.BoolVar(&options.transferLeader, "transfer-leader", false, "Transfer region leaders out of store before restart")
 
 	return cmd
 }
@@ -81,22 +87,40 @@ func NewRestartCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 func genRestartPlaybook(dingoadm *cli.DingoAdm,
 	dcs []*topology.DeployConfig,
 	options restartOptions) (*playbook.Playbook, error) {
-	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
-		Id:   options.id,
-		Role: options.role,
-		Host: options.host,
+	selected := dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
 	})
-	if len(dcs) == 0 {
+	if len(selected) == 0 {
 		return nil, errno.ERR_NO_SERVICES_MATCHED
 	}
 
 	steps := RESTART_PLAYBOOK_STEPS
 	pb := playbook.NewPlaybook(dingoadm)
-	for _, step := range steps {
-		pb.AddStep(&playbook.PlaybookStep{
-			Type:    step,
-			Configs: dcs,
-		})
+	if options.transferLeader {
+		if step := genTransferLeaderStep(dcs, selected); step != nil {
+			pb.AddStep(step)
+		}
+	}
+	// restart services tier by tier, the next tier restarts after this tier healthy
+	tiers := topology.SplitTiers(selected)
+	for i, tier := range tiers {
+		for _, step := range steps {
+			pb.AddStep(&playbook.PlaybookStep{
+				Type:    step,
+				Configs: tier,
+				Tier:    i,
+			})
+		}
+		if i < len(tiers)-1 {
+			pb.AddStep(&playbook.PlaybookStep{
+				Type:    playbook.WAIT_SERVICE_HEALTHY,
+				Configs: tier,
+				Tier:    i,
+			})
+		}
 	}
 	return pb, nil
 }
@@ -113,19 +137,20 @@ func runRestart(dingoadm *cli.DingoAdm, options restartOptions) error {
 	if err != nil {
 		return err
 	}
+	warnMaintenance(dingoadm, dcs, options.id, options.role, options.host, options.labels)
 
 	// 3) force restart
 	if options.force {
-		fmt.Print(tui.PromptRestartService(options.id, options.role, options.host))
-		return pb.Run()
+		fmt.Print(tui.PromptRestartService(options.id, options.role, options.host, options.labels))
+		return runPlaybook(dingoadm, pb, options.continueOnError)
 	}
 
 	// 3) confirm by user
-	if pass := tui.ConfirmYes(tui.PromptRestartService(options.id, options.role, options.host)); !pass {
+	if pass := tui.ConfirmYes(tui.PromptRestartService(options.id, options.role, options.host, options.labels)); !pass {
 		dingoadm.WriteOut(tui.PromptCancelOpetation("restart service"))
 		return errno.ERR_CANCEL_OPERATION
 	}
 
 	// 4) run playground
-	return pb.Run()
+	return runPlaybook(dingoadm, pb, options.continueOnError)
 }
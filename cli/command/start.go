@@ -32,10 +32,12 @@ import (
 	"fmt"
 
 	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/playbook"
-	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/tui"
+	tuicomm "github.com/dingodb/dingoadm/internal/tui/common"
 	cliutil "github.com/dingodb/dingoadm/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -47,13 +49,15 @@ var (
 )
 
 type startOptions struct {
-	id    string
-	role  string
-	host  string
-	force bool
+	id              string
+	role            string
+	host            string
+	labels          string
+	force           bool
+	continueOnError bool
 }
 
-func checkCommonOptions(dingoadm *cli.DingoAdm, id, role, host string) error {
+func checkCommonOptions(dingoadm *cli.DingoAdm, id, role, host, labels string) error {
 	items := []struct {
 		key      string
 		callback func(string) error
@@ -61,6 +65,7 @@ func checkCommonOptions(dingoadm *cli.DingoAdm, id, role, host string) error {
 		{id, dingoadm.CheckId},
 		{role, dingoadm.CheckRole},
 		{host, dingoadm.CheckHost},
+		{labels, dingoadm.CheckLabels},
 	}
 
 	for _, item := range items {
@@ -75,6 +80,48 @@ func checkCommonOptions(dingoadm *cli.DingoAdm, id, role, host string) error {
 	return nil
 }
 
+// warnMaintenance warns if the matched services on hosts in maintenance
+func warnMaintenance(dingoadm *cli.DingoAdm, dcs []*topology.DeployConfig, id, role, host, labels string) {
+	maintenances, err := configure.GetMaintenances(dingoadm)
+	if err != nil || len(maintenances) == 0 {
+		return
+	}
+
+	hosts := []string{}
+	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     id,
+		Role:   role,
+		Host:   host,
+		Labels: labels,
+	})
+	for _, dc := range dcs {
+		if _, ok := maintenances[dc.GetHost()]; ok && !cliutil.Contains(hosts, dc.GetHost()) {
+			hosts = append(hosts, dc.GetHost())
+		}
+	}
+	if len(hosts) > 0 {
+		dingoadm.WriteOut(tuicomm.PromptMaintenanceHosts(hosts))
+	}
+}
+
+// runPlaybook runs the playbook, in continue-on-error mode the remaining tasks go on
+// even if some tasks failed, and the failed tasks are listed at last
+func runPlaybook(dingoadm *cli.DingoAdm, pb *playbook.Playbook, continueOnError bool) error {
+	if !continueOnError {
+		return pb.Run()
+	}
+
+	pb.ContinueOnError()
+	err := pb.Run()
+	failures := pb.Failures()
+	if len(failures) == 0 {
+		return err
+	}
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOutln(tui.FormatFailures(failures, dingoadm.LogPath()))
+	return errno.ERR_SOME_TASKS_FAILED.F("%d task(s) failed", len(failures))
+}
+
 func NewStartCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	var options startOptions
 
@@ -83,7 +130,7 @@ func NewStartCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 		Short: "Start service",
 		Args:  cliutil.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return checkCommonOptions(dingoadm, options.id, options.role, options.host)
+			return checkCommonOptions(dingoadm, options.id, options.role, options.host, options.labels)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runStart(dingoadm, options)
@@ -95,7 +142,9 @@ func NewStartCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify service id")
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+	flags.BoolVar(&options.continueOnError, "continue-on-error", false, "Go on with the remaining tasks if some tasks failed")
 
 	return cmd
 }
@@ -104,21 +153,34 @@ func genStartPlaybook(dingoadm *cli.DingoAdm,
 	dcs []*topology.DeployConfig,
 	options startOptions) (*playbook.Playbook, error) {
 	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
-		Id:   options.id,
-		Role: options.role,
-		Host: options.host,
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
 	})
 	if len(dcs) == 0 {
 		return nil, errno.ERR_NO_SERVICES_MATCHED
 	}
 
+	// start services tier by tier, the next tier starts after this tier healthy
 	steps := START_PLAYBOOK_STEPS
 	pb := playbook.NewPlaybook(dingoadm)
-	for _, step := range steps {
-		pb.AddStep(&playbook.PlaybookStep{
-			Type:    step,
-			Configs: dcs,
-		})
+	tiers := topology.SplitTiers(dcs)
+	for i, tier := range tiers {
+		for _, step := range steps {
+			pb.AddStep(&playbook.PlaybookStep{
+				Type:    step,
+				Configs: tier,
+				Tier:    i,
+			})
+		}
+		if i < len(tiers)-1 {
+			pb.AddStep(&playbook.PlaybookStep{
+				Type:    playbook.WAIT_SERVICE_HEALTHY,
+				Configs: tier,
+				Tier:    i,
+			})
+		}
 	}
 	return pb, nil
 }
@@ -135,19 +197,20 @@ func runStart(dingoadm *cli.DingoAdm, options startOptions) error {
 	if err != nil {
 		return err
 	}
+	warnMaintenance(dingoadm, dcs, options.id, options.role, options.host, options.labels)
 
 	// 3) force start
 	if options.force {
-		fmt.Print(tui.PromptStartService(options.id, options.role, options.host))
-		return pb.Run()
+		fmt.Print(tuicomm.PromptStartService(options.id, options.role, options.host, options.labels))
+		return runPlaybook(dingoadm, pb, options.continueOnError)
 	}
 
 	// 3) confirm by user
-	if pass := tui.ConfirmYes(tui.PromptStartService(options.id, options.role, options.host)); !pass {
-		dingoadm.WriteOut(tui.PromptCancelOpetation("start service"))
+	if pass := tuicomm.ConfirmYes(tuicomm.PromptStartService(options.id, options.role, options.host, options.labels)); !pass {
+		dingoadm.WriteOut(tuicomm.PromptCancelOpetation("start service"))
 		return errno.ERR_CANCEL_OPERATION
 	}
 
 	// 4) run playground
-	return pb.Run()
+	return runPlaybook(dingoadm, pb, options.continueOnError)
 }
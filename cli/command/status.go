@@ -34,6 +34,7 @@ import (
 
 	"github.com/dingodb/dingoadm/cli/cli"
 	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/playbook"
@@ -45,6 +46,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	STATUS_SUFFIX_MAINTENANCE = " [maintenance]"
+)
+
 var (
 	GET_STATUS_PLAYBOOK_STEPS = []int{
 		playbook.INIT_SERVIE_STATUS,
@@ -56,6 +61,7 @@ type statusOptions struct {
 	id            string
 	role          string
 	host          string
+	labels        string
 	verbose       bool
 	showInstances bool
 	withCluster   string
@@ -69,6 +75,12 @@ func NewStatusCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 		Use:   "status [OPTIONS]",
 		Short: "Display service status",
 		Args:  cliutil.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if options.labels == "*" {
+				return nil
+			}
+			return dingoadm.CheckLabels(options.labels)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runStatus(dingoadm, options)
 		},
@@ -79,6 +91,7 @@ func NewStatusCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify service id")
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
 	flags.BoolVarP(&options.verbose, "verbose", "v", false, "Verbose output for status")
 	flags.BoolVarP(&options.showInstances, "show-instances", "s", false, "Display service num")
 	flags.StringVarP(&options.withCluster, "with-cluster", "w", "", "Display status of specified cluster with current default cluster")
@@ -153,6 +166,14 @@ func displayStatus(dingoadm *cli.DingoAdm, dcs []*topology.DeployConfig, options
 			statuses = append(statuses, status)
 		}
 	}
+	// mark services on hosts in maintenance
+	maintenanceHosts, _ := configure.GetMaintenanceHosts(dingoadm)
+	for i := range statuses {
+		if utils.Contains(maintenanceHosts, statuses[i].Host) {
+			statuses[i].Status += STATUS_SUFFIX_MAINTENANCE
+		}
+	}
+
 	excludeCols := []string{}
 	roles := dingoadm.GetRoles(dcs)
 	isMdsv2 := dcs[0].GetCtx().Lookup(topology.CTX_KEY_MDS_VERSION) == topology.CTX_VAL_MDS_V2
@@ -221,6 +242,10 @@ func displayStatus(dingoadm *cli.DingoAdm, dcs []*topology.DeployConfig, options
 		dingoadm.WriteOutln("coordinator raft   addr  : %s", getClusterCoorRaftAddr(dcs))
 	}
 
+	if len(maintenanceHosts) > 0 {
+		dingoadm.WriteOutln("maintenance hosts        : %s", strings.Join(maintenanceHosts, ", "))
+	}
+
 	dingoadm.WriteOutln("")
 	dingoadm.WriteOut("%s", output)
 	return width
@@ -230,9 +255,10 @@ func genStatusPlaybook(dingoadm *cli.DingoAdm,
 	dcs []*topology.DeployConfig,
 	options statusOptions) (*playbook.Playbook, error) {
 	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
-		Id:   options.id,
-		Role: options.role,
-		Host: options.host,
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
 	})
 
 	// skip ROLE_TMP dc
@@ -32,6 +32,7 @@ import (
 	"fmt"
 
 	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/playbook"
@@ -47,10 +48,13 @@ var (
 )
 
 type stopOptions struct {
-	id    string
-	role  string
-	host  string
-	force bool
+	id              string
+	role            string
+	host            string
+	labels          string
+	force           bool
+	transferLeader  bool
+	continueOnError bool
 }
 
 func NewStopCommand(dingoadm *cli.DingoAdm) *cobra.Command {
@@ -61,7 +65,7 @@ func NewStopCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 		Short: "Stop service",
 		Args:  cliutil.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return checkCommonOptions(dingoadm, options.id, options.role, options.host)
+			return checkCommonOptions(dingoadm, options.id, options.role, options.host, options.labels)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runStop(dingoadm, options)
@@ -73,30 +77,67 @@ func NewStopCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify service id")
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+	flags.BoolVar(&options.continueOnError, "continue-on-error", false, "Go on with the remaining tasks if some tasks failed")
+	flags.BoolVar(&options.transferLeader, "transfer-leader", false, "Transfer region leaders out of store before stop")
 
 	return cmd
 }
 
+// genTransferLeaderStep returns step which transfers region leaders out of the selected stores,
+// the selected stores never be the target of transfer for they will be stopped together
+func genTransferLeaderStep(dcs, selected []*topology.DeployConfig) *playbook.PlaybookStep {
+	stores := []*topology.DeployConfig{}
+	excludes := []int{}
+	for _, dc := range selected {
+		if dc.GetRole() == topology.ROLE_STORE {
+			stores = append(stores, dc)
+			excludes = append(excludes, dc.GetDingoInstanceId())
+		}
+	}
+	if len(stores) == 0 {
+		return nil
+	}
+
+	return &playbook.PlaybookStep{
+		Type:    playbook.TRANSFER_STORE_LEADER,
+		Configs: stores,
+		Options: map[string]interface{}{
+			comm.KEY_ALL_DEPLOY_CONFIGS:    dcs,
+			comm.KEY_STORE_LEADER_EXCLUDES: excludes,
+		},
+	}
+}
+
 func genStopPlaybook(dingoadm *cli.DingoAdm,
 	dcs []*topology.DeployConfig,
 	options stopOptions) (*playbook.Playbook, error) {
-	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
-		Id:   options.id,
-		Role: options.role,
-		Host: options.host,
+	selected := dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
 	})
-	if len(dcs) == 0 {
+	if len(selected) == 0 {
 		return nil, errno.ERR_NO_SERVICES_MATCHED
 	}
 
 	steps := STOP_PLAYBOOK_STEPS
 	pb := playbook.NewPlaybook(dingoadm)
-	for _, step := range steps {
-		pb.AddStep(&playbook.PlaybookStep{
-			Type:    step,
-			Configs: dcs,
-		})
+	if options.transferLeader {
+		if step := genTransferLeaderStep(dcs, selected); step != nil {
+			pb.AddStep(step)
+		}
+	}
+	// stop services tier by tier in reverse order of start
+	for _, tier := range topology.ReverseTiers(topology.SplitTiers(selected)) {
+		for _, step := range steps {
+			pb.AddStep(&playbook.PlaybookStep{
+				Type:    step,
+				Configs: tier,
+			})
+		}
 	}
 	return pb, nil
 }
@@ -113,20 +154,21 @@ func runStop(dingoadm *cli.DingoAdm, options stopOptions) error {
 	if err != nil {
 		return err
 	}
+	warnMaintenance(dingoadm, dcs, options.id, options.role, options.host, options.labels)
 
 	// 3) force stop
 	if options.force {
-		fmt.Print(tui.PromptStopService(options.id, options.role, options.host))
-		return pb.Run()
+		fmt.Print(tui.PromptStopService(options.id, options.role, options.host, options.labels))
+		return runPlaybook(dingoadm, pb, options.continueOnError)
 	}
 
 	// 3) confirm by user
-	pass := tui.ConfirmYes(tui.PromptStopService(options.id, options.role, options.host))
+	pass := tui.ConfirmYes(tui.PromptStopService(options.id, options.role, options.host, options.labels))
 	if !pass {
 		dingoadm.WriteOut(tui.PromptCancelOpetation("stop service"))
 		return errno.ERR_CANCEL_OPERATION
 	}
 
 	// 4) run playground
-	return pb.Run()
+	return runPlaybook(dingoadm, pb, options.continueOnError)
 }
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package store
+
+import (
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	BALANCE_EXAMPLE = `Examples:
+  $ dingoadm store balance                # Balance region leaders among all stores
+  $ dingoadm store balance --timeout 10m  # Wait leaders balanced at most 10 minutes`
+)
+
+var (
+	BALANCE_LEADER_PLAYBOOK_STEPS = []int{
+		playbook.BALANCE_STORE_LEADER,
+	}
+)
+
+type balanceOptions struct {
+	timeout time.Duration
+}
+
+func NewBalanceCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options balanceOptions
+
+	cmd := &cobra.Command{
+		Use:     "balance [OPTIONS]",
+		Short:   "Balance region leaders among stores",
+		Args:    cliutil.NoArgs,
+		Example: BALANCE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBalance(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.DurationVar(&options.timeout, "timeout", task.DEFAULT_STORE_LEADER_TIMEOUT, "Timeout for waiting leaders balanced")
+
+	return cmd
+}
+
+func genBalancePlaybook(dingoadm *cli.DingoAdm,
+	dcs []*topology.DeployConfig,
+	options balanceOptions) (*playbook.Playbook, error) {
+	if len(dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_STORE)) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED.S("no store in cluster")
+	}
+	coordinators := dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_COORDINATOR)
+	if len(coordinators) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED.S("no coordinator in cluster")
+	}
+
+	// NOTE: balance is cluster level operation which runs in any coordinator
+	steps := BALANCE_LEADER_PLAYBOOK_STEPS
+	pb := playbook.NewPlaybook(dingoadm)
+	for _, step := range steps {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: coordinators[:1],
+			Options: map[string]interface{}{
+				comm.KEY_ALL_DEPLOY_CONFIGS:   dcs,
+				comm.KEY_STORE_LEADER_TIMEOUT: options.timeout,
+			},
+		})
+	}
+	return pb, nil
+}
+
+func runBalance(dingoadm *cli.DingoAdm, options balanceOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) generate balance leader playbook
+	pb, err := genBalancePlaybook(dingoadm, dcs, options)
+	if err != nil {
+		return err
+	}
+
+	// 3) run playbook
+	err = pb.Run()
+	if err != nil {
+		return err
+	}
+
+	// 4) print success prompt
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOutln("Region leaders balanced among stores")
+	return nil
+}
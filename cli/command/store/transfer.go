@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package store
+
+import (
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	cliutil "github.com/dingodb/dingoadm/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	TRANSFER_EXAMPLE = `Examples:
+  $ dingoadm store leader transfer --id c9570da43a5b                # Transfer all leaders out of store
+  $ dingoadm store leader transfer --id c9570da43a5b --timeout 10m  # Wait leaders transferred at most 10 minutes`
+)
+
+var (
+	TRANSFER_LEADER_PLAYBOOK_STEPS = []int{
+		playbook.TRANSFER_STORE_LEADER,
+	}
+)
+
+type transferOptions struct {
+	id      string
+	timeout time.Duration
+}
+
+func NewTransferCommand(dingoadm *cli.DingoAdm) *cobra.Command {
+	var options transferOptions
+
+	cmd := &cobra.Command{
+		Use:     "transfer [OPTIONS]",
+		Short:   "Transfer all region leaders out of store",
+		Args:    cliutil.NoArgs,
+		Example: TRANSFER_EXAMPLE,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return dingoadm.CheckId(options.id)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTransfer(dingoadm, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.id, "id", "", "Specify store service id")
+	flags.DurationVar(&options.timeout, "timeout", task.DEFAULT_STORE_LEADER_TIMEOUT, "Timeout for waiting leaders transferred")
+	cmd.MarkFlagRequired("id")
+
+	return cmd
+}
+
+func genTransferPlaybook(dingoadm *cli.DingoAdm,
+	dcs []*topology.DeployConfig,
+	options transferOptions) (*playbook.Playbook, error) {
+	selected := dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:     options.id,
+		Role:   "*",
+		Host:   "*",
+		Labels: "*",
+	})
+	if len(selected) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED
+	} else if selected[0].GetRole() != topology.ROLE_STORE {
+		return nil, errno.ERR_NOT_STORE_SERVICE.
+			F("service %s is %s", options.id, selected[0].GetRole())
+	}
+
+	steps := TRANSFER_LEADER_PLAYBOOK_STEPS
+	pb := playbook.NewPlaybook(dingoadm)
+	for _, step := range steps {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: selected,
+			Options: map[string]interface{}{
+				comm.KEY_ALL_DEPLOY_CONFIGS:   dcs,
+				comm.KEY_STORE_LEADER_TIMEOUT: options.timeout,
+			},
+		})
+	}
+	return pb, nil
+}
+
+func runTransfer(dingoadm *cli.DingoAdm, options transferOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) generate transfer leader playbook
+	pb, err := genTransferPlaybook(dingoadm, dcs, options)
+	if err != nil {
+		return err
+	}
+
+	// 3) run playbook
+	err = pb.Run()
+	if err != nil {
+		return err
+	}
+
+	// 4) print success prompt
+	dingoadm.WriteOutln("")
+	dingoadm.WriteOutln("All region leaders of store '%s' transferred", options.id)
+	return nil
+}
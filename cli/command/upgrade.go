@@ -70,6 +70,7 @@ type upgradeOptions struct {
 	id            string
 	role          string
 	host          string
+	labels        string
 	force         bool
 	useLocalImage bool
 }
@@ -82,7 +83,7 @@ func NewUpgradeCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 		Short: "Upgrade service",
 		Args:  cliutil.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return checkCommonOptions(dingoadm, options.id, options.role, options.host)
+			return checkCommonOptions(dingoadm, options.id, options.role, options.host, options.labels)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runUpgrade(dingoadm, options)
@@ -94,6 +95,7 @@ func NewUpgradeCommand(dingoadm *cli.DingoAdm) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify service id")
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVarP(&options.labels, "labels", "l", "*", "Specify service host labels")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
 
@@ -104,9 +106,10 @@ func genUpgradePlaybook(dingoadm *cli.DingoAdm,
 	dcs []*topology.DeployConfig,
 	options upgradeOptions) (*playbook.Playbook, error) {
 	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
-		Id:   options.id,
-		Role: options.role,
-		Host: options.host,
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
 	})
 	if len(dcs) == 0 {
 		return nil, errno.ERR_NO_SERVICES_MATCHED
@@ -235,13 +238,15 @@ func runUpgrade(dingoadm *cli.DingoAdm, options upgradeOptions) error {
 
 	// 2) filter deploy config
 	dcs = dingoadm.FilterDeployConfig(dcs, topology.FilterOption{
-		Id:   options.id,
-		Role: options.role,
-		Host: options.host,
+		Id:     options.id,
+		Role:   options.role,
+		Host:   options.host,
+		Labels: options.labels,
 	})
 	if len(dcs) == 0 {
 		return errno.ERR_NO_SERVICES_MATCHED
 	}
+	warnMaintenance(dingoadm, dcs, "*", "*", "*", "*")
 
 	// 3.1) upgrade service at once
 	if options.force {
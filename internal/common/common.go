@@ -79,6 +79,25 @@ const (
 	SERVICE_STATUS_UNKNOWN = "Unknown"
 	SERVICE_DIR_ABSENT     = "-"
 
+	// exec
+	KEY_EXEC_COMMAND     = "EXEC_COMMAND"
+	KEY_ALL_EXEC_RESULTS = "ALL_EXEC_RESULTS"
+
+	// reload
+	KEY_ALL_RELOAD_RESULTS = "ALL_RELOAD_RESULTS"
+
+	// disk
+	KEY_FORCE_DISK      = "FORCE_DISK"
+	KEY_ALL_DISK_STATUS = "ALL_DISK_STATUS"
+
+	// health
+	KEY_ALL_HEALTH_FINDINGS = "ALL_HEALTH_FINDINGS"
+	KEY_HEALTH_GATE_TIMEOUT = "HEALTH_GATE_TIMEOUT"
+
+	// store leader
+	KEY_STORE_LEADER_TIMEOUT  = "STORE_LEADER_TIMEOUT"
+	KEY_STORE_LEADER_EXCLUDES = "STORE_LEADER_EXCLUDES"
+
 	// clean
 	KEY_CLEAN_ITEMS      = "CLEAN_ITEMS"
 	KEY_CLEAN_BY_RECYCLE = "CLEAN_BY_RECYCLE"
@@ -105,6 +124,21 @@ const (
 	KERNERL_MODULE_NBD        = "nbd"
 	KERNERL_MODULE_FUSE       = "fuse"
 
+	// client mount
+	KEY_ALL_CLIENT_MOUNT_STATUS = "ALL_CLIENT_MOUNT_STATUS"
+	KEY_REMOUNT_TIMEOUT         = "REMOUNT_TIMEOUT"
+
+	// client install
+	KEY_INSTALL_CLIENT_OPTIONS = "INSTALL_CLIENT_OPTIONS"
+
+	// client cache
+	KEY_CLIENT_CACHE_OPTIONS    = "CLIENT_CACHE_OPTIONS"
+	KEY_ALL_CLIENT_CACHE_STATUS = "ALL_CLIENT_CACHE_STATUS"
+
+	// fs
+	KEY_FS_OPTIONS = "FS_OPTIONS"
+	KEY_FS_RESULT  = "FS_RESULT"
+
 	// polarfs
 	KEY_POLARFS_HOST   = "POLARFS_HOST"
 	KEY_OS_RELEASE     = "OS_RELEASE"
@@ -132,14 +166,6 @@ const (
 	KEY_MONITOR_STATUS   = "MONITOR_STATUS"
 	CLEANED_MONITOR_CONF = "-"
 
-	// gateway
-	GATEWAY_NAME         = "GATEWAY_NAME"
-	GATEWAY_HOST         = "GATEWAY_HOST"
-	GATEWAY_LISTEN_ADDR  = "GATEWAY_LISTEN_ADDR"
-	GATEWAY_CONSOLE_ADDR = "GATEWAY_CONSOLE_ADDR"
-	GATEWAY_MOUNTPOINT   = "GATEWAY_MOUNTPOINT"
-	MDSADDR              = "mdsaddr"
-
 	// delimiter symbol
 	CLIENT_CONFIG_DELIMITER   = "="
 	TOOLS_V2_CONFIG_DELIMITER = ": "
@@ -29,6 +29,7 @@ package configure
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/dingodb/dingoadm/internal/build"
@@ -67,6 +68,9 @@ const (
 	KEY_QUOTA_CAPACITY = "quota.capacity"
 	KEY_QUOTA_INODES   = "quota.inodes"
 
+	KEY_CLIENT_DUMMY_PORT     = "client.dummyServer.startPort"
+	DEFAULT_CLIENT_DUMMY_PORT = 9000
+
 	DEFAULT_CORE_LOCATE_DIR = "/core"
 
 	FS_TYPE_VKS_V2 = "vfs_v2"
@@ -195,6 +199,59 @@ func ParseClientConfig(filename string, mountFSType string) (*ClientConfig, erro
 	return cfg, nil
 }
 
+// ParseClientConfigData parses client configure from content, e.g. the stored client config
+func ParseClientConfigData(data string, mountFSType string) (*ClientConfig, error) {
+	parser := viper.NewWithOptions(viper.KeyDelimiter("::"))
+	parser.SetConfigType("yaml")
+	parser.SetDefault(KEY_DISK_CACHE_CACHE_DIR, "")
+	err := parser.ReadConfig(bytes.NewBuffer([]byte(data)))
+	if err != nil {
+		return nil, errno.ERR_PARSE_CLIENT_CONFIGURE_FAILED.E(err)
+	}
+
+	m := map[string]interface{}{}
+	err = parser.Unmarshal(&m)
+	if err != nil {
+		return nil, errno.ERR_PARSE_CLIENT_CONFIGURE_FAILED.E(err)
+	}
+
+	cfg, err := NewClientConfig(m, mountFSType)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.data = data
+	return cfg, nil
+}
+
+// IsContainerConfig returns true if the config item takes effect
+// only when client container created, e.g. image, volumes
+func IsContainerConfig(key string) bool {
+	return excludeClientConfig[key]
+}
+
+// ClientConfigChanges returns sorted config items which differ between old and cc
+func ClientConfigChanges(old, cc *ClientConfig) []string {
+	keys := map[string]bool{}
+	for k := range old.config {
+		keys[k] = true
+	}
+	for k := range cc.config {
+		keys[k] = true
+	}
+
+	changes := []string{}
+	for k := range keys {
+		v1, _ := utils.All2Str(old.config[k])
+		v2, _ := utils.All2Str(cc.config[k])
+		if v1 != v2 {
+			changes = append(changes, k)
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
 func (cc *ClientConfig) getString(key string) string {
 	v := cc.config[strings.ToLower(key)]
 	if v == nil {
@@ -237,6 +294,28 @@ func (cc *ClientConfig) GetCoreLocateDir() string            { return DEFAULT_CO
 func (cc *ClientConfig) GetData() string                     { return cc.data }
 func (cc *ClientConfig) GetServiceConfig() map[string]string { return cc.serviceConfig }
 func (cc *ClientConfig) GetVariables() *variable.Variables   { return cc.variables }
+
+// GetDiskCacheDirs returns cache directories in container, the value of
+// disk_cache.cache_dir is like "/path/to/cache1:10240;/path/to/cache2:10240"
+func (cc *ClientConfig) GetDiskCacheDirs() []string {
+	dirs := []string{}
+	for _, item := range strings.Split(cc.getString(KEY_DISK_CACHE_CACHE_DIR), ";") {
+		dir := strings.TrimSpace(strings.SplitN(item, ":", 2)[0])
+		if len(dir) > 0 {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+func (cc *ClientConfig) GetDummyPort() int {
+	port := cc.getDigital(KEY_CLIENT_DUMMY_PORT)
+	if port <= 0 {
+		return DEFAULT_CLIENT_DUMMY_PORT
+	}
+	return port
+}
+
 func (cc *ClientConfig) GetContainerImage() string {
 	containerImage := cc.getString(KEY_CONTAINER_IMAGE)
 	if len(containerImage) == 0 {
@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package configure
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"gopkg.in/yaml.v3"
+)
+
+/*
+ * clients:
+ *   - fsname: fs1
+ *     mountpoint: /mnt/fs1
+ *     config: client.yaml   # relative to the inventory file
+ *     fstype: vfs_v2
+ *     persistent: true
+ *     hosts: [client1]
+ *     labels: [client]      # select hosts which have any of these labels
+ *     overrides:
+ *       client2:
+ *         mountpoint: /data/fs1
+ *         persistent: false
+ */
+type (
+	ClientInventory struct {
+		Clients []ClientInventoryEntry `yaml:"clients"`
+		dir     string
+	}
+
+	ClientInventoryEntry struct {
+		FSName     string                          `yaml:"fsname"`
+		MountPoint string                          `yaml:"mountpoint"`
+		Config     string                          `yaml:"config"`
+		FSType     string                          `yaml:"fstype"`
+		Persistent *bool                           `yaml:"persistent"`
+		Hosts      []string                        `yaml:"hosts"`
+		Labels     []string                        `yaml:"labels"`
+		Overrides  map[string]ClientInventoryEntry `yaml:"overrides"`
+	}
+
+	// ClientMount is the desired mount point of client on a host
+	ClientMount struct {
+		Host       string
+		FSName     string
+		FSType     string
+		MountPoint string
+		Persistent bool
+		Config     *ClientConfig
+	}
+)
+
+func ParseClientInventory(filename string) (*ClientInventory, error) {
+	if !utils.PathExist(filename) {
+		return nil, errno.ERR_PARSE_CLIENT_INVENTORY_FAILED.
+			F("%s: no such file", utils.AbsPath(filename))
+	}
+	data, err := utils.ReadFile(filename)
+	if err != nil {
+		return nil, errno.ERR_PARSE_CLIENT_INVENTORY_FAILED.E(err)
+	}
+
+	inventory := &ClientInventory{}
+	if err := yaml.Unmarshal([]byte(data), inventory); err != nil {
+		return nil, errno.ERR_PARSE_CLIENT_INVENTORY_FAILED.E(err)
+	}
+	inventory.dir = filepath.Dir(filename)
+	return inventory, nil
+}
+
+func selectHosts(entry ClientInventoryEntry, hostLabels map[string][]string) ([]string, error) {
+	selected := map[string]bool{}
+	for _, host := range entry.Hosts {
+		if _, ok := hostLabels[host]; !ok {
+			return nil, errno.ERR_HOST_NOT_FOUND.F("host: %s", host)
+		}
+		selected[host] = true
+	}
+	want := utils.Slice2Map(entry.Labels)
+	for host, labels := range hostLabels {
+		for _, label := range labels {
+			if want[label] {
+				selected[host] = true
+			}
+		}
+	}
+
+	hosts := []string{}
+	for host := range selected {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts, nil
+}
+
+func mergeOverride(entry, override ClientInventoryEntry) ClientInventoryEntry {
+	if len(override.FSName) > 0 {
+		entry.FSName = override.FSName
+	}
+	if len(override.MountPoint) > 0 {
+		entry.MountPoint = override.MountPoint
+	}
+	if len(override.Config) > 0 {
+		entry.Config = override.Config
+	}
+	if len(override.FSType) > 0 {
+		entry.FSType = override.FSType
+	}
+	if override.Persistent != nil {
+		entry.Persistent = override.Persistent
+	}
+	return entry
+}
+
+// Resolve expands entries of inventory into mount points of clients on each host,
+// hostLabels is the labels of all hosts (key: host)
+func (inventory *ClientInventory) Resolve(hostLabels map[string][]string) ([]ClientMount, error) {
+	mounts := []ClientMount{}
+	configs := map[string]*ClientConfig{}
+	exist := map[string]bool{}
+	for i, entry := range inventory.Clients {
+		hosts, err := selectHosts(entry, hostLabels)
+		if err != nil {
+			return nil, err
+		} else if len(hosts) == 0 {
+			return nil, errno.ERR_INVALID_CLIENT_INVENTORY.
+				F("clients[%d]: no host selected", i)
+		}
+		selected := utils.Slice2Map(hosts)
+		for host := range entry.Overrides {
+			if !selected[host] {
+				return nil, errno.ERR_INVALID_CLIENT_INVENTORY.
+					F("clients[%d]: override host '%s' is not selected", i, host)
+			}
+		}
+
+		for _, host := range hosts {
+			e := mergeOverride(entry, entry.Overrides[host])
+			if len(e.FSType) == 0 {
+				e.FSType = FS_TYPE_VKS_V2
+			}
+			mountPoint := utils.TrimSuffixRepeat(e.MountPoint, "/")
+			if len(e.FSName) == 0 || len(e.Config) == 0 {
+				return nil, errno.ERR_INVALID_CLIENT_INVENTORY.
+					F("clients[%d]: fsname and config are required", i)
+			} else if !strings.HasPrefix(mountPoint, "/") {
+				return nil, errno.ERR_FS_MOUNTPOINT_REQUIRE_ABSOLUTE_PATH.
+					F("clients[%d]: mount point: %s", i, e.MountPoint)
+			}
+
+			key := host + ":" + mountPoint
+			if exist[key] {
+				return nil, errno.ERR_DUPLICATE_CLIENT_MOUNT_POINT.
+					F("host: %s, mount point: %s", host, mountPoint)
+			}
+			exist[key] = true
+
+			filename := e.Config
+			if !filepath.IsAbs(filename) {
+				filename = filepath.Join(inventory.dir, filename)
+			}
+			cacheKey := filename + ":" + e.FSType
+			cc, ok := configs[cacheKey]
+			if !ok {
+				cc, err = ParseClientConfig(filename, e.FSType)
+				if err != nil {
+					return nil, err
+				}
+				configs[cacheKey] = cc
+			}
+
+			mounts = append(mounts, ClientMount{
+				Host:       host,
+				FSName:     e.FSName,
+				FSType:     e.FSType,
+				MountPoint: mountPoint,
+				Persistent: e.Persistent != nil && *e.Persistent,
+				Config:     cc,
+			})
+		}
+	}
+	return mounts, nil
+}
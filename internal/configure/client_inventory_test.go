@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package configure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeInventory(t *testing.T, inventory string) string {
+	dir := t.TempDir()
+	client := "kind: dingofs\nmds.addr: 10.0.0.1:7400\n"
+	if err := os.WriteFile(filepath.Join(dir, "client.yaml"), []byte(client), 0644); err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "clients.yaml")
+	if err := os.WriteFile(filename, []byte(inventory), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestResolveClientInventory(t *testing.T) {
+	assert := assert.New(t)
+	hostLabels := map[string][]string{
+		"host1": {"client"},
+		"host2": {"client", "rack=r1"},
+		"host3": {"store"},
+	}
+
+	filename := writeInventory(t, `
+clients:
+  - fsname: fs1
+    mountpoint: /mnt/fs1/
+    config: client.yaml
+    persistent: true
+    labels: [client]
+    overrides:
+      host2:
+        mountpoint: /data/fs1
+        persistent: false
+  - fsname: fs2
+    mountpoint: /mnt/fs2
+    config: client.yaml
+    hosts: [host3]
+`)
+	inventory, err := ParseClientInventory(filename)
+	assert.Nil(err)
+	mounts, err := inventory.Resolve(hostLabels)
+	assert.Nil(err)
+	assert.Len(mounts, 3)
+	assert.Equal("host1", mounts[0].Host)
+	assert.Equal("/mnt/fs1", mounts[0].MountPoint)
+	assert.True(mounts[0].Persistent)
+	assert.Equal(FS_TYPE_VKS_V2, mounts[0].FSType)
+	assert.Equal("host2", mounts[1].Host)
+	assert.Equal("/data/fs1", mounts[1].MountPoint)
+	assert.False(mounts[1].Persistent)
+	assert.Equal("fs2", mounts[2].FSName)
+	assert.Equal("host3", mounts[2].Host)
+	assert.Same(mounts[0].Config, mounts[2].Config)
+
+	// duplicate mount point on same host
+	filename = writeInventory(t, `
+clients:
+  - fsname: fs1
+    mountpoint: /mnt/fs
+    config: client.yaml
+    hosts: [host1]
+  - fsname: fs2
+    mountpoint: /mnt/fs
+    config: client.yaml
+    labels: [client]
+`)
+	inventory, err = ParseClientInventory(filename)
+	assert.Nil(err)
+	_, err = inventory.Resolve(hostLabels)
+	assert.Equal(errno.ERR_DUPLICATE_CLIENT_MOUNT_POINT.GetCode(), err.(*errno.ErrorCode).GetCode())
+
+	// override host which is not selected
+	filename = writeInventory(t, `
+clients:
+  - fsname: fs1
+    mountpoint: /mnt/fs1
+    config: client.yaml
+    hosts: [host1]
+    overrides:
+      host3:
+        mountpoint: /data/fs1
+`)
+	inventory, err = ParseClientInventory(filename)
+	assert.Nil(err)
+	_, err = inventory.Resolve(hostLabels)
+	assert.Equal(errno.ERR_INVALID_CLIENT_INVENTORY.GetCode(), err.(*errno.ErrorCode).GetCode())
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package configure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientConfigChanges(t *testing.T) {
+	assert := assert.New(t)
+
+	old, err := ParseClientConfigData(`
+kind: dingofs
+mds.addr: 10.0.0.1:7400
+container_image: dingodatabase/dingofs:v1
+disk_cache.cache_size_mb: 1024
+`, FS_TYPE_VKS_V2)
+	assert.Nil(err)
+	cc, err := ParseClientConfigData(`
+kind: dingofs
+mds.addr: 10.0.0.1:7400
+container_image: dingodatabase/dingofs:v2
+disk_cache.cache_size_mb: 2048
+quota.capacity: 100
+`, FS_TYPE_VKS_V2)
+	assert.Nil(err)
+
+	changes := ClientConfigChanges(old, cc)
+	assert.Equal([]string{"container_image", "disk_cache.cache_size_mb", "quota.capacity"}, changes)
+	assert.True(IsContainerConfig(changes[0]))
+	assert.False(IsContainerConfig(changes[1]))
+	assert.Empty(ClientConfigChanges(cc, cc))
+}
@@ -44,6 +44,7 @@ import (
  * log_level = error
  * sudo_alias = "sudo"
  * timeout = 180
+ * retry_backoff = 2
  *
  * [ssh_connections]
  * retries = 3
@@ -51,6 +52,13 @@ import (
  *
  * [database]
  * url = "sqlite:///home/curve/.curveadm/data/curveadm.db"
+ *
+ * [step_retries]
+ * pull_image = 2
+ *
+ * [step_timeout]
+ * pull_image = 1800
+ * get_service_status = 30
  */
 const (
 	KEY_LOG_LEVEL    = "log_level"
@@ -58,6 +66,7 @@ const (
 	KEY_ENGINE       = "engine"
 	KEY_TIMEOUT      = "timeout"
 	KEY_AUTO_UPGRADE = "auto_upgrade"
+	KEY_BACKOFF      = "retry_backoff"
 	KEY_SSH_RETRIES  = "retries"
 	KEY_SSH_TIMEOUT  = "timeout"
 	KEY_DB_URL       = "url"
@@ -69,6 +78,15 @@ const (
 	DB_RQLITE    = "rqlite"
 
 	WITHOUT_SUDO = " "
+
+	// idempotent steps of playbook which retries and timeout can be configured for,
+	// steps not listed here use the default timeout and never retry, because retry
+	// re-runs the whole task which may be not idempotent
+	STEP_DEFAULT            = "default"
+	STEP_PULL_IMAGE         = "pull_image"
+	STEP_GET_SERVICE_STATUS = "get_service_status"
+	STEP_GET_CLIENT_STATUS  = "get_client_status"
+	STEP_GET_MONITOR_STATUS = "get_monitor_status"
 )
 
 type (
@@ -81,12 +99,18 @@ type (
 		SSHRetries  int
 		SSHTimeout  int
 		DBUrl       string
+
+		RetryBackoff int            // seconds, doubled after each retry
+		StepRetries  map[string]int // key: step name
+		StepTimeout  map[string]int // key: step name, value: seconds
 	}
 
 	DingoAdm struct {
 		Defaults       map[string]interface{} `mapstructure:"defaults"`
 		SSHConnections map[string]interface{} `mapstructure:"ssh_connections"`
 		DataBase       map[string]interface{} `mapstructure:"database"`
+		StepRetries    map[string]interface{} `mapstructure:"step_retries"`
+		StepTimeout    map[string]interface{} `mapstructure:"step_timeout"`
 	}
 )
 
@@ -99,6 +123,13 @@ var (
 		"warn":  true,
 		"error": true,
 	}
+
+	SUPPORT_STEP_POLICY = map[string]bool{
+		STEP_PULL_IMAGE:         true,
+		STEP_GET_SERVICE_STATUS: true,
+		STEP_GET_CLIENT_STATUS:  true,
+		STEP_GET_MONITOR_STATUS: true,
+	}
 )
 
 func ReplaceGlobals(cfg *DingoAdmConfig) {
@@ -116,6 +147,21 @@ func newDefault() *DingoAdmConfig {
 		SSHRetries:  3,
 		SSHTimeout:  10,
 		DBUrl:       fmt.Sprintf("sqlite://%s/.dingoadm/data/dingoadm.db", home),
+
+		// pulling image is slow and flaky, status probes should fail fast
+		RetryBackoff: 2,
+		StepRetries: map[string]int{
+			STEP_PULL_IMAGE:         2,
+			STEP_GET_SERVICE_STATUS: 1,
+			STEP_GET_CLIENT_STATUS:  1,
+			STEP_GET_MONITOR_STATUS: 1,
+		},
+		StepTimeout: map[string]int{
+			STEP_PULL_IMAGE:         1800,
+			STEP_GET_SERVICE_STATUS: 30,
+			STEP_GET_CLIENT_STATUS:  30,
+			STEP_GET_MONITOR_STATUS: 30,
+		},
 	}
 	return cfg
 }
@@ -133,6 +179,15 @@ func requirePositiveInt(k string, v interface{}) (int, error) {
 	return num, nil
 }
 
+func requireNonNegativeInt(k string, v interface{}) (int, error) {
+	num, ok := utils.Str2Int(v.(string))
+	if !ok || num < 0 {
+		return 0, errno.ERR_CONFIGURE_VALUE_REQUIRES_INTEGER.
+			F("%s: %v, requires non-negative integer", k, v)
+	}
+	return num, nil
+}
+
 func requirePositiveBool(k string, v interface{}) (bool, error) {
 	yes, ok := utils.Str2Bool(v.(string))
 	if !ok {
@@ -181,6 +236,14 @@ func parseDefaultsSection(cfg *DingoAdmConfig, defaults map[string]interface{})
 			}
 			cfg.AutoUpgrade = yes
 
+		// retry backoff
+		case KEY_BACKOFF:
+			num, err := requirePositiveInt(KEY_BACKOFF, v)
+			if err != nil {
+				return err
+			}
+			cfg.RetryBackoff = num
+
 		default:
 			return errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.
 				F("%s: %s", k, v)
@@ -248,6 +311,38 @@ func parseDatabaseSection(cfg *DingoAdmConfig, database map[string]interface{})
 	return nil
 }
 
+func parseStepRetriesSection(cfg *DingoAdmConfig, retries map[string]interface{}) error {
+	for k, v := range retries {
+		if k == STEP_DEFAULT && v == "0" { // generated by old install script, which means no retry
+			continue
+		} else if !SUPPORT_STEP_POLICY[k] { // retry re-runs the whole task, only for idempotent steps
+			return errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.
+				F("%s: %s", k, v)
+		}
+		num, err := requireNonNegativeInt(k, v)
+		if err != nil {
+			return err
+		}
+		cfg.StepRetries[k] = num
+	}
+	return nil
+}
+
+func parseStepTimeoutSection(cfg *DingoAdmConfig, timeout map[string]interface{}) error {
+	for k, v := range timeout {
+		if !SUPPORT_STEP_POLICY[k] { // default timeout is 'timeout' in [defaults]
+			return errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.
+				F("%s: %s", k, v)
+		}
+		num, err := requirePositiveInt(k, v)
+		if err != nil {
+			return err
+		}
+		cfg.StepTimeout[k] = num
+	}
+	return nil
+}
+
 type sectionParser struct {
 	parser  func(*DingoAdmConfig, map[string]interface{}) error
 	section map[string]interface{}
@@ -279,6 +374,8 @@ func ParseDingoAdmConfig(filename string) (*DingoAdmConfig, error) {
 		{parseDefaultsSection, global.Defaults},
 		{parseConnectionSection, global.SSHConnections},
 		{parseDatabaseSection, global.DataBase},
+		{parseStepRetriesSection, global.StepRetries},
+		{parseStepTimeoutSection, global.StepTimeout},
 	}
 	for _, item := range items {
 		err := item.parser(cfg, item.section)
@@ -304,6 +401,38 @@ func (cfg *DingoAdmConfig) GetSudoAlias() string {
 	return cfg.SudoAlias
 }
 
+func (cfg *DingoAdmConfig) GetRetryBackoff() int { return cfg.RetryBackoff }
+
+// GetStepRetries returns retries of the step, 0 if the step not support retry
+func (cfg *DingoAdmConfig) GetStepRetries(step string) int {
+	return cfg.StepRetries[step]
+}
+
+// GetStepTimeout returns timeout (seconds) of commands executed in the step
+func (cfg *DingoAdmConfig) GetStepTimeout(step string) int {
+	if timeout, ok := cfg.StepTimeout[step]; ok {
+		return timeout
+	}
+	return cfg.Timeout
+}
+
+// OverrideStepPolicy overrides retries and timeout of steps, which specified in command line
+func (cfg *DingoAdmConfig) OverrideStepPolicy(retries, timeout map[string]int) error {
+	for k, v := range retries {
+		if !SUPPORT_STEP_POLICY[k] || v < 0 {
+			return errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.F("step retries: %s=%d", k, v)
+		}
+		cfg.StepRetries[k] = v
+	}
+	for k, v := range timeout {
+		if !SUPPORT_STEP_POLICY[k] || v <= 0 {
+			return errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.F("step timeout: %s=%d", k, v)
+		}
+		cfg.StepTimeout[k] = v
+	}
+	return nil
+}
+
 func (cfg *DingoAdmConfig) GetDBUrl() string {
 	return cfg.DBUrl
 }
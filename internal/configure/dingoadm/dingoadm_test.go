@@ -0,0 +1,125 @@
+package dingoadm
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/stretchr/testify/assert"
+)
+
+func errCode(err error) int {
+	return err.(*errno.ErrorCode).GetCode()
+}
+
+func TestParseStepRetriesSection(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := newDefault()
+	err := parseStepRetriesSection(cfg, map[string]interface{}{
+		STEP_PULL_IMAGE:         "5",
+		STEP_GET_SERVICE_STATUS: "0",
+	})
+	assert.Nil(err)
+	assert.Equal(5, cfg.GetStepRetries(STEP_PULL_IMAGE))
+	assert.Equal(0, cfg.GetStepRetries(STEP_GET_SERVICE_STATUS))
+	assert.Equal(1, cfg.GetStepRetries(STEP_GET_CLIENT_STATUS))
+
+	// retry re-runs the whole task, which denied for non-idempotent steps
+	err = parseStepRetriesSection(newDefault(), map[string]interface{}{STEP_DEFAULT: "0"})
+	assert.Nil(err) // generated by old install script
+	err = parseStepRetriesSection(newDefault(), map[string]interface{}{STEP_DEFAULT: "1"})
+	assert.Equal(errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.GetCode(), errCode(err))
+	err = parseStepRetriesSection(newDefault(), map[string]interface{}{"deploy": "1"})
+	assert.Equal(errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.GetCode(), errCode(err))
+
+	err = parseStepRetriesSection(newDefault(), map[string]interface{}{STEP_PULL_IMAGE: "-1"})
+	assert.Equal(errno.ERR_CONFIGURE_VALUE_REQUIRES_INTEGER.GetCode(), errCode(err))
+	err = parseStepRetriesSection(newDefault(), map[string]interface{}{STEP_PULL_IMAGE: "x"})
+	assert.Equal(errno.ERR_CONFIGURE_VALUE_REQUIRES_INTEGER.GetCode(), errCode(err))
+}
+
+func TestGetStepRetries_NotIdempotent(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := newDefault()
+	assert.Equal(0, cfg.GetStepRetries(STEP_DEFAULT))
+	assert.Equal(0, cfg.GetStepRetries("deploy"))
+}
+
+func TestGetStepTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := newDefault()
+	cfg.Timeout = 100
+	assert.Equal(1800, cfg.GetStepTimeout(STEP_PULL_IMAGE))
+	assert.Equal(100, cfg.GetStepTimeout(STEP_DEFAULT))
+	assert.Equal(100, cfg.GetStepTimeout("deploy"))
+
+	err := parseStepTimeoutSection(cfg, map[string]interface{}{STEP_GET_SERVICE_STATUS: "15"})
+	assert.Nil(err)
+	assert.Equal(15, cfg.GetStepTimeout(STEP_GET_SERVICE_STATUS))
+
+	err = parseStepTimeoutSection(cfg, map[string]interface{}{STEP_DEFAULT: "15"})
+	assert.Equal(errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.GetCode(), errCode(err))
+}
+
+func TestOverrideStepPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := newDefault()
+	err := cfg.OverrideStepPolicy(map[string]int{STEP_PULL_IMAGE: 4}, map[string]int{STEP_PULL_IMAGE: 60})
+	assert.Nil(err)
+	assert.Equal(4, cfg.GetStepRetries(STEP_PULL_IMAGE))
+	assert.Equal(60, cfg.GetStepTimeout(STEP_PULL_IMAGE))
+
+	tests := []struct {
+		retries map[string]int
+		timeout map[string]int
+	}{
+		{map[string]int{STEP_DEFAULT: 1}, nil},
+		{map[string]int{"deploy": 1}, nil},
+		{map[string]int{STEP_PULL_IMAGE: -1}, nil},
+		{nil, map[string]int{STEP_DEFAULT: 10}},
+		{nil, map[string]int{STEP_PULL_IMAGE: 0}},
+	}
+	for _, tt := range tests {
+		err := newDefault().OverrideStepPolicy(tt.retries, tt.timeout)
+		assert.Equal(errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.GetCode(), errCode(err),
+			"retries=%v timeout=%v", tt.retries, tt.timeout)
+	}
+}
+
+// readInstallerConfig returns dingoadm.cfg which generated by install script
+func readInstallerConfig(t *testing.T) string {
+	data, err := os.ReadFile("../../../scripts/install_dingoadm.sh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mu := regexp.MustCompile(`(?s)cat << __EOF__ > "\$\{confpath\}"\n(.*?)\n__EOF__`).
+		FindStringSubmatch(string(data))
+	if mu == nil {
+		t.Fatal("dingoadm.cfg template not found in install script")
+	}
+	return mu[1]
+}
+
+func TestParseInstallerConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	data := strings.ReplaceAll(readInstallerConfig(t), "${g_db_path}", "sqlite:///tmp/dingoadm.db")
+	filename := filepath.Join(t.TempDir(), "dingoadm.cfg")
+	if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := ParseDingoAdmConfig(filename)
+	assert.Nil(err)
+	assert.Equal(300, cfg.GetTimeout())
+	assert.Equal(2, cfg.GetStepRetries(STEP_PULL_IMAGE))
+	assert.Equal(0, cfg.GetStepRetries(STEP_DEFAULT))
+	assert.Equal(30, cfg.GetStepTimeout(STEP_GET_SERVICE_STATUS))
+	assert.Equal("sqlite:///tmp/dingoadm.db", cfg.GetDBUrl())
+}
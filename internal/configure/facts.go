@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package configure
+
+import (
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure/hosts"
+	"github.com/dingodb/dingoadm/internal/errno"
+)
+
+const (
+	// cached facts gathered before it are considered stale, which should be re-probed
+	HOST_FACTS_EXPIRE = 24 * time.Hour
+)
+
+// GetHostFacts returns the cached facts of host, nil if never gathered
+func GetHostFacts(dingoadm *cli.DingoAdm, host string) (*hosts.HostFacts, error) {
+	items, err := dingoadm.Storage().GetHostFacts(host)
+	if err != nil {
+		return nil, errno.ERR_GET_HOST_FACTS_FAILED.E(err)
+	} else if len(items) == 0 {
+		return nil, nil
+	}
+	return hosts.DecodeHostFacts(items[0].Data)
+}
+
+// GetFreshHostFacts returns the cached facts of host which not expired,
+// nil if never gathered, stale or broken, caller should probe the host itself
+func GetFreshHostFacts(dingoadm *cli.DingoAdm, host string) *hosts.HostFacts {
+	facts, err := GetHostFacts(dingoadm, host)
+	if err != nil || facts == nil || time.Since(facts.GatheredAt) > HOST_FACTS_EXPIRE {
+		return nil
+	}
+	return facts
+}
+
+// GetAllHostFacts returns the cached facts of all hosts
+func GetAllHostFacts(dingoadm *cli.DingoAdm) ([]*hosts.HostFacts, error) {
+	items, err := dingoadm.Storage().GetAllHostFacts()
+	if err != nil {
+		return nil, errno.ERR_GET_HOST_FACTS_FAILED.E(err)
+	}
+
+	out := []*hosts.HostFacts{}
+	for _, item := range items {
+		facts, err := hosts.DecodeHostFacts(item.Data)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, facts)
+	}
+	return out, nil
+}
@@ -2,109 +2,341 @@ package configure
 
 import (
 	"fmt"
+	"io"
+	"net"
+	"path"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/utils"
-	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
 )
 
 const (
-	KEY_GATEWAY_S3_ROOT_USER                = "s3.root_user"
-	KEY_GATEWAY_S3_ROOT_PASSWORD            = "s3.root_password"
-	KEY_GATEWAY_LISTEN_PORT                 = "gateway.listen_port"
-	KEY_GATEWAY_CONSOLE_PORT                = "gateway.console_port"
-	KEY_DINGOFS_MDS_ADDRS                   = "dingofs.mdsaddr"
-	KEY_GATEWAY_CONTAINER_IMAGE             = "container_image"
-	KEY_GATEWAY_LOG_DIR                     = "log_dir"
-	KEY_GATEWAY_DATA_DIR                    = "data_dir"
-	KEY_GATEWAY_CORE_DIR                    = "core_dir"
 	DEFAULT_DINGOFS_GATEWAY_CONTAINER_IMAGE = "dingodatabase/dingofs:latest"
+	DEFAULT_GATEWAY_LISTEN_PORT             = 19000
+	DEFAULT_GATEWAY_CONSOLE_PORT            = 19001
+
+	// minio requires length of root user and password
+	MIN_GATEWAY_ROOT_USER_LENGTH     = 3
+	MIN_GATEWAY_ROOT_PASSWORD_LENGTH = 8
+)
+
+var (
+	// gateway name is part of container name
+	GATEWAY_NAME_REGEX = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+	// e.g. line 3: field gateway.listen_prot not found in type configure.gatewayFile
+	GATEWAY_UNKNOWN_ITEM_REGEX = regexp.MustCompile(`^line (\d+): field (.+) not found in type`)
 )
 
+/*
+ * container_image: dingodatabase/dingofs:latest
+ * dingofs.mdsaddr: 10.0.0.1:6700,10.0.0.2:6700
+ * s3.root_user: minioadmin
+ * s3.root_password: minioadmin
+ * gateway.listen_port: 19000
+ * gateway.console_port: 19001
+ * gateway.tls_cert_file: /etc/dingofs/public.crt  # optional, on the gateway host
+ * gateway.tls_key_file: /etc/dingofs/private.key
+ * instances:                                      # optional, items above are defaults of instances
+ *   - name: gateway1
+ *     host: server1
+ *     mount_point: /mnt/dingofs
+ *     fsname: dingofs                             # optional, filesystem mounted on mount point
+ *   - name: gateway2
+ *     host: server1
+ *     mount_point: /mnt/dingofs
+ *     gateway.listen_address: 10.0.0.1:19002      # takes precedence over listen_port
+ *     gateway.console_address: 10.0.0.1:19003
+ */
 type (
+	gatewayItems struct {
+		ContainerImage string `yaml:"container_image"`
+		LogDir         string `yaml:"log_dir"`
+		DataDir        string `yaml:"data_dir"`
+		CoreDir        string `yaml:"core_dir"`
+		MDSAddr        string `yaml:"dingofs.mdsaddr"`
+		RootUser       string `yaml:"s3.root_user"`
+		RootPassword   string `yaml:"s3.root_password"`
+		ListenPort     int    `yaml:"gateway.listen_port"`
+		ConsolePort    int    `yaml:"gateway.console_port"`
+		ListenAddress  string `yaml:"gateway.listen_address"`
+		ConsoleAddress string `yaml:"gateway.console_address"`
+		TLSCertFile    string `yaml:"gateway.tls_cert_file"`
+		TLSKeyFile     string `yaml:"gateway.tls_key_file"`
+		FSName         string `yaml:"fsname"`
+	}
+
+	gatewayInstance struct {
+		Name         string `yaml:"name"`
+		Host         string `yaml:"host"`
+		MountPoint   string `yaml:"mount_point"`
+		gatewayItems `yaml:",inline"`
+	}
+
+	gatewayFile struct {
+		gatewayItems `yaml:",inline"`
+		Instances    []gatewayInstance `yaml:"instances"`
+	}
+
 	GatewayConfig struct {
-		configMap map[string]interface{}
-		data      string // configure file content
+		name        string
+		host        string
+		mountPoint  string
+		listenAddr  string
+		consoleAddr string
+		items       gatewayItems
+		declared    bool     // declared in instances
+		data        string   // configure file content
+		warnings    []string // unknown items in configure file, which ignored
+	}
+
+	// GatewayInfo is the gateway record saved in storage once it started
+	GatewayInfo struct {
+		Name        string `json:"name"`
+		Host        string `json:"host"`
+		MountPoint  string `json:"mount_point"`
+		FSName      string `json:"fsname,omitempty"`
+		ListenAddr  string `json:"listen_addr"`
+		ConsoleAddr string `json:"console_addr"`
+		TLS         bool   `json:"tls,omitempty"`
+		ContainerId string `json:"container_id"`
 	}
 )
 
-func NewGatewayConfig(config map[string]interface{}) (*GatewayConfig, error) {
+func newGatewayConfig(instance gatewayInstance, data string) *GatewayConfig {
 	gc := &GatewayConfig{
-		configMap: config,
+		name:       instance.Name,
+		host:       instance.Host,
+		mountPoint: instance.MountPoint,
+		items:      instance.gatewayItems,
+		data:       data,
+	}
+	gc.listenAddr = joinGatewayAddress(instance.ListenAddress, instance.ListenPort, DEFAULT_GATEWAY_LISTEN_PORT)
+	gc.consoleAddr = joinGatewayAddress(instance.ConsoleAddress, instance.ConsolePort, DEFAULT_GATEWAY_CONSOLE_PORT)
+	return gc
+}
+
+func joinGatewayAddress(address string, port, defaultPort int) string {
+	if len(address) > 0 {
+		return address
+	} else if port == 0 {
+		port = defaultPort
+	}
+	return ":" + strconv.Itoa(port)
+}
+
+// getUnknownGatewayItems decodes configure file strictly and returns
+// unknown items, which were accepted by the schemaless parser before
+func getUnknownGatewayItems(data string) []string {
+	decoder := yaml.NewDecoder(strings.NewReader(data))
+	decoder.KnownFields(true)
+	err := decoder.Decode(&gatewayFile{})
+	terr, ok := err.(*yaml.TypeError)
+	if !ok {
+		return nil
 	}
 
-	return gc, nil
+	warnings := []string{}
+	for _, msg := range terr.Errors {
+		mu := GATEWAY_UNKNOWN_ITEM_REGEX.FindStringSubmatch(msg)
+		if mu != nil {
+			warnings = append(warnings, fmt.Sprintf("line %s: unknown item '%s' is ignored", mu[1], mu[2]))
+		}
+	}
+	return warnings
 }
 
-func ParseGatewayConfig(filename string) (*GatewayConfig, error) {
-	// 1. read file content
+// ParseGatewayConfig returns gateway instances declared in configure file,
+// items at top level are defaults of all instances. If no instance declared,
+// it returns one gateway whose name, host and mount point should be set by SetInstance
+func ParseGatewayConfig(filename string) ([]*GatewayConfig, error) {
+	if !utils.PathExist(filename) {
+		return nil, errno.ERR_PARSE_GATEWAY_CONFIGURE_FAILED.
+			F("%s: no such file", utils.AbsPath(filename))
+	}
 	data, err := utils.ReadFile(filename)
 	if err != nil {
 		return nil, errno.ERR_PARSE_GATEWAY_CONFIGURE_FAILED.E(err)
 	}
 
-	// 2. new parser
-	parser := viper.NewWithOptions(viper.KeyDelimiter("::"))
-	parser.SetConfigFile(filename)
-	parser.SetConfigType("yaml")
-	err = parser.ReadInConfig()
-	if err != nil {
+	// 1) decode with schema, unknown item is ignored with warning
+	file := gatewayFile{}
+	decoder := yaml.NewDecoder(strings.NewReader(data))
+	if err := decoder.Decode(&file); err != nil && err != io.EOF {
 		return nil, errno.ERR_PARSE_GATEWAY_CONFIGURE_FAILED.E(err)
 	}
+	warnings := getUnknownGatewayItems(data)
+	if len(file.Instances) == 0 {
+		gc := newGatewayConfig(gatewayInstance{gatewayItems: file.gatewayItems}, data)
+		gc.warnings = warnings
+		return []*GatewayConfig{gc}, nil
+	}
 
-	// 3. parse
-	m := map[string]interface{}{}
-	err = parser.Unmarshal(&m)
-	if err != nil {
+	// 2) decode instances again over the defaults, so instance only overrides items it specified
+	nodes := struct {
+		Instances []yaml.Node `yaml:"instances"`
+	}{}
+	if err := yaml.Unmarshal([]byte(data), &nodes); err != nil {
 		return nil, errno.ERR_PARSE_GATEWAY_CONFIGURE_FAILED.E(err)
 	}
+	gcs := []*GatewayConfig{}
+	for _, node := range nodes.Instances {
+		instance := gatewayInstance{gatewayItems: file.gatewayItems}
+		if err := node.Decode(&instance); err != nil {
+			return nil, errno.ERR_PARSE_GATEWAY_CONFIGURE_FAILED.E(err)
+		}
+		gc := newGatewayConfig(instance, data)
+		gc.declared = true
+		gc.warnings = warnings
+		gcs = append(gcs, gc)
+	}
+	return gcs, nil
+}
 
-	// 4. new config
-	cfg, err := NewGatewayConfig(m)
+func validateGatewayAddress(address string) (string, error) {
+	ip, port, err := net.SplitHostPort(address)
 	if err != nil {
-		return nil, err
+		return "", errno.ERR_INVALID_GATEWAY_ADDRESS.F("%s: %v", address, err)
+	} else if len(ip) > 0 && net.ParseIP(ip) == nil {
+		return "", errno.ERR_INVALID_GATEWAY_ADDRESS.F("%s: invalid ip", address)
+	} else if n, err := strconv.Atoi(port); err != nil || n <= 0 || n > 65535 {
+		return "", errno.ERR_INVALID_GATEWAY_ADDRESS.F("%s: invalid port", address)
 	}
-
-	cfg.data = data
-	return cfg, nil
+	return port, nil
 }
 
-func (gc *GatewayConfig) getString(key string) string {
-	v := gc.configMap[strings.ToLower(key)]
-	if v == nil {
-		return ""
+func (gc *GatewayConfig) validate() error {
+	// instance
+	if !GATEWAY_NAME_REGEX.MatchString(gc.name) {
+		return errno.ERR_INVALID_GATEWAY_CONFIGURE.
+			F("name: '%s', which should match %s", gc.name, GATEWAY_NAME_REGEX.String())
+	} else if len(gc.host) == 0 {
+		return errno.ERR_INVALID_GATEWAY_CONFIGURE.F("gateway %s: host is required", gc.name)
+	} else if !strings.HasPrefix(gc.mountPoint, "/") {
+		return errno.ERR_INVALID_GATEWAY_CONFIGURE.
+			F("gateway %s: mount point '%s' must be an absolute path", gc.name, gc.mountPoint)
+	}
+
+	// dingofs
+	if len(gc.items.MDSAddr) == 0 {
+		return errno.ERR_GATEWAY_MDSADDR_EMPTY.F("gateway %s", gc.name)
+	}
+	for _, addr := range strings.Split(gc.items.MDSAddr, ",") {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return errno.ERR_INVALID_GATEWAY_CONFIGURE.F("gateway %s: dingofs.mdsaddr: %v", gc.name, err)
+		}
+	}
+
+	// addresses
+	listenPort, err := validateGatewayAddress(gc.listenAddr)
+	if err != nil {
+		return err
+	}
+	consolePort, err := validateGatewayAddress(gc.consoleAddr)
+	if err != nil {
+		return err
+	} else if listenPort == consolePort {
+		return errno.ERR_INVALID_GATEWAY_ADDRESS.
+			F("gateway %s: listen and console address use the same port %s", gc.name, listenPort)
+	}
+
+	// credentials
+	if len(gc.items.RootUser) < MIN_GATEWAY_ROOT_USER_LENGTH {
+		return errno.ERR_INVALID_GATEWAY_CREDENTIALS.
+			F("gateway %s: s3.root_user requires at least %d characters", gc.name, MIN_GATEWAY_ROOT_USER_LENGTH)
+	} else if len(gc.items.RootPassword) < MIN_GATEWAY_ROOT_PASSWORD_LENGTH {
+		return errno.ERR_INVALID_GATEWAY_CREDENTIALS.
+			F("gateway %s: s3.root_password requires at least %d characters", gc.name, MIN_GATEWAY_ROOT_PASSWORD_LENGTH)
 	}
-	return fmt.Sprintf("%v", v)
-}
 
-func (gc *GatewayConfig) getBool(key string) bool {
-	v := gc.configMap[strings.ToLower(key)]
-	if v == nil {
-		return false
+	// tls
+	cert, key := gc.items.TLSCertFile, gc.items.TLSKeyFile
+	if (len(cert) > 0) != (len(key) > 0) {
+		return errno.ERR_INVALID_GATEWAY_TLS_CONFIGURE.
+			F("gateway %s: gateway.tls_cert_file and gateway.tls_key_file must be specified together", gc.name)
+	} else if gc.HasTLS() && (!path.IsAbs(cert) || !path.IsAbs(key)) {
+		return errno.ERR_INVALID_GATEWAY_TLS_CONFIGURE.
+			F("gateway %s: certificate and key file must be absolute path", gc.name)
 	}
-	return v.(bool)
+	return nil
 }
 
-func (gc *GatewayConfig) GetS3RootUser() string { return gc.getString(KEY_GATEWAY_S3_ROOT_USER) }
-func (gc *GatewayConfig) GetS3RootPassword() string {
-	return gc.getString(KEY_GATEWAY_S3_ROOT_PASSWORD)
+func getGatewayPorts(host, listenAddr, consoleAddr string) []string {
+	ports := []string{}
+	for _, address := range []string{listenAddr, consoleAddr} {
+		if _, port, err := net.SplitHostPort(address); err == nil {
+			ports = append(ports, fmt.Sprintf("%s:%s", host, port))
+		}
+	}
+	return ports
 }
 
-func (gc *GatewayConfig) GetListenPort() string {
-	return gc.getString(KEY_GATEWAY_LISTEN_PORT)
+// ValidateGatewayConfigs validates each gateway, and checks names and ports of
+// gateways are not conflicting with each other or with started gateways, the
+// gateway already started must be stopped before starting it again
+func ValidateGatewayConfigs(gcs []*GatewayConfig, started []GatewayInfo) error {
+	names := map[string]bool{}
+	owners := map[string]string{} // key: host:port, value: gateway name
+	for _, gc := range gcs {
+		if err := gc.validate(); err != nil {
+			return err
+		} else if names[gc.name] {
+			return errno.ERR_DUPLICATE_GATEWAY_NAME.F("name: %s", gc.name)
+		}
+		names[gc.name] = true
+		for _, key := range getGatewayPorts(gc.host, gc.listenAddr, gc.consoleAddr) {
+			if owner, ok := owners[key]; ok {
+				return errno.ERR_GATEWAY_PORT_CONFLICT.F("%s used by both %s and %s", key, owner, gc.name)
+			}
+			owners[key] = gc.name
+		}
+	}
+
+	for _, info := range started {
+		if names[info.Name] {
+			return errno.ERR_GATEWAY_NAME_ALREADY_STARTED.
+				F("name=%s host=%s mountPoint=%s", info.Name, info.Host, info.MountPoint)
+		}
+		for _, key := range getGatewayPorts(info.Host, info.ListenAddr, info.ConsoleAddr) {
+			if owner, ok := owners[key]; ok {
+				return errno.ERR_GATEWAY_PORT_CONFLICT.
+					F("%s used by %s, which conflicts with started gateway %s", key, owner, info.Name)
+			}
+		}
+	}
+	return nil
 }
 
-func (gc *GatewayConfig) GetConsolePort() string {
-	return gc.getString(KEY_GATEWAY_CONSOLE_PORT)
+// SetInstance sets name, host and mount point for gateway which not declared in instances
+func (gc *GatewayConfig) SetInstance(name, host, mountPoint string) {
+	gc.name = name
+	gc.host = host
+	gc.mountPoint = mountPoint
 }
 
-func (gc *GatewayConfig) GetDataDir() string { return gc.getString(KEY_GATEWAY_DATA_DIR) }
-func (gc *GatewayConfig) GetLogDir() string  { return gc.getString(KEY_GATEWAY_LOG_DIR) }
-func (gc *GatewayConfig) GetCoreDir() string { return gc.getString(KEY_GATEWAY_CORE_DIR) }
-func (gc *GatewayConfig) GetData() string    { return gc.data }
+func (gc *GatewayConfig) IsDeclared() bool          { return gc.declared }
+func (gc *GatewayConfig) GetWarnings() []string     { return gc.warnings }
+func (gc *GatewayConfig) GetName() string           { return gc.name }
+func (gc *GatewayConfig) GetHost() string           { return gc.host }
+func (gc *GatewayConfig) GetMountPoint() string     { return gc.mountPoint }
+func (gc *GatewayConfig) GetFSName() string         { return gc.items.FSName }
+func (gc *GatewayConfig) GetListenAddr() string     { return gc.listenAddr }
+func (gc *GatewayConfig) GetConsoleAddr() string    { return gc.consoleAddr }
+func (gc *GatewayConfig) GetS3RootUser() string     { return gc.items.RootUser }
+func (gc *GatewayConfig) GetS3RootPassword() string { return gc.items.RootPassword }
+func (gc *GatewayConfig) GetTLSCertFile() string    { return gc.items.TLSCertFile }
+func (gc *GatewayConfig) GetTLSKeyFile() string     { return gc.items.TLSKeyFile }
+func (gc *GatewayConfig) HasTLS() bool              { return len(gc.items.TLSCertFile) > 0 }
+func (gc *GatewayConfig) GetDataDir() string        { return gc.items.DataDir }
+func (gc *GatewayConfig) GetLogDir() string         { return gc.items.LogDir }
+func (gc *GatewayConfig) GetCoreDir() string        { return gc.items.CoreDir }
+func (gc *GatewayConfig) GetData() string           { return gc.data }
 func (gc *GatewayConfig) GetContainerImage() string {
-	containerImage := gc.getString(KEY_GATEWAY_CONTAINER_IMAGE)
+	containerImage := gc.items.ContainerImage
 	if len(containerImage) == 0 {
 		containerImage = DEFAULT_DINGOFS_GATEWAY_CONTAINER_IMAGE
 	}
@@ -112,5 +344,5 @@ func (gc *GatewayConfig) GetContainerImage() string {
 }
 
 func (gc *GatewayConfig) GetDingofsMDSAddr() string {
-	return gc.getString(KEY_DINGOFS_MDS_ADDRS)
+	return gc.items.MDSAddr
 }
@@ -1,12 +1,158 @@
 package configure
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeGatewayFile(t *testing.T, data string) string {
+	filename := filepath.Join(t.TempDir(), "gateway.yaml")
+	if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+const GATEWAY_COMMON_ITEMS = `dingofs.mdsaddr: 10.0.0.1:6700,10.0.0.2:6700
+s3.root_user: minioadmin
+`
 
 func TestParseGatewayFile(t *testing.T) {
-	fileName := "/home/dongw/dingofs/gateway.yaml"
-	gc, err := ParseGatewayConfig(fileName)
-	if err != nil {
-		t.Errorf("parse gateway config file failed: %v", err)
+	assert := assert.New(t)
+
+	gcs, err := ParseGatewayConfig(writeGatewayFile(t, GATEWAY_COMMON_ITEMS+`
+s3.root_password: minioadmin
+gateway.listen_port: 29000
+`))
+	assert.Nil(err)
+	assert.Len(gcs, 1)
+	gcs[0].SetInstance("gateway1", "host1", "/mnt/dingofs")
+	assert.Nil(ValidateGatewayConfigs(gcs, nil))
+	assert.Equal(":29000", gcs[0].GetListenAddr())
+	assert.Equal(":19001", gcs[0].GetConsoleAddr())
+	assert.Equal(DEFAULT_DINGOFS_GATEWAY_CONTAINER_IMAGE, gcs[0].GetContainerImage())
+	assert.False(gcs[0].HasTLS())
+
+	// unknown item is ignored with warning
+	gcs, err = ParseGatewayConfig(writeGatewayFile(t, GATEWAY_COMMON_ITEMS+`
+s3.root_password: minioadmin
+gateway.listen_prot: 29000
+`))
+	assert.Nil(err)
+	assert.Equal([]string{"line 5: unknown item 'gateway.listen_prot' is ignored"}, gcs[0].GetWarnings())
+	assert.Equal(":19000", gcs[0].GetListenAddr())
+
+	_, err = ParseGatewayConfig(writeGatewayFile(t, GATEWAY_COMMON_ITEMS+`
+s3.root_password: [minioadmin
+`))
+	assert.Equal(errno.ERR_PARSE_GATEWAY_CONFIGURE_FAILED.GetCode(), err.(*errno.ErrorCode).GetCode())
+}
+
+func TestParseGatewayInstances(t *testing.T) {
+	assert := assert.New(t)
+
+	gcs, err := ParseGatewayConfig(writeGatewayFile(t, GATEWAY_COMMON_ITEMS+`
+s3.root_password: minioadmin
+gateway.tls_cert_file: /etc/dingofs/public.crt
+gateway.tls_key_file: /etc/dingofs/private.key
+instances:
+  - name: gateway1
+    host: host1
+    mount_point: /mnt/dingofs
+    fsname: fs1
+  - name: gateway2
+    host: host1
+    mount_point: /mnt/dingofs
+    gateway.listen_address: 10.0.0.1:19002
+    gateway.console_address: 10.0.0.1:19003
+    s3.root_user: admin2
+`))
+	assert.Nil(err)
+	assert.Len(gcs, 2)
+	assert.Nil(ValidateGatewayConfigs(gcs, nil))
+	assert.Equal("fs1", gcs[0].GetFSName())
+	assert.Equal(":19000", gcs[0].GetListenAddr())
+	assert.Equal("minioadmin", gcs[0].GetS3RootUser())
+	assert.Equal("10.0.0.1:19002", gcs[1].GetListenAddr())
+	assert.Equal("admin2", gcs[1].GetS3RootUser())
+	assert.Equal("minioadmin", gcs[1].GetS3RootPassword())
+	assert.True(gcs[1].HasTLS())
+
+	// conflicts with started gateway on the same host
+	started := []GatewayInfo{
+		{Name: "gateway3", Host: "host1", ListenAddr: ":19003", ConsoleAddr: ":19004"},
 	}
-	t.Logf("gateway config: %v", gc)
+	err = ValidateGatewayConfigs(gcs, started)
+	assert.Equal(errno.ERR_GATEWAY_PORT_CONFLICT.GetCode(), err.(*errno.ErrorCode).GetCode())
+	started[0].Host = "host2"
+	assert.Nil(ValidateGatewayConfigs(gcs, started))
+
+	// gateway with the same name already started
+	started[0].Name = gcs[0].GetName()
+	err = ValidateGatewayConfigs(gcs, started)
+	assert.Equal(errno.ERR_GATEWAY_NAME_ALREADY_STARTED.GetCode(), err.(*errno.ErrorCode).GetCode())
+}
+
+func TestValidateGatewayConfigs(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name       string
+		mountPoint string
+		items      string
+		err        *errno.ErrorCode
+	}{
+		{"gateway1", "/mnt/dingofs", "s3.root_password: minioadmin", nil},
+		{"gateway1", "mnt", "", errno.ERR_INVALID_GATEWAY_CONFIGURE},
+		{"a/b", "/mnt/dingofs", "", errno.ERR_INVALID_GATEWAY_CONFIGURE},
+		{"gateway1", "/mnt/dingofs", "gateway.listen_address: 10.0.0.1", errno.ERR_INVALID_GATEWAY_ADDRESS},
+		{"gateway1", "/mnt/dingofs", "gateway.listen_port: 70000", errno.ERR_INVALID_GATEWAY_ADDRESS},
+		{"gateway1", "/mnt/dingofs", "gateway.console_port: 19000", errno.ERR_INVALID_GATEWAY_ADDRESS},
+		{"gateway1", "/mnt/dingofs", "s3.root_password: short", errno.ERR_INVALID_GATEWAY_CREDENTIALS},
+		{"gateway1", "/mnt/dingofs", "gateway.tls_cert_file: /etc/public.crt", errno.ERR_INVALID_GATEWAY_TLS_CONFIGURE},
+		{"gateway1", "/mnt/dingofs", "gateway.tls_cert_file: public.crt\ngateway.tls_key_file: private.key",
+			errno.ERR_INVALID_GATEWAY_TLS_CONFIGURE},
+	}
+	for _, tt := range tests {
+		items := tt.items
+		if !strings.Contains(items, "s3.root_password") {
+			items += "\ns3.root_password: minioadmin"
+		}
+		gcs, err := ParseGatewayConfig(writeGatewayFile(t, GATEWAY_COMMON_ITEMS+items+"\n"))
+		if !assert.Nil(err, tt.items) {
+			continue
+		}
+		gcs[0].SetInstance(tt.name, "host1", tt.mountPoint)
+		err = ValidateGatewayConfigs(gcs, nil)
+		if tt.err == nil {
+			assert.Nil(err, tt.items)
+		} else if assert.NotNil(err, tt.items) {
+			assert.Equal(tt.err.GetCode(), err.(*errno.ErrorCode).GetCode(), tt.items)
+		}
+	}
+
+	// duplicate name and port of instances
+	gcs, err := ParseGatewayConfig(writeGatewayFile(t, GATEWAY_COMMON_ITEMS+`
+s3.root_password: minioadmin
+instances:
+  - {name: gateway1, host: host1, mount_point: /mnt/fs1}
+  - {name: gateway1, host: host2, mount_point: /mnt/fs1}
+`))
+	assert.Nil(err)
+	err = ValidateGatewayConfigs(gcs, nil)
+	assert.Equal(errno.ERR_DUPLICATE_GATEWAY_NAME.GetCode(), err.(*errno.ErrorCode).GetCode())
+
+	gcs, err = ParseGatewayConfig(writeGatewayFile(t, GATEWAY_COMMON_ITEMS+`
+s3.root_password: minioadmin
+instances:
+  - {name: gateway1, host: host1, mount_point: /mnt/fs1}
+  - {name: gateway2, host: host1, mount_point: /mnt/fs2}
+`))
+	assert.Nil(err)
+	err = ValidateGatewayConfigs(gcs, nil)
+	assert.Equal(errno.ERR_GATEWAY_PORT_CONFLICT.GetCode(), err.(*errno.ErrorCode).GetCode())
 }
@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package hosts
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingoadm/internal/errno"
+)
+
+const (
+	// sections printed by script host_facts.sh
+	FACTS_SECTION_HOSTNAME   = "hostname"
+	FACTS_SECTION_OS         = "os"
+	FACTS_SECTION_KERNEL     = "kernel"
+	FACTS_SECTION_ARCH       = "arch"
+	FACTS_SECTION_CPU        = "cpu"
+	FACTS_SECTION_MEMORY     = "memory"
+	FACTS_SECTION_NUMA       = "numa"
+	FACTS_SECTION_LSBLK      = "lsblk"
+	FACTS_SECTION_FILESYSTEM = "filesystem"
+	FACTS_SECTION_DOCKER     = "docker"
+	FACTS_SECTION_TIMESYNC   = "timesync"
+	FACTS_SECTION_PORTS      = "ports"
+
+	FACTS_SECTION_PREFIX = "@@@ "
+
+	REGEX_FACTS_KV_PAIR  = `([A-Z_]+)="([^"]*)"`
+	REGEX_FACTS_MEMTOTAL = `^MemTotal:\s+(\d+)\s+kB$`
+)
+
+type (
+	BlockDevice struct {
+		Name       string `json:"name"`
+		Type       string `json:"type"`
+		Size       uint64 `json:"size"`
+		Rotational bool   `json:"rotational"`
+		FSType     string `json:"fstype,omitempty"`
+		MountPoint string `json:"mountpoint,omitempty"`
+	}
+
+	Filesystem struct {
+		Source     string `json:"source"`
+		FSType     string `json:"fstype"`
+		Size       uint64 `json:"size"`
+		Used       uint64 `json:"used"`
+		Avail      uint64 `json:"avail"`
+		MountPoint string `json:"mountpoint"`
+	}
+
+	TimeSync struct {
+		Service      string `json:"service,omitempty"`
+		Synchronized bool   `json:"synchronized"`
+	}
+
+	HostFacts struct {
+		Host          string        `json:"host"`
+		Hostname      string        `json:"hostname"`
+		OSName        string        `json:"os_name"`
+		OSId          string        `json:"os_id"`
+		OSVersion     string        `json:"os_version"`
+		Kernel        string        `json:"kernel"`
+		Arch          string        `json:"arch"`
+		CPUs          int           `json:"cpus"`
+		CPUModel      string        `json:"cpu_model"`
+		MemTotal      uint64        `json:"mem_total"`
+		NUMANodes     int           `json:"numa_nodes"`
+		BlockDevices  []BlockDevice `json:"block_devices"`
+		Filesystems   []Filesystem  `json:"filesystems"`
+		DockerVersion string        `json:"docker_version,omitempty"`
+		TimeSync      TimeSync      `json:"time_sync"`
+		OpenPorts     []int         `json:"open_ports"`
+		GatheredAt    time.Time     `json:"gathered_at"`
+	}
+)
+
+func splitSections(out string) map[string][]string {
+	sections := map[string][]string{}
+	current := ""
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.HasPrefix(line, FACTS_SECTION_PREFIX) {
+			current = strings.TrimSpace(strings.TrimPrefix(line, FACTS_SECTION_PREFIX))
+			sections[current] = []string{}
+			continue
+		} else if len(current) == 0 || len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+		sections[current] = append(sections[current], line)
+	}
+	return sections
+}
+
+func firstLine(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(lines[0])
+}
+
+// parseKVPairs parses line like: NAME="sda" TYPE="disk" SIZE="1024"
+func parseKVPairs(line string) map[string]string {
+	m := map[string]string{}
+	for _, mu := range regexp.MustCompile(REGEX_FACTS_KV_PAIR).FindAllStringSubmatch(line, -1) {
+		m[mu[1]] = mu[2]
+	}
+	return m
+}
+
+func (f *HostFacts) parseOS(lines []string) {
+	for _, line := range lines {
+		items := strings.SplitN(line, "=", 2)
+		if len(items) != 2 {
+			continue
+		}
+		value := strings.Trim(items[1], `"'`)
+		switch items[0] {
+		case "PRETTY_NAME":
+			f.OSName = value
+		case "ID":
+			f.OSId = value
+		case "VERSION_ID":
+			f.OSVersion = value
+		}
+	}
+}
+
+func (f *HostFacts) parseCPU(lines []string) {
+	f.CPUs, _ = strconv.Atoi(firstLine(lines))
+	if len(lines) > 1 {
+		f.CPUModel = strings.TrimSpace(lines[1])
+	}
+}
+
+func (f *HostFacts) parseMemory(lines []string) {
+	mu := regexp.MustCompile(REGEX_FACTS_MEMTOTAL).FindStringSubmatch(firstLine(lines))
+	if len(mu) > 0 {
+		kb, _ := strconv.ParseUint(mu[1], 10, 64)
+		f.MemTotal = kb * 1024
+	}
+}
+
+func (f *HostFacts) parseBlockDevices(lines []string) {
+	for _, line := range lines {
+		m := parseKVPairs(line)
+		if len(m["NAME"]) == 0 {
+			continue
+		}
+		size, _ := strconv.ParseUint(m["SIZE"], 10, 64)
+		f.BlockDevices = append(f.BlockDevices, BlockDevice{
+			Name:       m["NAME"],
+			Type:       m["TYPE"],
+			Size:       size,
+			Rotational: m["ROTA"] == "1",
+			FSType:     m["FSTYPE"],
+			MountPoint: m["MOUNTPOINT"],
+		})
+	}
+}
+
+// parseFilesystems parses output of 'df -P -T -B1' without title
+func (f *HostFacts) parseFilesystems(lines []string) {
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 7 {
+			continue
+		}
+		size, _ := strconv.ParseUint(fields[2], 10, 64)
+		used, _ := strconv.ParseUint(fields[3], 10, 64)
+		avail, _ := strconv.ParseUint(fields[4], 10, 64)
+		f.Filesystems = append(f.Filesystems, Filesystem{
+			Source:     fields[0],
+			FSType:     fields[1],
+			Size:       size,
+			Used:       used,
+			Avail:      avail,
+			MountPoint: strings.Join(fields[6:], " "),
+		})
+	}
+}
+
+func (f *HostFacts) parseTimeSync(lines []string) {
+	for _, line := range lines {
+		items := strings.SplitN(line, "=", 2)
+		if len(items) != 2 {
+			continue
+		}
+		switch items[0] {
+		case "service":
+			f.TimeSync.Service = items[1]
+		case "synchronized":
+			f.TimeSync.Synchronized = items[1] == "yes"
+		}
+	}
+}
+
+func (f *HostFacts) parsePorts(lines []string) {
+	for _, line := range lines {
+		port, err := strconv.Atoi(strings.TrimSpace(line))
+		if err == nil && port > 0 {
+			f.OpenPorts = append(f.OpenPorts, port)
+		}
+	}
+}
+
+// ParseHostFacts parses the output of script host_facts.sh
+func ParseHostFacts(host, out string) (*HostFacts, error) {
+	sections := splitSections(out)
+	if _, ok := sections[FACTS_SECTION_KERNEL]; !ok {
+		return nil, errno.ERR_UNRECOGNIZED_HOST_FACTS.
+			F("host=%s: section '%s' not found", host, FACTS_SECTION_KERNEL)
+	}
+
+	f := &HostFacts{
+		Host:         host,
+		Hostname:     firstLine(sections[FACTS_SECTION_HOSTNAME]),
+		Kernel:       firstLine(sections[FACTS_SECTION_KERNEL]),
+		Arch:         firstLine(sections[FACTS_SECTION_ARCH]),
+		BlockDevices: []BlockDevice{},
+		Filesystems:  []Filesystem{},
+		OpenPorts:    []int{},
+		GatheredAt:   time.Now(),
+	}
+	f.NUMANodes, _ = strconv.Atoi(firstLine(sections[FACTS_SECTION_NUMA]))
+	f.DockerVersion = firstLine(sections[FACTS_SECTION_DOCKER])
+	f.parseOS(sections[FACTS_SECTION_OS])
+	f.parseCPU(sections[FACTS_SECTION_CPU])
+	f.parseMemory(sections[FACTS_SECTION_MEMORY])
+	f.parseBlockDevices(sections[FACTS_SECTION_LSBLK])
+	f.parseFilesystems(sections[FACTS_SECTION_FILESYSTEM])
+	f.parseTimeSync(sections[FACTS_SECTION_TIMESYNC])
+	f.parsePorts(sections[FACTS_SECTION_PORTS])
+	return f, nil
+}
+
+func (f *HostFacts) Encode() (string, error) {
+	bytes, err := json.Marshal(f)
+	if err != nil {
+		return "", errno.ERR_ENCODE_HOST_FACTS_FAILED.E(err)
+	}
+	return string(bytes), nil
+}
+
+func DecodeHostFacts(data string) (*HostFacts, error) {
+	f := &HostFacts{}
+	if err := json.Unmarshal([]byte(data), f); err != nil {
+		return nil, errno.ERR_DECODE_HOST_FACTS_FAILED.E(err)
+	}
+	return f, nil
+}
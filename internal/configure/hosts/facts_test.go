@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package hosts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	FACTS_OUTPUT = `@@@ hostname
+node1
+@@@ os
+PRETTY_NAME="Ubuntu 22.04.3 LTS"
+ID=ubuntu
+VERSION_ID="22.04"
+@@@ kernel
+5.15.0-86-generic
+@@@ arch
+x86_64
+@@@ cpu
+32
+ Intel(R) Xeon(R) Gold 6248R CPU @ 3.00GHz
+@@@ memory
+MemTotal:       131879404 kB
+@@@ numa
+2
+@@@ lsblk
+NAME="sda" TYPE="disk" SIZE="480103981056" ROTA="0" FSTYPE="" MOUNTPOINT=""
+NAME="sda1" TYPE="part" SIZE="480101000000" ROTA="0" FSTYPE="ext4" MOUNTPOINT="/"
+NAME="nvme0n1" TYPE="disk" SIZE="3840755982336" ROTA="0" FSTYPE="xfs" MOUNTPOINT="/data"
+@@@ filesystem
+/dev/sda1      ext4  472446402560 52428800000 396250591232      12% /
+/dev/nvme0n1   xfs  3840755982336 1048576000 3839707406336       1% /data
+@@@ docker
+24.0.7
+@@@ timesync
+service=chronyd
+synchronized=yes
+@@@ ports
+22
+20001
+20002
+`
+)
+
+func TestParseHostFacts(t *testing.T) {
+	assert := assert.New(t)
+
+	facts, err := ParseHostFacts("host1", FACTS_OUTPUT)
+	assert.Nil(err)
+	assert.Equal("host1", facts.Host)
+	assert.Equal("node1", facts.Hostname)
+	assert.Equal("Ubuntu 22.04.3 LTS", facts.OSName)
+	assert.Equal("ubuntu", facts.OSId)
+	assert.Equal("22.04", facts.OSVersion)
+	assert.Equal("5.15.0-86-generic", facts.Kernel)
+	assert.Equal(32, facts.CPUs)
+	assert.Equal("Intel(R) Xeon(R) Gold 6248R CPU @ 3.00GHz", facts.CPUModel)
+	assert.Equal(uint64(131879404*1024), facts.MemTotal)
+	assert.Equal(2, facts.NUMANodes)
+	assert.Len(facts.BlockDevices, 3)
+	assert.Equal("/data", facts.BlockDevices[2].MountPoint)
+	assert.False(facts.BlockDevices[0].Rotational)
+	assert.Len(facts.Filesystems, 2)
+	assert.Equal(uint64(3839707406336), facts.Filesystems[1].Avail)
+	assert.Equal("24.0.7", facts.DockerVersion)
+	assert.Equal(TimeSync{Service: "chronyd", Synchronized: true}, facts.TimeSync)
+	assert.Equal([]int{22, 20001, 20002}, facts.OpenPorts)
+
+	data, err := facts.Encode()
+	assert.Nil(err)
+	decoded, err := DecodeHostFacts(data)
+	assert.Nil(err)
+	assert.Equal(facts.Filesystems, decoded.Filesystems)
+	assert.True(facts.GatheredAt.Equal(decoded.GatheredAt))
+
+	_, err = ParseHostFacts("host1", "bash: command not found")
+	assert.NotNil(err)
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package hosts
+
+import (
+	"strings"
+
+	"github.com/dingodb/dingoadm/internal/errno"
+)
+
+const (
+	SELECTOR_ALL       = "*"
+	SELECTOR_DELIMITER = ":"
+
+	SELECTOR_OP_EXCLUDE   = '!'
+	SELECTOR_OP_INTERSECT = '&'
+)
+
+// SplitLabels splits label pattern like 'group1:!host1:&rack=r3' into labels
+func SplitLabels(pattern string) []string {
+	return strings.Split(pattern, SELECTOR_DELIMITER)
+}
+
+/*
+ * pattern         description
+ * ---             ---
+ * label2:label2   multiple labels: all hosts belong to label <label1> plus all hosts belong to <label2>
+ * label2:!label2  excluding labels: all hosts belong to label <label1> except those belong to label <label2>
+ * label2:&label2  intersection labels: any hosts belong to label <label1> that are also belong to label <label2>
+ *
+ * label is matched as a whole, e.g. 'rack=r3' only matches hosts which have label 'rack=r3'
+ */
+func ParseLabelPattern(labels []string) (include, exclude, intersect map[string]bool) {
+	include = map[string]bool{}
+	exclude = map[string]bool{}
+	intersect = map[string]bool{}
+	for _, label := range labels {
+		if len(label) == 0 {
+			continue
+		}
+
+		switch label[0] {
+		case SELECTOR_OP_EXCLUDE:
+			exclude[label[1:]] = true
+		case SELECTOR_OP_INTERSECT:
+			intersect[label[1:]] = true
+		default:
+			include[label] = true
+		}
+	}
+	return
+}
+
+// ValidateLabels returns error if any label is empty after operator stripped
+func ValidateLabels(labels []string) error {
+	for _, label := range labels {
+		if len(label) == 0 {
+			continue
+		} else if label[0] == SELECTOR_OP_EXCLUDE || label[0] == SELECTOR_OP_INTERSECT {
+			label = label[1:]
+		}
+		if len(label) == 0 || strings.ContainsAny(label, " !&") {
+			return errno.ERR_INVALID_LABEL_SELECTOR.F("labels: %s", strings.Join(labels, SELECTOR_DELIMITER))
+		}
+	}
+	return nil
+}
+
+// return true if dropped
+func excludeOne(hc *HostConfig, exclude map[string]bool) bool {
+	if len(exclude) == 0 {
+		return false
+	}
+
+	for _, label := range hc.GetLabels() {
+		if exclude[label] {
+			return true
+		}
+	}
+	return false
+}
+
+// return true if selected
+func includeOne(hc *HostConfig, include map[string]bool) bool {
+	if len(include) == 0 {
+		return true
+	}
+
+	for _, label := range hc.GetLabels() {
+		if include[label] {
+			return true
+		}
+	}
+	return false
+}
+
+// return true if selected
+func intersectOne(hc *HostConfig, intersect map[string]bool) bool {
+	if len(intersect) == 0 {
+		return true
+	}
+
+	exist := map[string]bool{}
+	for _, label := range hc.GetLabels() {
+		if intersect[label] {
+			exist[label] = true
+		}
+	}
+	return len(exist) == len(intersect)
+}
+
+// FilterByLabels returns hosts which match the labels, all hosts returned if no labels
+func FilterByLabels(hcs []*HostConfig, labels []string) []*HostConfig {
+	if len(labels) == 0 {
+		return hcs
+	}
+
+	out := []*HostConfig{}
+	include, exclude, intersect := ParseLabelPattern(labels)
+	for _, hc := range hcs {
+		if excludeOne(hc, exclude) {
+			continue
+		} else if !includeOne(hc, include) {
+			continue
+		} else if !intersectOne(hc, intersect) {
+			continue
+		}
+		out = append(out, hc)
+	}
+	return out
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package hosts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newHostConfig(host string, labels ...string) *HostConfig {
+	return &HostConfig{
+		config: map[string]interface{}{CONFIG_HOST.Key(): host},
+		labels: labels,
+	}
+}
+
+func TestFilterByLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	hcs := []*HostConfig{
+		newHostConfig("host1", "rack=r1", "zone=az1", "store"),
+		newHostConfig("host2", "rack=r2", "zone=az1", "store"),
+		newHostConfig("host3", "rack=r3", "zone=az2", "store"),
+		newHostConfig("host4", "rack=r3", "zone=az2"),
+	}
+
+	tests := []struct {
+		pattern string
+		expect  []string
+	}{
+		{"", []string{"host1", "host2", "host3", "host4"}},
+		{"rack=r3", []string{"host3", "host4"}},
+		{"zone=az2", []string{"host3", "host4"}},
+		{"rack", []string{}},
+		{"rack=r1:rack=r2", []string{"host1", "host2"}},
+		{"store:&rack=r3", []string{"host3"}},
+		{"zone=az1:!rack=r1", []string{"host2"}},
+		{"!store", []string{"host4"}},
+		{"&zone=az2:&store", []string{"host3"}},
+		{"rack=r4", []string{}},
+	}
+	for _, test := range tests {
+		out := []string{}
+		for _, hc := range FilterByLabels(hcs, SplitLabels(test.pattern)) {
+			out = append(out, hc.GetHost())
+		}
+		assert.Equal(test.expect, out, test.pattern)
+	}
+
+	for _, pattern := range []string{"!", "&", "store:!", "ra ck=r1", "!!store"} {
+		assert.NotNil(ValidateLabels(SplitLabels(pattern)), pattern)
+	}
+	assert.Nil(ValidateLabels(SplitLabels("zone=az1:!rack=r1:&store")))
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package configure
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/storage"
+)
+
+const (
+	LABEL_MAINTENANCE = "maintenance"
+)
+
+// MaintenanceInfo is the maintenance record of host saved in storage,
+// services are stopped in the order and started in the reverse order
+type MaintenanceInfo struct {
+	Host      string    `json:"host"`
+	Services  []string  `json:"services"`
+	Reason    string    `json:"reason,omitempty"`
+	EnterTime time.Time `json:"enter_time"`
+}
+
+// GetMaintenances returns hosts in maintenance of current cluster (key: host)
+func GetMaintenances(dingoadm *cli.DingoAdm) (map[string]MaintenanceInfo, error) {
+	items, err := dingoadm.Storage().GetMaintenances(dingoadm.ClusterId())
+	if err != nil {
+		return nil, errno.ERR_GET_MAINTENANCES_FAILED.E(err)
+	}
+
+	return parseMaintenances(items)
+}
+
+func parseMaintenances(items []storage.Any) (map[string]MaintenanceInfo, error) {
+	m := map[string]MaintenanceInfo{}
+	for _, item := range items {
+		info := MaintenanceInfo{}
+		if err := json.Unmarshal([]byte(item.Data), &info); err != nil {
+			return nil, errno.ERR_GET_MAINTENANCES_FAILED.E(err)
+		}
+		m[info.Host] = info
+	}
+	return m, nil
+}
+
+// GetMaintenanceHosts returns sorted hosts in maintenance
+func GetMaintenanceHosts(dingoadm *cli.DingoAdm) ([]string, error) {
+	m, err := GetMaintenances(dingoadm)
+	if err != nil {
+		return nil, err
+	}
+	hosts := []string{}
+	for host := range m {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts, nil
+}
+
+func SaveMaintenance(dingoadm *cli.DingoAdm, info MaintenanceInfo) error {
+	bytes, err := json.Marshal(info)
+	if err != nil {
+		return errno.ERR_REPLACE_MAINTENANCE_FAILED.E(err)
+	}
+	err = dingoadm.Storage().ReplaceMaintenance(dingoadm.ClusterId(), info.Host, string(bytes))
+	if err != nil {
+		return errno.ERR_REPLACE_MAINTENANCE_FAILED.E(err)
+	}
+	return nil
+}
+
+func RemoveMaintenance(dingoadm *cli.DingoAdm, host string) error {
+	err := dingoadm.Storage().DeleteMaintenance(dingoadm.ClusterId(), host)
+	if err != nil {
+		return errno.ERR_DELETE_MAINTENANCE_FAILED.E(err)
+	}
+	return nil
+}
+
+// labelMaintenance splits targets on hosts in maintenance into separate groups
+// with label 'maintenance="true"', which alert rules exclude to suppress alerts
+func labelMaintenance(dingoadm *cli.DingoAdm, targets []serviceTarget) ([]serviceTarget, error) {
+	m, err := GetMaintenances(dingoadm)
+	if err != nil || len(m) == 0 {
+		return targets, err
+	}
+	ips, err := getHostIps(dingoadm)
+	if err != nil {
+		return nil, err
+	}
+	maintenance := map[string]bool{}
+	for host := range m {
+		maintenance[host] = true
+		if ip, ok := ips[host]; ok {
+			maintenance[ip] = true
+		}
+	}
+	return splitMaintenanceTargets(targets, maintenance), nil
+}
+
+// splitMaintenanceTargets splits addresses whose host (or ip) in maintenance
+// out of each target, the labels of target are kept in both groups
+func splitMaintenanceTargets(targets []serviceTarget, maintenance map[string]bool) []serviceTarget {
+	ret := []serviceTarget{}
+	for _, target := range targets {
+		normal := serviceTarget{Labels: target.Labels}
+		labeled := serviceTarget{Labels: map[string]string{LABEL_MAINTENANCE: "true"}}
+		for k, v := range target.Labels {
+			labeled.Labels[k] = v
+		}
+		for _, address := range target.Targets {
+			host, _, err := net.SplitHostPort(address)
+			if err == nil && maintenance[host] {
+				labeled.Targets = append(labeled.Targets, address)
+			} else {
+				normal.Targets = append(normal.Targets, address)
+			}
+		}
+		if len(normal.Targets) > 0 {
+			ret = append(ret, normal)
+		}
+		if len(labeled.Targets) > 0 {
+			ret = append(ret, labeled)
+		}
+	}
+	return ret
+}
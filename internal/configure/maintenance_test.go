@@ -0,0 +1,87 @@
+package configure
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMaintenances(t *testing.T) {
+	assert := assert.New(t)
+
+	enterTime := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	info := MaintenanceInfo{
+		Host:      "host1",
+		Services:  []string{"store1", "coordinator1"},
+		Reason:    "disk swap",
+		EnterTime: enterTime,
+	}
+	bytes, err := json.Marshal(info)
+	assert.Nil(err)
+
+	m, err := parseMaintenances([]storage.Any{
+		{Id: "1:host1", Data: string(bytes)},
+		{Id: "1:host2", Data: `{"host":"host2","services":[],"enter_time":"2026-10-19T08:00:00Z"}`},
+	})
+	assert.Nil(err)
+	assert.Len(m, 2)
+	assert.Equal(info, m["host1"])
+	assert.Equal("host2", m["host2"].Host)
+	assert.Equal("", m["host2"].Reason)
+	assert.True(enterTime.Equal(m["host2"].EnterTime))
+
+	m, err = parseMaintenances([]storage.Any{})
+	assert.Nil(err)
+	assert.Len(m, 0)
+
+	_, err = parseMaintenances([]storage.Any{{Id: "1:host1", Data: "{"}})
+	assert.Equal(errno.ERR_GET_MAINTENANCES_FAILED.GetCode(), err.(*errno.ErrorCode).GetCode())
+}
+
+func TestSplitMaintenanceTargets(t *testing.T) {
+	assert := assert.New(t)
+
+	labels := map[string]string{"job": "store"}
+	maintenance := map[string]bool{"host1": true, "10.0.0.1": true}
+	tests := []struct {
+		targets []serviceTarget
+		expect  []serviceTarget
+	}{
+		// no target in maintenance
+		{
+			[]serviceTarget{{Targets: []string{"10.0.0.2:6600", "10.0.0.3:6600"}, Labels: labels}},
+			[]serviceTarget{{Targets: []string{"10.0.0.2:6600", "10.0.0.3:6600"}, Labels: labels}},
+		},
+		// split into normal and maintenance groups, matched by ip or hostname
+		{
+			[]serviceTarget{{Targets: []string{"10.0.0.1:6600", "10.0.0.2:6600", "host1:6601"}, Labels: labels}},
+			[]serviceTarget{
+				{Targets: []string{"10.0.0.2:6600"}, Labels: labels},
+				{Targets: []string{"10.0.0.1:6600", "host1:6601"}, Labels: map[string]string{"job": "store", "maintenance": "true"}},
+			},
+		},
+		// all targets in maintenance
+		{
+			[]serviceTarget{{Targets: []string{"10.0.0.1:6600"}, Labels: labels}},
+			[]serviceTarget{{Targets: []string{"10.0.0.1:6600"}, Labels: map[string]string{"job": "store", "maintenance": "true"}}},
+		},
+		// address without port never matched
+		{
+			[]serviceTarget{{Targets: []string{"10.0.0.1"}, Labels: labels}},
+			[]serviceTarget{{Targets: []string{"10.0.0.1"}, Labels: labels}},
+		},
+		// target without address dropped
+		{
+			[]serviceTarget{{Targets: []string{}, Labels: labels}},
+			[]serviceTarget{},
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.expect, splitMaintenanceTargets(tt.targets, maintenance))
+	}
+	assert.Equal(map[string]string{"job": "store"}, labels) // labels of origin target not changed
+}
@@ -29,6 +29,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/dingodb/dingoadm/cli/cli"
@@ -65,6 +66,14 @@ const (
 
 	INFO_TYPE_FILE = "file"
 	INFO_TYPE_DATA = "data"
+
+	JOB_NODE             = "node"
+	JOB_CLIENT           = "client"
+	JOB_GATEWAY          = "gateway"
+	LABEL_METRICS_PATH   = "__metrics_path__"
+	LABEL_SCHEME         = "__scheme__"
+	BRPC_METRICS_PATH    = "/brpc_metrics"
+	GATEWAY_METRICS_PATH = "/minio/v2/metrics/cluster"
 )
 
 type (
@@ -73,8 +82,9 @@ type (
 	}
 
 	service struct {
-		Config map[string]interface{} `mapstructure:"config"`
-		Deploy []deploy               `mapstructure:"deploy"`
+		Config   map[string]interface{} `mapstructure:"config"`
+		Deploy   []deploy               `mapstructure:"deploy"`
+		External map[string]interface{} `mapstructure:"external"`
 	}
 
 	Monitor struct {
@@ -112,6 +122,13 @@ type (
 		DiffType      int
 		MonitorConfig *MonitorConfig
 	}
+
+	// same as AuxInfo of client which saved on mount
+	clientAuxInfo struct {
+		FSName     string `json:"fsname"`
+		MountPoint string `json:"mount_point"`
+		MetricPort int    `json:"metric_port"`
+	}
 )
 
 func (m *MonitorConfig) getConfig() map[string]interface{} {
@@ -261,7 +278,7 @@ func getHost(c *Monitor, role string) []string {
 	return hosts
 }
 
-func parsePrometheusTarget(dcs []*topology.DeployConfig) (string, error) {
+func parseServerTargets(dcs []*topology.DeployConfig) []serviceTarget {
 	targets := []serviceTarget{}
 	tMap := make(map[string]serviceTarget)
 	for _, dc := range dcs {
@@ -281,12 +298,17 @@ func parsePrometheusTarget(dcs []*topology.DeployConfig) (string, error) {
 			topology.ROLE_COORDINATOR,
 			topology.ROLE_STORE:
 			item = fmt.Sprintf("%s:%d", ip, dc.GetDingoServerPort())
+		case topology.ROLE_DINGODB_EXECUTOR:
+			item = fmt.Sprintf("%s:%d", ip, dc.GetDingoDBMetricsPort())
+		default:
+			continue
 		}
 		if _, ok := tMap[role]; ok {
 			t := tMap[role]
 			t.Targets = append(t.Targets, item)
 			tMap[role] = t
 		} else {
+			// NOTE: keep the default metrics path of services which existing dashboards use
 			tMap[role] = serviceTarget{
 				Labels:  map[string]string{"job": role},
 				Targets: []string{item},
@@ -296,6 +318,136 @@ func parsePrometheusTarget(dcs []*topology.DeployConfig) (string, error) {
 	for _, v := range tMap {
 		targets = append(targets, v)
 	}
+	return targets
+}
+
+func parsePrometheusTarget(dcs []*topology.DeployConfig) (string, error) {
+	target, err := json.Marshal(parseServerTargets(dcs))
+	if err != nil {
+		return "", errno.ERR_PARSE_PROMETHEUS_TARGET_FAILED.E(err)
+	}
+	return string(target), nil
+}
+
+// ParseServerTarget returns prometheus targets of services in topology, the services
+// on hosts in maintenance are labeled to suppress their alerts
+func ParseServerTarget(dingoadm *cli.DingoAdm) (string, error) {
+	dcs, err := dingoadm.ParseTopology()
+	if err != nil {
+		return "", err
+	}
+	targets, err := labelMaintenance(dingoadm, parseServerTargets(dcs))
+	if err != nil {
+		return "", err
+	}
+	target, err := json.Marshal(targets)
+	if err != nil {
+		return "", errno.ERR_PARSE_PROMETHEUS_TARGET_FAILED.E(err)
+	}
+	return string(target), nil
+}
+
+func getHostIps(dingoadm *cli.DingoAdm) (map[string]string, error) {
+	ips := map[string]string{}
+	if len(dingoadm.Hosts()) == 0 {
+		return ips, nil
+	}
+	hcs, err := confHost.ParseHosts(dingoadm.Hosts())
+	if err != nil {
+		return nil, err
+	}
+	for _, hc := range hcs {
+		ips[hc.GetHost()] = hc.GetHostname()
+	}
+	return ips, nil
+}
+
+func parseClientTargets(dingoadm *cli.DingoAdm) ([]serviceTarget, error) {
+	ips, err := getHostIps(dingoadm)
+	if err != nil {
+		return nil, err
+	}
+	lookup := func(host string) string {
+		if ip, ok := ips[host]; ok {
+			return ip
+		}
+		return host
+	}
+
+	targets := []serviceTarget{}
+	clients, err := dingoadm.Storage().GetClients()
+	if err != nil {
+		return nil, errno.ERR_GET_ALL_CLIENTS_FAILED.E(err)
+	}
+	for _, client := range clients {
+		if client.Kind != topology.KIND_DINGOFS {
+			continue
+		}
+		auxInfo := clientAuxInfo{}
+		if err := json.Unmarshal([]byte(client.AuxInfo), &auxInfo); err != nil {
+			return nil, errno.ERR_PARSE_PROMETHEUS_TARGET_FAILED.E(err)
+		}
+		port := auxInfo.MetricPort
+		if port <= 0 { // mounted by old version
+			port = DEFAULT_CLIENT_DUMMY_PORT
+		}
+		targets = append(targets, serviceTarget{
+			Targets: []string{fmt.Sprintf("%s:%d", lookup(client.Host), port)},
+			Labels: map[string]string{
+				"job":              JOB_CLIENT,
+				"id":               client.Id,
+				"fs_name":          auxInfo.FSName,
+				"mountpoint":       auxInfo.MountPoint,
+				"host":             client.Host,
+				LABEL_METRICS_PATH: BRPC_METRICS_PATH,
+			},
+		})
+	}
+
+	gateways, err := dingoadm.Storage().GetGateways()
+	if err != nil {
+		return nil, errno.ERR_GET_ALL_GATEWAYS_FAILED.E(err)
+	}
+	for _, item := range gateways {
+		gateway := GatewayInfo{}
+		if err := json.Unmarshal([]byte(item.Data), &gateway); err != nil {
+			return nil, errno.ERR_PARSE_PROMETHEUS_TARGET_FAILED.E(err)
+		}
+		ip, port, err := net.SplitHostPort(gateway.ListenAddr)
+		if err != nil {
+			return nil, errno.ERR_PARSE_PROMETHEUS_TARGET_FAILED.E(err)
+		} else if len(ip) == 0 {
+			ip = lookup(gateway.Host)
+		}
+		labels := map[string]string{
+			"job":              JOB_GATEWAY,
+			"name":             gateway.Name,
+			"mountpoint":       gateway.MountPoint,
+			"host":             gateway.Host,
+			LABEL_METRICS_PATH: GATEWAY_METRICS_PATH,
+		}
+		if gateway.TLS {
+			labels[LABEL_SCHEME] = "https"
+		}
+		targets = append(targets, serviceTarget{
+			Targets: []string{net.JoinHostPort(ip, port)},
+			Labels:  labels,
+		})
+	}
+	return targets, nil
+}
+
+// ParseClientTarget returns prometheus targets of mounted clients and started
+// gateways recorded in storage, which changes on mount/umount without topology
+func ParseClientTarget(dingoadm *cli.DingoAdm) (string, error) {
+	targets, err := parseClientTargets(dingoadm)
+	if err != nil {
+		return "", err
+	}
+	targets, err = labelMaintenance(dingoadm, targets)
+	if err != nil {
+		return "", err
+	}
 	target, err := json.Marshal(targets)
 	if err != nil {
 		return "", errno.ERR_PARSE_PROMETHEUS_TARGET_FAILED.E(err)
@@ -325,18 +477,8 @@ func parseHosts(dingoadm *cli.DingoAdm) ([]string, []string, []*topology.DeployC
 	return hosts, hostIps, dcs, nil
 }
 
-func ParseMonitor(dingoadm *cli.DingoAdm) ([]*MonitorConfig, error) {
-	return ParseMonitorInfo(dingoadm, dingoadm.Monitor().Monitor, INFO_TYPE_DATA)
-}
-
-// ParseMonitorFile parses monitor configuration from a file or from existing monitor data. infoType can be "file" or "data".
-func ParseMonitorInfo(dingoadm *cli.DingoAdm, info string, infoType string) ([]*MonitorConfig, error) {
-	hosts, hostIps, dcs, err := parseHosts(dingoadm)
-	if err != nil {
-		return nil, err
-	}
-
-	// parse monitor configure
+func readMonitor(info string, infoType string) (Monitor, error) {
+	config := Monitor{}
 	parser := viper.NewWithOptions(viper.KeyDelimiter("::"))
 	parser.SetConfigType("yaml")
 
@@ -344,23 +486,41 @@ func ParseMonitorInfo(dingoadm *cli.DingoAdm, info string, infoType string) ([]*
 	case INFO_TYPE_FILE:
 		parser.SetConfigFile(info)
 		if err := parser.ReadInConfig(); err != nil {
-			return nil, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.E(err)
+			return config, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.E(err)
 		}
 	case INFO_TYPE_DATA:
 		if len(info) != 0 && info != common.CLEANED_MONITOR_CONF {
 			if err := parser.ReadConfig(bytes.NewBuffer([]byte(info))); err != nil {
-				return nil, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.E(err)
+				return config, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.E(err)
 			}
 		} else {
-			return nil, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED
+			return config, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED
 		}
 	default:
-		return nil, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.F("invalid info type: %s", infoType)
+		return config, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.F("invalid info type: %s", infoType)
 	}
 
-	config := Monitor{}
 	if err := parser.Unmarshal(&config); err != nil {
-		return nil, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.E(err)
+		return config, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.E(err)
+	}
+	return config, nil
+}
+
+func ParseMonitor(dingoadm *cli.DingoAdm) ([]*MonitorConfig, error) {
+	return ParseMonitorInfo(dingoadm, dingoadm.Monitor().Monitor, INFO_TYPE_DATA)
+}
+
+// ParseMonitorFile parses monitor configuration from a file or from existing monitor data. infoType can be "file" or "data".
+func ParseMonitorInfo(dingoadm *cli.DingoAdm, info string, infoType string) ([]*MonitorConfig, error) {
+	hosts, hostIps, dcs, err := parseHosts(dingoadm)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse monitor configure
+	config, err := readMonitor(info, infoType)
+	if err != nil {
+		return nil, err
 	}
 
 	// get host -> hostname(ip)
@@ -375,7 +535,10 @@ func ParseMonitorInfo(dingoadm *cli.DingoAdm, info string, infoType string) ([]*
 
 	mkind := dcs[0].GetKind()
 	// mconfImage := dcs[0].GetContainerImage()
-	syncMonitorPath := config.MonitroSync.Config[KEY_DATA_DIR].(string)
+	syncMonitorPath, _ := config.MonitroSync.Config[KEY_DATA_DIR].(string)
+	if err := checkExternal(&config); err != nil {
+		return nil, err
+	}
 	roles := []string{}
 	switch {
 	case config.NodeExporter.Deploy != nil:
@@ -392,6 +555,9 @@ func ParseMonitorInfo(dingoadm *cli.DingoAdm, info string, infoType string) ([]*
 	}
 	ret := []*MonitorConfig{}
 	for _, role := range roles {
+		if isExternalRole(&config, role) {
+			continue
+		}
 		// prometheus/grafana use as default host
 		serviceHosts := getHost(&config, role)
 		host := serviceHosts[0]
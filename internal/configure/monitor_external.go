@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package configure
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/errno"
+)
+
+/*
+ * external prometheus/grafana in monitor.yaml:
+ *
+ *   prometheus:
+ *     external:
+ *       sd: file                          # file: publish file_sd targets over SSH
+ *       host: prometheus-host             #       host in hosts.yaml which prometheus runs on
+ *       sd_dir: /etc/prometheus/file_sd   #       directory watched by file_sd_configs
+ *       # sd: http                        # http: serve targets by `dingoadm monitor sd`
+ *       # sd_listen: :9099
+ *   grafana:
+ *     external:
+ *       url: http://10.0.0.1:3000
+ *       token: <service account token>
+ *       datasource: Prometheus            # datasource which dashboards use
+ */
+const (
+	ROLE_EXTERNAL_PROMETHEUS = "external_prometheus"
+	ROLE_EXTERNAL_GRAFANA    = "external_grafana"
+
+	KEY_EXTERNAL_SD            = "sd"
+	KEY_EXTERNAL_SD_DIR        = "sd_dir"
+	KEY_EXTERNAL_SD_LISTEN     = "sd_listen"
+	KEY_EXTERNAL_GRAFANA_URL   = "url"
+	KEY_EXTERNAL_GRAFANA_TOKEN = "token"
+	KEY_EXTERNAL_DATASOURCE    = "datasource"
+
+	SD_TYPE_FILE               = "file"
+	SD_TYPE_HTTP               = "http"
+	DEFAULT_SD_LISTEN          = ":9099"
+	DEFAULT_GRAFANA_DATASOURCE = "Prometheus"
+)
+
+func (m *MonitorConfig) IsExternal() bool {
+	return m.role == ROLE_EXTERNAL_PROMETHEUS || m.role == ROLE_EXTERNAL_GRAFANA
+}
+
+func (m *MonitorConfig) GetSDType() string {
+	return m.getString(&m.config, KEY_EXTERNAL_SD)
+}
+
+func (m *MonitorConfig) GetSDDir() string {
+	return m.getString(&m.config, KEY_EXTERNAL_SD_DIR)
+}
+
+func (m *MonitorConfig) GetSDListen() string {
+	listen := m.getString(&m.config, KEY_EXTERNAL_SD_LISTEN)
+	if len(listen) == 0 {
+		return DEFAULT_SD_LISTEN
+	}
+	return listen
+}
+
+// GetSDFile returns the target file published into file_sd directory
+func (m *MonitorConfig) GetSDFile(clusterName string) string {
+	return filepath.Join(m.GetSDDir(), fmt.Sprintf("dingoadm_%s.json", clusterName))
+}
+
+func (m *MonitorConfig) GetGrafanaURL() string {
+	return m.getString(&m.config, KEY_EXTERNAL_GRAFANA_URL)
+}
+
+func (m *MonitorConfig) GetGrafanaToken() string {
+	return m.getString(&m.config, KEY_EXTERNAL_GRAFANA_TOKEN)
+}
+
+func (m *MonitorConfig) GetGrafanaDatasource() string {
+	datasource := m.getString(&m.config, KEY_EXTERNAL_DATASOURCE)
+	if len(datasource) == 0 {
+		return DEFAULT_GRAFANA_DATASOURCE
+	}
+	return datasource
+}
+
+// isExternalRole returns whether the role is served by external prometheus/grafana,
+// monitor_sync only feeds the deployed prometheus
+func isExternalRole(config *Monitor, role string) bool {
+	prometheus := config.Prometheus.External != nil
+	switch role {
+	case ROLE_PROMETHEUS, ROLE_MONITOR_SYNC:
+		return prometheus
+	case ROLE_GRAFANA:
+		return config.Grafana.External != nil || (prometheus && config.Grafana.Config == nil)
+	}
+	return false
+}
+
+func checkExternal(config *Monitor) error {
+	if external := config.Prometheus.External; external != nil {
+		sd, _ := external[KEY_EXTERNAL_SD].(string)
+		switch sd {
+		case SD_TYPE_FILE:
+			host, _ := external[KEY_HOST].(string)
+			dir, _ := external[KEY_EXTERNAL_SD_DIR].(string)
+			if len(host) == 0 || !filepath.IsAbs(dir) {
+				return errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.
+					F("external prometheus with file_sd requires 'host' and absolute 'sd_dir'")
+			}
+		case SD_TYPE_HTTP:
+		default:
+			return errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.
+				F("external prometheus 'sd' should be '%s' or '%s', got '%s'", SD_TYPE_FILE, SD_TYPE_HTTP, sd)
+		}
+		if config.Grafana.External == nil && config.Grafana.Config != nil {
+			return errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.
+				F("grafana requires external mode when prometheus is external")
+		}
+	}
+
+	if external := config.Grafana.External; external != nil {
+		url, _ := external[KEY_EXTERNAL_GRAFANA_URL].(string)
+		token, _ := external[KEY_EXTERNAL_GRAFANA_TOKEN].(string)
+		if len(url) == 0 || len(token) == 0 {
+			return errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.
+				F("external grafana requires 'url' and 'token'")
+		}
+	}
+	return nil
+}
+
+// ParseExternalMonitor returns the external prometheus/grafana declared in monitor.yaml,
+// which never deployed by dingoadm but get targets and dashboards published
+func ParseExternalMonitor(dingoadm *cli.DingoAdm, info string, infoType string) ([]*MonitorConfig, error) {
+	config, err := readMonitor(info, infoType)
+	if err != nil {
+		return nil, err
+	} else if err := checkExternal(&config); err != nil {
+		return nil, err
+	}
+
+	ret := []*MonitorConfig{}
+	if external := config.Prometheus.External; external != nil {
+		_, hostIps, dcs, err := parseHosts(dingoadm)
+		if err != nil {
+			return nil, err
+		} else if len(dcs) == 0 {
+			return nil, errno.ERR_NO_SERVICES_IN_TOPOLOGY
+		}
+		target, err := parsePrometheusTarget(dcs)
+		if err != nil {
+			return nil, err
+		}
+		external[KEY_PROMETHEUS_TARGET] = target
+		if config.NodeExporter.Deploy != nil {
+			external[KEY_NODE_IPS] = hostIps
+			external[KRY_NODE_LISTEN_PORT] = config.NodeExporter.Config[KEY_LISTEN_PORT]
+		}
+		host, _ := external[KEY_HOST].(string)
+		ret = append(ret, &MonitorConfig{
+			kind:   dcs[0].GetKind(),
+			id:     ROLE_EXTERNAL_PROMETHEUS,
+			role:   ROLE_EXTERNAL_PROMETHEUS,
+			host:   host,
+			config: external,
+			order:  2,
+		})
+	}
+	if external := config.Grafana.External; external != nil {
+		ret = append(ret, &MonitorConfig{
+			id:     ROLE_EXTERNAL_GRAFANA,
+			role:   ROLE_EXTERNAL_GRAFANA,
+			config: external,
+			order:  3,
+		})
+	}
+	return ret, nil
+}
+
+// ParseExternalTarget returns all targets for external prometheus, including services
+// in topology, node_exporter, clients and gateways, labeled with the cluster name
+func ParseExternalTarget(dingoadm *cli.DingoAdm, mc *MonitorConfig) (string, error) {
+	targets := []serviceTarget{}
+	if err := json.Unmarshal([]byte(mc.GetPrometheusTarget()), &targets); err != nil {
+		return "", errno.ERR_PARSE_PROMETHEUS_TARGET_FAILED.E(err)
+	}
+
+	if ips := mc.GetNodeIps(); len(ips) > 0 {
+		node := serviceTarget{Labels: map[string]string{"job": JOB_NODE}}
+		for _, ip := range ips {
+			node.Targets = append(node.Targets, fmt.Sprintf("%s:%d", ip, mc.GetNodeListenPort()))
+		}
+		targets = append(targets, node)
+	}
+
+	clients, err := parseClientTargets(dingoadm)
+	if err != nil {
+		return "", err
+	}
+	targets = append(targets, clients...)
+	targets, err = labelMaintenance(dingoadm, targets)
+	if err != nil {
+		return "", err
+	}
+	for _, target := range targets {
+		target.Labels["cluster"] = dingoadm.ClusterName()
+	}
+
+	data, err := json.Marshal(targets)
+	if err != nil {
+		return "", errno.ERR_PARSE_PROMETHEUS_TARGET_FAILED.E(err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,54 @@
+package configure
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseServerTargets(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx := topology.NewContext()
+	ctx.Add("host1", "10.0.0.1")
+	ctx.Add("host2", "10.0.0.2")
+	dcs, err := topology.ParseTopology(`
+kind: dingo-store
+global:
+  container_image: dingodatabase/dingo-store:latest
+
+coordinator_services:
+  config:
+    server.port: 6500
+    raft.port: 7500
+  deploy:
+    - host: host1
+    - host: host2
+
+store_services:
+  config:
+    server.port: 6600
+    raft.port: 7600
+  deploy:
+    - host: host1
+`, ctx)
+	assert.Nil(err)
+
+	targets := parseServerTargets(dcs)
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].Labels["job"] < targets[j].Labels["job"]
+	})
+	// metrics path of existing jobs unchanged, which dashboards depend on
+	assert.Equal([]serviceTarget{
+		{
+			Labels:  map[string]string{"job": topology.ROLE_COORDINATOR},
+			Targets: []string{"10.0.0.1:6500", "10.0.0.2:6500"},
+		},
+		{
+			Labels:  map[string]string{"job": topology.ROLE_STORE},
+			Targets: []string{"10.0.0.1:6600"},
+		},
+	}, targets)
+}
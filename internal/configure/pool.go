@@ -29,8 +29,11 @@ package configure
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/utils"
 )
 
 const (
@@ -46,6 +49,12 @@ const (
 	DEFAULT_SCATTER_WIDTH        = 0
 )
 
+var (
+	// host label keys which used as failure domain, from coarse to fine,
+	// e.g. labels: ["az=az1", "rack=r3"]
+	FAILURE_DOMAIN_LABELS = []string{"az", "zone", "rack"}
+)
+
 type (
 	MigrateServer struct {
 		From *topology.DeployConfig
@@ -156,7 +165,61 @@ func formatName(dc *topology.DeployConfig) string {
 	return fmt.Sprintf("%s_%s_%d", dc.GetHost(), dc.GetName(), dc.GetInstancesSequence())
 }
 
-func createLogicalPool(dcs []*topology.DeployConfig, logicalPool, poolset string) (LogicalPool, []Server) {
+func getLabelValue(labels []string, key string) (string, bool) {
+	for _, label := range labels {
+		if strings.HasPrefix(label, key+"=") {
+			return strings.TrimPrefix(label, key+"="), true
+		}
+	}
+	return "", false
+}
+
+/*
+ * getFailureDomains returns the zone of each host (key: host, value: zone)
+ * which derived from host labels, the first label key in FAILURE_DOMAIN_LABELS
+ * which labeled on all hosts and has at least `replicas` distinct values wins,
+ * nil means no failure domain labels found and zone will be assigned by round-robin.
+ */
+func getFailureDomains(hosts []string, hostLabels map[string][]string,
+	pool string, replicas int) (map[string]string, error) {
+	if len(hosts) == 0 {
+		return nil, nil
+	}
+
+	var err error
+	for _, key := range FAILURE_DOMAIN_LABELS {
+		zones := map[string]string{}
+		distinct := map[string]bool{}
+		for _, host := range hosts {
+			value, ok := getLabelValue(hostLabels[host], key)
+			if !ok {
+				break
+			}
+			zones[host] = fmt.Sprintf("%s_%s", key, value)
+			distinct[value] = true
+		}
+
+		if len(zones) != len(hosts) {
+			continue
+		} else if len(distinct) >= replicas {
+			return zones, nil
+		} else if err == nil {
+			err = errno.ERR_INSUFFICIENT_FAILURE_DOMAINS.
+				F("pool=%s label=%s domains=%d replicas=%d", pool, key, len(distinct), replicas)
+		}
+	}
+	return nil, err
+}
+
+func createLogicalPool(dcs []*topology.DeployConfig, logicalPool, poolset string,
+	replicas int, hostLabels map[string][]string) (LogicalPool, []Server, error) {
+	if len(dcs) == 0 {
+		return LogicalPool{}, nil, errno.ERR_NO_SERVICES_FOR_POOL.F("pool=%s", logicalPool)
+	} else if replicas <= 0 {
+		return LogicalPool{}, nil, errno.ERR_INVALID_POOL_REPLICAS.
+			F("pool=%s replicas=%d", logicalPool, replicas)
+	}
+
 	var zone string
 	copysets := 0
 	servers := []Server{}
@@ -165,10 +228,31 @@ func createLogicalPool(dcs []*topology.DeployConfig, logicalPool, poolset string
 	physicalPool := logicalPool
 	kind := dcs[0].GetKind()
 	SortDeployConfigs(dcs)
+
+	// zones derived from failure domain of hosts if labeled
+	hosts := []string{}
+	for _, dc := range dcs {
+		if dc.GetRole() == ROLE_METASERVER && kind == KIND_DINGOFS && !utils.Contains(hosts, dc.GetHost()) {
+			hosts = append(hosts, dc.GetHost())
+		}
+	}
+	domains, err := getFailureDomains(hosts, hostLabels, logicalPool, replicas)
+	if err != nil {
+		return LogicalPool{}, nil, err
+	} else if domains != nil {
+		distinct := map[string]bool{}
+		for _, zone := range domains {
+			distinct[zone] = true
+		}
+		zones = len(distinct)
+	}
+
 	for _, dc := range dcs {
 		role := dc.GetRole()
 		if role == ROLE_METASERVER && kind == KIND_DINGOFS {
-			if dc.GetParentId() == dc.GetId() {
+			if domains != nil {
+				zone = domains[dc.GetHost()]
+			} else if dc.GetParentId() == dc.GetId() {
 				zone = nextZone()
 			}
 
@@ -204,7 +288,7 @@ func createLogicalPool(dcs []*topology.DeployConfig, logicalPool, poolset string
 	}
 
 	// copysets
-	copysets = (int)(copysets / DEFAULT_REPLICAS_PER_COPYSET)
+	copysets = (int)(copysets / replicas)
 	if copysets == 0 {
 		copysets = 1
 	}
@@ -214,7 +298,7 @@ func createLogicalPool(dcs []*topology.DeployConfig, logicalPool, poolset string
 		Name:     logicalPool,
 		Copysets: copysets,
 		Zones:    zones,
-		Replicas: DEFAULT_REPLICAS_PER_COPYSET,
+		Replicas: replicas,
 	}
 	if kind == KIND_CURVEBS {
 		lpool.ScatterWidth = DEFAULT_SCATTER_WIDTH
@@ -222,11 +306,15 @@ func createLogicalPool(dcs []*topology.DeployConfig, logicalPool, poolset string
 		lpool.PhysicalPool = physicalPool
 	}
 
-	return lpool, servers
+	return lpool, servers, nil
 }
 
-func generateClusterPool(dcs []*topology.DeployConfig, poolName string, poolset Poolset) DingoFsClusterTopo {
-	lpool, servers := createLogicalPool(dcs, poolName, poolset.Name)
+func generateClusterPool(dcs []*topology.DeployConfig, poolName string, poolset Poolset,
+	replicas int, hostLabels map[string][]string) (DingoFsClusterTopo, error) {
+	lpool, servers, err := createLogicalPool(dcs, poolName, poolset.Name, replicas, hostLabels)
+	if err != nil {
+		return DingoFsClusterTopo{}, err
+	}
 	topo := DingoFsClusterTopo{Servers: servers, NPools: 1}
 	if dcs[0].GetKind() == KIND_CURVEBS {
 		topo.LogicalPools = []LogicalPool{lpool}
@@ -234,12 +322,30 @@ func generateClusterPool(dcs []*topology.DeployConfig, poolName string, poolset
 	} else {
 		topo.Pools = []LogicalPool{lpool}
 	}
-	return topo
+	return topo, nil
+}
+
+// GetReplicas returns replicas of the last pool, 0 if no pool
+func (topo *DingoFsClusterTopo) GetReplicas() int {
+	pools := topo.Pools
+	if len(topo.LogicalPools) > 0 {
+		pools = topo.LogicalPools
+	}
+	if len(pools) == 0 {
+		return 0
+	}
+	return pools[len(pools)-1].Replicas
 }
 
-func ScaleOutClusterPool(old *DingoFsClusterTopo, dcs []*topology.DeployConfig, poolset Poolset) {
+// the new pool keeps the same replicas with the old pools
+func ScaleOutClusterPool(old *DingoFsClusterTopo, dcs []*topology.DeployConfig, poolset Poolset,
+	hostLabels map[string][]string) error {
 	npools := old.NPools
-	topo := generateClusterPool(dcs, fmt.Sprintf("pool%d", npools+1), poolset)
+	topo, err := generateClusterPool(dcs, fmt.Sprintf("pool%d", npools+1), poolset,
+		old.GetReplicas(), hostLabels)
+	if err != nil {
+		return err
+	}
 	if dcs[0].GetKind() == KIND_CURVEBS {
 		// logical pools
 		for _, pool := range topo.LogicalPools {
@@ -265,6 +371,7 @@ func ScaleOutClusterPool(old *DingoFsClusterTopo, dcs []*topology.DeployConfig,
 		old.Servers = append(old.Servers, server)
 	}
 	old.NPools = old.NPools + 1
+	return nil
 }
 
 func MigrateClusterServer(old *DingoFsClusterTopo, migrates []*MigrateServer) {
@@ -290,7 +397,15 @@ func MigrateClusterServer(old *DingoFsClusterTopo, migrates []*MigrateServer) {
 	}
 }
 
-func GenerateDefaultClusterPool(dcs []*topology.DeployConfig, poolset Poolset) (topo DingoFsClusterTopo, err error) {
-	topo = generateClusterPool(dcs, "pool1", poolset)
+// hostLabels (key: host, value: labels) is used to derive zones from failure domain, it can be nil
+func GenerateDefaultClusterPool(dcs []*topology.DeployConfig, poolset Poolset,
+	hostLabels map[string][]string) (topo DingoFsClusterTopo, err error) {
+	return GenerateClusterPool(dcs, poolset, DEFAULT_REPLICAS_PER_COPYSET, hostLabels)
+}
+
+// GenerateClusterPool generates pool with specified replicas, e.g. replicas of the existing pool
+func GenerateClusterPool(dcs []*topology.DeployConfig, poolset Poolset,
+	replicas int, hostLabels map[string][]string) (topo DingoFsClusterTopo, err error) {
+	topo, err = generateClusterPool(dcs, "pool1", poolset, replicas, hostLabels)
 	return
 }
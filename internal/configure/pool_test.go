@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package configure
+
+import (
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFailureDomains(t *testing.T) {
+	assert := assert.New(t)
+	hosts := []string{"host1", "host2", "host3"}
+
+	// no labels: assign zone by round-robin
+	domains, err := getFailureDomains(hosts, nil, "pool1", 3)
+	assert.Nil(err)
+	assert.Nil(domains)
+
+	// zone derived from rack
+	domains, err = getFailureDomains(hosts, map[string][]string{
+		"host1": {"rack=r1"},
+		"host2": {"rack=r2"},
+		"host3": {"rack=r3"},
+	}, "pool1", 3)
+	assert.Nil(err)
+	assert.Equal(map[string]string{"host1": "rack_r1", "host2": "rack_r2", "host3": "rack_r3"}, domains)
+
+	// az is preferred if it has enough failure domains
+	domains, err = getFailureDomains(hosts, map[string][]string{
+		"host1": {"az=az1", "rack=r1"},
+		"host2": {"az=az2", "rack=r1"},
+		"host3": {"az=az3", "rack=r2"},
+	}, "pool1", 3)
+	assert.Nil(err)
+	assert.Equal("az_az2", domains["host2"])
+
+	// fallback to rack if az has not enough failure domains
+	domains, err = getFailureDomains(hosts, map[string][]string{
+		"host1": {"az=az1", "rack=r1"},
+		"host2": {"az=az1", "rack=r2"},
+		"host3": {"az=az2", "rack=r3"},
+	}, "pool1", 3)
+	assert.Nil(err)
+	assert.Equal("rack_r2", domains["host2"])
+
+	// not enough failure domains
+	_, err = getFailureDomains(hosts, map[string][]string{
+		"host1": {"rack=r1"},
+		"host2": {"rack=r1"},
+		"host3": {"rack=r2"},
+	}, "pool1", 3)
+	assert.Equal(errno.ERR_INSUFFICIENT_FAILURE_DOMAINS.GetCode(), err.(*errno.ErrorCode).GetCode())
+
+	// label missing on some host
+	domains, err = getFailureDomains(hosts, map[string][]string{
+		"host1": {"rack=r1"},
+		"host2": {"rack=r2"},
+		"host3": {"store"},
+	}, "pool1", 3)
+	assert.Nil(err)
+	assert.Nil(domains)
+}
+
+func TestGenerateClusterPoolInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	// no services
+	_, err := GenerateClusterPool(nil, Poolset{}, 3, nil)
+	assert.Equal(errno.ERR_NO_SERVICES_FOR_POOL.GetCode(), err.(*errno.ErrorCode).GetCode())
+
+	// invalid replicas of old pool
+	old := DingoFsClusterTopo{}
+	assert.Equal(0, old.GetReplicas())
+	err = ScaleOutClusterPool(&old, nil, Poolset{}, nil)
+	assert.Equal(errno.ERR_NO_SERVICES_FOR_POOL.GetCode(), err.(*errno.ErrorCode).GetCode())
+
+	old.Pools = []LogicalPool{{Name: "pool1", Replicas: 3}, {Name: "pool2", Replicas: 2}}
+	assert.Equal(2, old.GetReplicas())
+
+	// invalid replicas
+	dc, err := topology.NewDeployConfig(topology.NewContext(), KIND_DINGOFS, ROLE_METASERVER,
+		"host1", "metaserver", 1, 0, 0, map[string]interface{}{})
+	assert.Nil(err)
+	_, err = GenerateClusterPool([]*topology.DeployConfig{dc}, Poolset{}, 0, nil)
+	assert.Equal(errno.ERR_INVALID_POOL_REPLICAS.GetCode(), err.(*errno.ErrorCode).GetCode())
+	old.Pools = []LogicalPool{{Name: "pool1"}}
+	err = ScaleOutClusterPool(&old, []*topology.DeployConfig{dc}, Poolset{}, nil)
+	assert.Equal(errno.ERR_INVALID_POOL_REPLICAS.GetCode(), err.(*errno.ErrorCode).GetCode())
+}
@@ -109,9 +109,10 @@ type (
 	}
 
 	FilterOption struct {
-		Id   string
-		Role string
-		Host string
+		Id     string
+		Role   string
+		Host   string
+		Labels string // host label selector, empty or "*" means all hosts
 	}
 )
 
@@ -235,7 +236,9 @@ func (dc *DeployConfig) convert() error {
 	// init service config
 	for k, v := range dc.config {
 		item := itemset.get(k)
-		if item == nil || item.exclude == false {
+		if IsDiskConfig(k) { // disk section is used by dingoadm only
+			continue
+		} else if item == nil || item.exclude == false {
 			dc.serviceConfig[k] = v.(string)
 		}
 	}
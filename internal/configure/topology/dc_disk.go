@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package topology
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	// disk section in service config, e.g.
+	//   disk.raft_dir: /dev/nvme0n1
+	//   disk.fs_type: xfs
+	DISK_CONFIG_PREFIX      = "disk."
+	DISK_KEY_FS_TYPE        = "fs_type"
+	DISK_KEY_MOUNT_OPTIONS  = "mount_options"
+	DISK_KEY_MIN_FREE_SPACE = "min_free_space"
+
+	DISK_FS_TYPE_EXT4 = "ext4"
+	DISK_FS_TYPE_XFS  = "xfs"
+
+	DEFAULT_DISK_FS_TYPE       = DISK_FS_TYPE_EXT4
+	DEFAULT_DISK_MOUNT_OPTIONS = "defaults,noatime"
+
+	DIR_ABSENT = "-"
+)
+
+type Disk struct {
+	Dir          string // data_dir, raft_dir, doc_dir or vector_dir
+	Device       string
+	MountPoint   string
+	FSType       string
+	MountOptions string
+	MinFreeSpace uint64 // bytes, 0 means no limit
+}
+
+// dir which could be mounted from a disk, and its mount point
+var diskDirs = map[string]func(dc *DeployConfig) string{
+	CONFIG_DATA_DIR.key:                 (*DeployConfig).GetDataDir,
+	CONFIG_DINGO_STORE_RAFT_DIR.key:     (*DeployConfig).GetDingoRaftDir,
+	CONFIG_DINGO_STORE_DOCUMENT_DIR.key: (*DeployConfig).GetDingoStoreDocDir,
+	CONFIG_DINGO_STORE_VECTOR_DIR.key:   (*DeployConfig).GetDingoStoreVectorDir,
+}
+
+func IsDiskConfig(key string) bool {
+	return strings.HasPrefix(key, DISK_CONFIG_PREFIX)
+}
+
+func (dc *DeployConfig) getDiskConfig(key string) string {
+	v, ok := dc.config[DISK_CONFIG_PREFIX+key]
+	if !ok {
+		return ""
+	}
+	s, _ := v.(string)
+	return strings.TrimSpace(s)
+}
+
+// GetDisks returns the disks which need to be prepared for service directories,
+// sorted by directory
+func (dc *DeployConfig) GetDisks() ([]Disk, error) {
+	fsType := DEFAULT_DISK_FS_TYPE
+	mountOptions := DEFAULT_DISK_MOUNT_OPTIONS
+	var minFreeSpace uint64
+	if v := dc.getDiskConfig(DISK_KEY_FS_TYPE); len(v) > 0 {
+		fsType = v
+	}
+	if v := dc.getDiskConfig(DISK_KEY_MOUNT_OPTIONS); len(v) > 0 {
+		mountOptions = v
+	}
+	if v := dc.getDiskConfig(DISK_KEY_MIN_FREE_SPACE); len(v) > 0 {
+		size, err := humanize.ParseBytes(v)
+		if err != nil {
+			return nil, errno.ERR_INVALID_DISK_CONFIGURE.
+				F("%s.host[%s].disk.%s: %s", dc.GetRole(), dc.GetHost(), DISK_KEY_MIN_FREE_SPACE, v)
+		}
+		minFreeSpace = size
+	}
+	if fsType != DISK_FS_TYPE_EXT4 && fsType != DISK_FS_TYPE_XFS {
+		return nil, errno.ERR_INVALID_DISK_CONFIGURE.
+			F("%s.host[%s].disk.%s: %s (ext4 or xfs)", dc.GetRole(), dc.GetHost(), DISK_KEY_FS_TYPE, fsType)
+	}
+
+	disks := []Disk{}
+	for k := range dc.config {
+		if !IsDiskConfig(k) {
+			continue
+		}
+		key := strings.TrimPrefix(k, DISK_CONFIG_PREFIX)
+		if key == DISK_KEY_FS_TYPE || key == DISK_KEY_MOUNT_OPTIONS || key == DISK_KEY_MIN_FREE_SPACE {
+			continue
+		}
+
+		getter, ok := diskDirs[key]
+		if !ok {
+			return nil, errno.ERR_INVALID_DISK_CONFIGURE.
+				F("%s.host[%s].%s: unsupport directory", dc.GetRole(), dc.GetHost(), k)
+		}
+		mountPoint := getter(dc)
+		if len(mountPoint) == 0 || mountPoint == DIR_ABSENT {
+			return nil, errno.ERR_INVALID_DISK_CONFIGURE.
+				F("%s.host[%s].%s: %s has no %s", dc.GetRole(), dc.GetHost(), k, dc.GetRole(), key)
+		}
+		device := dc.getDiskConfig(key)
+		if !strings.HasPrefix(device, "/dev/") {
+			return nil, errno.ERR_INVALID_DISK_CONFIGURE.
+				F("%s.host[%s].%s: %s (requires a device path)", dc.GetRole(), dc.GetHost(), k, device)
+		}
+		disks = append(disks, Disk{
+			Dir:          key,
+			Device:       device,
+			MountPoint:   mountPoint,
+			FSType:       fsType,
+			MountOptions: mountOptions,
+			MinFreeSpace: minFreeSpace,
+		})
+	}
+
+	sort.Slice(disks, func(i, j int) bool {
+		return disks[i].Dir < disks[j].Dir
+	})
+	return disks, nil
+}
+
+// FilterDiskConfigs returns deploy configs which have disk section
+func FilterDiskConfigs(dcs []*DeployConfig) []*DeployConfig {
+	out := []*DeployConfig{}
+	for _, dc := range dcs {
+		for k := range dc.config {
+			if IsDiskConfig(k) {
+				out = append(out, dc)
+				break
+			}
+		}
+	}
+	return out
+}
@@ -294,6 +294,10 @@ func (dc *DeployConfig) GetDingoDBMySQLPort() int {
 	return dc.getInt(CONFIG_DINGODB_EXECUTOR_MYSQL_PORT)
 }
 
+func (dc *DeployConfig) GetDingoDBMetricsPort() int {
+	return dc.getInt(CONFIG_DINGODB_EXECUTOR_METRICS_PORT)
+}
+
 func (dc *DeployConfig) GetDingoDBExportPort() int {
 	return dc.getInt(CONFIG_DINGODB_WEB_EXPORT_PORT)
 }
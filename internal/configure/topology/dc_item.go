@@ -70,6 +70,7 @@ const (
 	DEFAULT_METASERVER_COPYSETS             = 100 // copysets per metaserver
 	DEFAULT_DINGODB_EXECUTOR_SERVER_PORT    = 8765
 	DEFAULT_DINGODB_EXECUTOR_MYSQL_PORT     = 3307
+	DEFAULT_DINGODB_EXECUTOR_METRICS_PORT   = 8766
 	DEFAULT_DOCUMENT_SERVER_PORT            = 23001
 	DEFAULT_DOCUMENT_RAFT_PORT              = 23101
 	DEFAULT_INDEX_SERVER_PORT               = 21001
@@ -624,6 +625,14 @@ var (
 		DEFAULT_DINGODB_EXECUTOR_MYSQL_PORT,
 	)
 
+	CONFIG_DINGODB_EXECUTOR_METRICS_PORT = itemset.insert(
+		KIND_DINGODB,
+		"metricsPort",
+		REQUIRE_POSITIVE_INTEGER,
+		false,
+		DEFAULT_DINGODB_EXECUTOR_METRICS_PORT,
+	)
+
 	CONFIG_DINGODB_WEB_EXPORT_PORT = itemset.insert(
 		KIND_DINGODB,
 		"exportPort",
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package topology
+
+var (
+	// ROLE_DEPENDENCIES is the role dependency graph of each cluster kind (key: role, value: roles it depends on),
+	// the role depends on others is started after them and stopped before them
+	ROLE_DEPENDENCIES = map[string]map[string][]string{
+		KIND_CURVEBS: {
+			ROLE_FS_MDS:        {ROLE_ETCD},
+			ROLE_CHUNKSERVER:   {ROLE_FS_MDS},
+			ROLE_SNAPSHOTCLONE: {ROLE_CHUNKSERVER},
+		},
+		KIND_CURVEFS: {
+			ROLE_FS_MDS:     {ROLE_ETCD},
+			ROLE_METASERVER: {ROLE_FS_MDS},
+		},
+		KIND_DINGOFS: {
+			ROLE_STORE:      {ROLE_COORDINATOR},
+			ROLE_FS_MDS:     {ROLE_ETCD, ROLE_STORE},
+			ROLE_METASERVER: {ROLE_FS_MDS},
+		},
+		KIND_DINGOSTORE: {
+			ROLE_STORE:            {ROLE_COORDINATOR},
+			ROLE_DINGODB_DOCUMENT: {ROLE_STORE},
+			ROLE_DINGODB_INDEX:    {ROLE_STORE},
+			ROLE_DINGODB_DISKANN:  {ROLE_STORE},
+		},
+		KIND_DINGODB: {
+			ROLE_STORE:            {ROLE_COORDINATOR},
+			ROLE_DINGODB_DOCUMENT: {ROLE_STORE},
+			ROLE_DINGODB_INDEX:    {ROLE_STORE},
+			ROLE_DINGODB_DISKANN:  {ROLE_STORE},
+			ROLE_FS_MDS:           {ROLE_DINGODB_DOCUMENT, ROLE_DINGODB_INDEX, ROLE_DINGODB_DISKANN},
+			ROLE_DINGODB_EXECUTOR: {ROLE_FS_MDS, ROLE_DINGODB_DOCUMENT, ROLE_DINGODB_INDEX, ROLE_DINGODB_DISKANN},
+			ROLE_DINGODB_PROXY:    {ROLE_DINGODB_EXECUTOR},
+			ROLE_DINGODB_WEB:      {ROLE_DINGODB_EXECUTOR},
+		},
+	}
+)
+
+// GetRoleTier returns the tier of role in dependency graph, the role without
+// dependency is in tier 0, others are in the next tier of their deepest dependency
+func GetRoleTier(kind, role string) int {
+	return getRoleTier(ROLE_DEPENDENCIES[kind], role, map[string]bool{})
+}
+
+func getRoleTier(graph map[string][]string, role string, visiting map[string]bool) int {
+	if visiting[role] { // NOTE: never happen unless the graph has cycle
+		return 0
+	}
+	visiting[role] = true
+	defer delete(visiting, role)
+
+	tier := 0
+	for _, dep := range graph[role] {
+		if t := getRoleTier(graph, dep, visiting) + 1; t > tier {
+			tier = t
+		}
+	}
+	return tier
+}
+
+// SplitTiers splits services into tiers in start order, services in the same tier
+// are independent of each other; the empty tier is dropped, so a subset of services
+// still keeps the order
+func SplitTiers(dcs []*DeployConfig) [][]*DeployConfig {
+	m := map[int][]*DeployConfig{}
+	last := -1
+	for _, dc := range dcs {
+		tier := GetRoleTier(dc.GetKind(), dc.GetRole())
+		m[tier] = append(m[tier], dc)
+		if tier > last {
+			last = tier
+		}
+	}
+
+	tiers := [][]*DeployConfig{}
+	for i := 0; i <= last; i++ {
+		if len(m[i]) > 0 {
+			tiers = append(tiers, m[i])
+		}
+	}
+	return tiers
+}
+
+// ReverseTiers returns tiers in stop order
+func ReverseTiers(tiers [][]*DeployConfig) [][]*DeployConfig {
+	reversed := [][]*DeployConfig{}
+	for i := len(tiers) - 1; i >= 0; i-- {
+		reversed = append(reversed, tiers[i])
+	}
+	return reversed
+}
@@ -0,0 +1,142 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRoleTier(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		kind  string
+		tiers map[string]int
+	}{
+		{
+			KIND_CURVEBS,
+			map[string]int{ROLE_ETCD: 0, ROLE_FS_MDS: 1, ROLE_CHUNKSERVER: 2, ROLE_SNAPSHOTCLONE: 3},
+		},
+		{
+			KIND_CURVEFS,
+			map[string]int{ROLE_ETCD: 0, ROLE_FS_MDS: 1, ROLE_METASERVER: 2},
+		},
+		{
+			KIND_DINGOFS,
+			map[string]int{
+				ROLE_ETCD: 0, ROLE_COORDINATOR: 0, ROLE_STORE: 1, ROLE_FS_MDS: 2, ROLE_METASERVER: 3,
+				ROLE_DINGODB_EXECUTOR: 0, // not in graph
+			},
+		},
+		{
+			KIND_DINGOSTORE,
+			map[string]int{
+				ROLE_COORDINATOR: 0, ROLE_STORE: 1,
+				ROLE_DINGODB_DOCUMENT: 2, ROLE_DINGODB_INDEX: 2, ROLE_DINGODB_DISKANN: 2,
+			},
+		},
+		{
+			KIND_DINGODB,
+			map[string]int{
+				ROLE_COORDINATOR: 0, ROLE_STORE: 1,
+				ROLE_DINGODB_DOCUMENT: 2, ROLE_DINGODB_INDEX: 2, ROLE_DINGODB_DISKANN: 2,
+				ROLE_FS_MDS: 3, ROLE_DINGODB_EXECUTOR: 4, ROLE_DINGODB_PROXY: 5, ROLE_DINGODB_WEB: 5,
+			},
+		},
+		{
+			"unknown",
+			map[string]int{ROLE_STORE: 0, ROLE_FS_MDS: 0},
+		},
+	}
+	for _, tt := range tests {
+		for role, tier := range tt.tiers {
+			assert.Equal(tier, GetRoleTier(tt.kind, role), "kind=%s role=%s", tt.kind, role)
+		}
+	}
+}
+
+func newTierDeployConfig(t *testing.T, kind, role, host string) *DeployConfig {
+	dc, err := NewDeployConfig(NewContext(), kind, role, host, "", 1, 0, 0, map[string]interface{}{})
+	assert.Nil(t, err)
+	return dc
+}
+
+func tierRoles(tiers [][]*DeployConfig) [][]string {
+	roles := [][]string{}
+	for _, tier := range tiers {
+		items := []string{}
+		for _, dc := range tier {
+			items = append(items, dc.GetRole()+"@"+dc.GetHost())
+		}
+		roles = append(roles, items)
+	}
+	return roles
+}
+
+func TestSplitTiers(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		kind   string
+		roles  []string
+		expect [][]string
+	}{
+		{
+			KIND_DINGOFS,
+			[]string{ROLE_FS_MDS, ROLE_STORE, ROLE_COORDINATOR, ROLE_ETCD},
+			[][]string{{"coordinator@host1", "etcd@host1"}, {"store@host1"}, {"mds@host1"}},
+		},
+		{
+			KIND_DINGOSTORE,
+			[]string{ROLE_DINGODB_INDEX, ROLE_STORE, ROLE_COORDINATOR, ROLE_DINGODB_DOCUMENT},
+			[][]string{{"coordinator@host1"}, {"store@host1"}, {"index@host1", "document@host1"}},
+		},
+		{
+			KIND_DINGODB,
+			[]string{ROLE_DINGODB_WEB, ROLE_DINGODB_EXECUTOR, ROLE_FS_MDS, ROLE_STORE, ROLE_COORDINATOR},
+			[][]string{{"coordinator@host1"}, {"store@host1"}, {"mds@host1"}, {"executor@host1"}, {"web@host1"}},
+		},
+		// filtered subset, the empty tiers are dropped and the order is kept
+		{
+			KIND_DINGODB,
+			[]string{ROLE_DINGODB_PROXY, ROLE_STORE},
+			[][]string{{"store@host1"}, {"proxy@host1"}},
+		},
+		{
+			KIND_DINGOFS,
+			[]string{ROLE_FS_MDS},
+			[][]string{{"mds@host1"}},
+		},
+		{KIND_DINGOFS, []string{}, [][]string{}},
+	}
+	for _, tt := range tests {
+		dcs := []*DeployConfig{}
+		for _, role := range tt.roles {
+			dcs = append(dcs, newTierDeployConfig(t, tt.kind, role, "host1"))
+		}
+		assert.Equal(tt.expect, tierRoles(SplitTiers(dcs)), "kind=%s roles=%v", tt.kind, tt.roles)
+	}
+
+	// services of the same tier keep their order in topology
+	dcs := []*DeployConfig{
+		newTierDeployConfig(t, KIND_DINGOFS, ROLE_STORE, "host2"),
+		newTierDeployConfig(t, KIND_DINGOFS, ROLE_COORDINATOR, "host1"),
+		newTierDeployConfig(t, KIND_DINGOFS, ROLE_STORE, "host1"),
+	}
+	assert.Equal([][]string{{"coordinator@host1"}, {"store@host2", "store@host1"}}, tierRoles(SplitTiers(dcs)))
+}
+
+func TestReverseTiers(t *testing.T) {
+	assert := assert.New(t)
+
+	dcs := []*DeployConfig{
+		newTierDeployConfig(t, KIND_DINGOFS, ROLE_FS_MDS, "host1"),
+		newTierDeployConfig(t, KIND_DINGOFS, ROLE_COORDINATOR, "host1"),
+		newTierDeployConfig(t, KIND_DINGOFS, ROLE_STORE, "host1"),
+	}
+	tiers := SplitTiers(dcs)
+	assert.Equal([][]string{{"mds@host1"}, {"store@host1"}, {"coordinator@host1"}}, tierRoles(ReverseTiers(tiers)))
+	// the origin tiers unchanged
+	assert.Equal([][]string{{"coordinator@host1"}, {"store@host1"}, {"mds@host1"}}, tierRoles(tiers))
+	assert.Equal([][]*DeployConfig{}, ReverseTiers([][]*DeployConfig{}))
+}
@@ -205,6 +205,7 @@ var (
 
 	// 100: database/SQL (init failed)
 	ERR_INIT_SQL_DATABASE_FAILED = EC(100000, "init SQLite database failed")
+	ERR_DATABASE_SCHEMA_TOO_NEW  = EC(100001, "database schema is newer than dingoadm supported, please upgrade dingoadm")
 
 	// 110: database/SQL (execute SQL statement: hosts table)
 	ERR_GET_HOSTS_FAILED    = EC(110000, "execute SQL failed which get hosts")
@@ -242,12 +243,29 @@ var (
 	ERR_INSERT_CLIENT_CONFIG_FAILED = EC(116000, "execute SQL failed which insert client config")
 	ERR_SELECT_CLIENT_CONFIG_FAILED = EC(116001, "execute SQL failed which select client config")
 	ERR_DELETE_CLIENT_CONFIG_FAILED = EC(116002, "execute SQL failed which delete client config")
+	ERR_REPLACE_GATEWAY_FAILED      = EC(116003, "execute SQL failed which replace gateway")
+	ERR_GET_ALL_GATEWAYS_FAILED     = EC(116004, "execute SQL failed which get all gateways")
+	ERR_REPLACE_MAINTENANCE_FAILED  = EC(116005, "execute SQL failed which replace maintenance")
+	ERR_GET_MAINTENANCES_FAILED     = EC(116006, "execute SQL failed which get maintenances")
+	ERR_DELETE_MAINTENANCE_FAILED   = EC(116007, "execute SQL failed which delete maintenance")
+	ERR_SET_CLIENT_CONFIG_FAILED    = EC(116008, "execute SQL failed which set client config")
+	ERR_DELETE_GATEWAY_FAILED       = EC(116009, "execute SQL failed which delete gateway")
 	// 117: database/SQL (execute SQL statement: monitor table)
 	ERR_GET_MONITOR_FAILED     = EC(117000, "execute SQL failed while get monitor")
 	ERR_REPLACE_MONITOR_FAILED = EC(117001, "execute SQL failed while replace monitor")
 	ERR_UPDATE_MONITOR_FAILED  = EC(117002, "execute SQL failed while update monitor")
+	// 118: database/SQL (execute SQL statement: host facts)
+	ERR_REPLACE_HOST_FACTS_FAILED = EC(118000, "execute SQL failed while replace host facts")
+	ERR_GET_HOST_FACTS_FAILED     = EC(118001, "execute SQL failed while get host facts")
+
+	// 119: database/SQL (execute SQL statement: schema migrations)
+	ERR_GET_SCHEMA_STATUS_FAILED       = EC(119000, "execute SQL failed while get database schema status")
+	ERR_MIGRATE_DATABASE_SCHEMA_FAILED = EC(119001, "migrate database schema failed")
+	ERR_BACKUP_DATABASE_FAILED         = EC(119002, "backup database failed")
 
 	// 200: command options (hosts)
+	ERR_INVALID_LABEL_SELECTOR = EC(200000, "invalid label selector")
+	ERR_NO_HOSTS_MATCHED       = EC(200001, "no hosts matched the label selector")
 
 	// 210: command options (cluster)
 	ERR_ID_NOT_FOUND                   = EC(210000, "id not found")
@@ -259,6 +277,7 @@ var (
 	ERR_NO_SERVICES_MATCHED            = EC(210006, "no services matched")
 	ERR_UNSUPPORT_DINGODB_ROLE         = EC(210007, "unsupport dingodb role (coordinator/store/executor/document/index/diskann/proxy/web)")
 	ERR_UNSUPPORT_DINGOSTORE_ROLE      = EC(210008, "unsupport dingo-store role (coordinator/store/document/index/diskann)")
+	ERR_UNSUPPORT_RENDER_TARGET        = EC(210009, "unsupport render target (k8s)")
 	// TODO: please check pool set disk type
 	ERR_INVALID_DISK_TYPE = EC(210007, "poolset disk type must be lowercase and can only be one of ssd, hdd and nvme")
 
@@ -344,6 +363,7 @@ var (
 	ERR_INSTANCES_REQUIRES_POSITIVE_INTEGER = EC(331002, "instances requires a positive integer")
 	ERR_INVALID_VARIABLE_SECTION            = EC(331003, "invalid variable section")
 	ERR_DUPLICATE_SERVICE_ID                = EC(331004, "service id is duplicate")
+	ERR_INVALID_DISK_CONFIGURE              = EC(331005, "invalid disk configure")
 	// 332: configure (topology.yaml: update topology)
 	ERR_DELETE_SERVICE_WHILE_COMMIT_TOPOLOGY_IS_DENIED   = EC(332000, "delete service while commit topology is denied")
 	ERR_ADD_SERVICE_WHILE_COMMIT_TOPOLOGY_IS_DENIED      = EC(332001, "add service while commit topology is denied")
@@ -378,10 +398,24 @@ var (
 	ERR_REQUIRE_CURVEBS_KIND_CLIENT_CONFIGURE_FILE = EC(351002, "require curvebs kind client configure file")
 	ERR_REQUIRE_CURVEFS_KIND_CLIENT_CONFIGURE_FILE = EC(351003, "require dingofs kind client configure file")
 	ERR_INVALID_CLUSTER_LISTEN_MDS_ADDRESS         = EC(351004, "invalid cluster MDS listen address")
+	// 352: configure (clients.yaml: client inventory)
+	ERR_PARSE_CLIENT_INVENTORY_FAILED = EC(352000, "parse client inventory failed")
+	ERR_INVALID_CLIENT_INVENTORY      = EC(352001, "invalid client inventory")
+	ERR_DUPLICATE_CLIENT_MOUNT_POINT  = EC(352002, "mount point is duplicate in client inventory")
 
 	// 360: configure (gateway.yaml: parse failed)
-	ERR_PARSE_GATEWAY_CONFIGURE_FAILED = EC(360000, "parse client configure failed")
+	ERR_PARSE_GATEWAY_CONFIGURE_FAILED = EC(360000, "parse gateway configure failed")
 	ERR_GATEWAY_MDSADDR_EMPTY          = EC(360001, "dingofs mdsaddr is empty")
+	ERR_INVALID_GATEWAY_CONFIGURE      = EC(360002, "invalid gateway configure")
+	ERR_INVALID_GATEWAY_ADDRESS        = EC(360003, "invalid gateway listen or console address")
+	ERR_INVALID_GATEWAY_CREDENTIALS    = EC(360004, "invalid gateway root user or password")
+	ERR_INVALID_GATEWAY_TLS_CONFIGURE  = EC(360005, "invalid gateway TLS configure")
+	ERR_DUPLICATE_GATEWAY_NAME         = EC(360006, "gateway name is duplicate")
+	ERR_GATEWAY_PORT_CONFLICT          = EC(360007, "gateway port conflicts with other gateway on the same host")
+	ERR_GATEWAY_INSTANCE_NOT_FOUND     = EC(360008, "gateway instance not found in configure")
+	ERR_GATEWAY_NOT_STARTED            = EC(360009, "gateway not started")
+	ERR_GATEWAY_ALREADY_STARTED        = EC(360010, "gateway already started on the mount point, please stop it first")
+	ERR_GATEWAY_NAME_ALREADY_STARTED   = EC(360011, "gateway with the same name already started, please stop it first")
 
 	// 400: common (hosts)
 	ERR_HOST_NOT_FOUND = EC(400000, "host not found")
@@ -410,6 +444,22 @@ var (
 	ERR_ENCRYPT_FILE_FAILED                  = EC(410021, "encrypt file failed")
 	ERR_CLIENT_ID_NOT_FOUND                  = EC(410022, "client id not found")
 	ERR_ENABLE_ETCD_AUTH_FAILED              = EC(410023, "enable etcd auth failed")
+	ERR_RENDER_K8S_MANIFEST_FAILED           = EC(410024, "render kubernetes manifest failed")
+	ERR_UNRECOGNIZED_HOST_FACTS              = EC(410025, "unrecognized host facts")
+	ERR_ENCODE_HOST_FACTS_FAILED             = EC(410026, "encode host facts failed")
+	ERR_DECODE_HOST_FACTS_FAILED             = EC(410027, "decode host facts failed")
+	ERR_EXEC_COMMAND_FAILED_IN_SERVICES      = EC(410028, "command exited with non-zero code in some services")
+	ERR_EXEC_COMMAND_REQUIRED                = EC(410029, "command is required when exec in multiple services")
+	ERR_CRITICAL_HEALTH_FINDINGS             = EC(410030, "cluster has critical health findings")
+	ERR_NOT_STORE_SERVICE                    = EC(410031, "service is not a store")
+	ERR_QUERY_REGION_MAP_FAILED              = EC(410032, "query region map by dingodb_cli failed")
+	ERR_NO_STORE_TO_TRANSFER_LEADER          = EC(410033, "no available store to transfer region leader to")
+	ERR_WAIT_STORE_LEADER_TIMEOUT            = EC(410034, "wait region leaders converged timeout")
+	ERR_HOST_ALREADY_IN_MAINTENANCE          = EC(410035, "host is already in maintenance")
+	ERR_HOST_NOT_IN_MAINTENANCE              = EC(410036, "host is not in maintenance")
+	ERR_WAIT_SERVICE_HEALTHY_TIMEOUT         = EC(410037, "wait service healthy timeout")
+	ERR_SOME_TASKS_FAILED                    = EC(410038, "some tasks failed in continue-on-error mode")
+	ERR_DEPENDENCY_TIER_FAILED               = EC(410039, "service skipped as services it depends on failed")
 
 	// 420: common (curvebs client)
 	ERR_VOLUME_ALREADY_MAPPED             = EC(420000, "volume already mapped")
@@ -427,6 +477,27 @@ var (
 	ERR_CREATE_FILESYSTEM_FAILED = EC(430001, "create filesystem failed")
 	ERR_MOUNT_FILESYSTEM_FAILED  = EC(430002, "mount filesystem failed")
 	ERR_UMOUNT_FILESYSTEM_FAILED = EC(430003, "umount filesystem failed")
+	ERR_RUN_DINGOFS_TOOL_FAILED  = EC(430004, "run dingofs tool failed")
+	ERR_NO_MDS_FOR_DINGOFS_TOOL  = EC(430005, "no mds service to run dingofs tool")
+	ERR_FILESYSTEM_NOT_FOUND     = EC(430006, "filesystem not found")
+	ERR_FILESYSTEM_IS_MOUNTED    = EC(430007, "filesystem is mounted by clients")
+	ERR_UNSUPPORT_STORAGE_TYPE   = EC(430008, "unsupport filesystem storage type")
+	ERR_INVALID_FS_QUOTA         = EC(430009, "invalid filesystem quota")
+	ERR_DELETE_FILESYSTEM_FAILED = EC(430010, "delete filesystem failed")
+	ERR_FS_CLIENT_LOSED          = EC(430011, "client container is losed")
+	ERR_WAIT_REMOUNT_TIMEOUT     = EC(430012, "wait client remount timeout")
+	ERR_BROKEN_CLIENT_MOUNTS     = EC(430013, "some client mounts are broken")
+	ERR_CLIENT_PACKAGE_NOT_FOUND = EC(430014, "dingofs client package not found")
+	ERR_INVALID_SYSTEMD_MOUNT    = EC(430015, "systemd mount requires filesystem name and mount point")
+	ERR_CONFIG_REQUIRE_RECREATE  = EC(430016, "config item requires recreating client container")
+	ERR_EDIT_CONFIG_FAILED       = EC(430017, "edit client config failed")
+	ERR_CLEAN_RUNNING_CLIENT     = EC(430018, "clean cache of running client is denied")
+	ERR_CLIENT_NOT_RUNNING       = EC(430019, "client is not running")
+	ERR_WARMUP_CACHE_FAILED      = EC(430020, "warmup client cache failed")
+	ERR_CLEAN_CACHE_FAILED       = EC(430021, "clean client cache failed")
+	ERR_CLEAN_CACHE_DIR_DENIED   = EC(430022, "clean cache directory which not under volume of client is denied")
+	ERR_CLIENT_MOUNT_BUSY        = EC(430023, "mount point is still in use, stop applications which using it and retry")
+	ERR_CLEAN_SHARED_CACHE_DIR   = EC(430024, "clean cache directory shared with running client is denied")
 
 	// 440: common (polarfs)
 	ERR_GET_OS_REELASE_FAILED       = EC(440000, "get os release failed")
@@ -446,6 +517,7 @@ var (
 	ERR_DATA_DIRECTORY_ALREADY_IN_USE   = EC(501001, "data directory already in use")
 	// 502: checker (topology/address)
 	ERR_DUPLICATE_LISTEN_ADDRESS = EC(502000, "listen address is duplicate")
+	ERR_DUPLICATE_LISTEN_PORT    = EC(502001, "listen port is duplicate on the same host")
 	// 503: checker (topology/service)
 	ERR_ETCD_REQUIRES_3_SERVICES          = EC(503000, "etcd requires at least 3 services")
 	ERR_MDS_REQUIRES_3_SERVICES           = EC(503001, "mds requires at least 3 services")
@@ -459,6 +531,13 @@ var (
 	ERR_METASERVER_REQUIRES_3_HOSTS       = EC(503009, "metaserver requires at least 3 hosts to distrubute zones")
 	ERR_COORDINATOR_REQUIRES_3_SERVICES   = EC(503010, "coordinator requires at least 3 services")
 	ERR_STORE_REQUIRES_3_SERVICES         = EC(503011, "store requires at least 3 services")
+	ERR_COORDINATOR_REQUIRES_ODD_SERVICES = EC(503012, "coordinator requires an odd number of services")
+	ERR_REPLICA_NUM_EXCEED_HOSTS          = EC(503013, "default_replica_num exceeds the number of hosts")
+	ERR_DUPLICATE_INSTANCE_START_ID       = EC(503014, "instance_start_id is duplicate")
+	ERR_INVALID_EXECUTOR_JAVA_OPTS        = EC(503015, "invalid executor java_opts")
+	ERR_INSUFFICIENT_FAILURE_DOMAINS      = EC(503016, "failure domains of pool are less than replicas")
+	ERR_INVALID_POOL_REPLICAS             = EC(503017, "replicas of pool must be greater than 0")
+	ERR_NO_SERVICES_FOR_POOL              = EC(503018, "no services for creating pool")
 
 	// 510: checker (ssh)
 	ERR_SSH_CONNECT_FAILED = EC(510000, "SSH connect failed")
@@ -485,7 +564,9 @@ var (
 	ERR_HOST_TIME_DIFFERENCE_OVER_30_SECONDS = EC(550001, "host time difference over 30 seconds")
 
 	// 560: checker (service)
-	ERR_CHUNKFILE_POOL_NOT_EXIST = EC(560000, "there is no chunkfile pool in data directory")
+	ERR_CHUNKFILE_POOL_NOT_EXIST           = EC(560000, "there is no chunkfile pool in data directory")
+	ERR_UNRECOGNIZED_HOST_MEMORY           = EC(560001, "unrecognized host memory")
+	ERR_EXECUTOR_MEMORY_EXCEED_HOST_MEMORY = EC(560002, "executor heap and direct memory exceed host memory")
 
 	// 570: checker (client)
 	ERR_INVALID_CURVEFS_CLIENT_S3_ACCESS_KEY  = EC(570000, "invalid dingofs client S3 access key")
@@ -493,6 +574,15 @@ var (
 	ERR_INVALID_CURVEFS_CLIENT_S3_ADDRESS     = EC(570002, "invalid dingofs client S3 address")
 	ERR_INVALID_CURVEFS_CLIENT_S3_BUCKET_NAME = EC(570003, "invalid dingofs client S3 bucket name")
 
+	// 580: checker (disk)
+	ERR_DISK_NOT_EMPTY                  = EC(580000, "disk is not empty, it has filesystem or partitions")
+	ERR_DISK_MOUNTED_ON_OTHER_DIRECTORY = EC(580001, "disk is mounted on other directory")
+	ERR_DISK_MOUNT_POINT_NOT_EMPTY      = EC(580002, "mount point of disk is not empty")
+	ERR_DISK_INSUFFICIENT_FREE_SPACE    = EC(580003, "free space of disk is insufficient")
+	ERR_DUPLICATE_DISK_DEVICE           = EC(580004, "disk device is duplicate on the same host")
+	ERR_PREPARE_DISK_FAILED             = EC(580005, "prepare disk failed")
+	ERR_UNRECOGNIZED_DISK_STATUS        = EC(580006, "unrecognized disk status")
+
 	// 590: checker (others)
 	ERR_CONTAINER_ENGINE_NOT_INSTALLED = EC(590000, "container engine docker/podman not installed")
 	ERR_DOCKER_DAEMON_IS_NOT_RUNNING   = EC(590001, "docker daemon is not running")
@@ -564,10 +654,17 @@ var (
 	// 640: gateway (dingofs gateway)
 	ERR_NO_HOST_FOR_GATEWAY  = EC(640000, "no host found")
 	ERR_START_GATEWAY_FAILED = EC(640001, "start s3 gateway failed")
+	ERR_GATEWAY_FS_NOT_FOUND = EC(640002, "filesystem of gateway not mounted on the mount point")
 
 	// 650: mdsv2
 	ERR_CREATE_META_TABLE_FAILED = EC(650000, "create meta table failed")
 
+	// 660: monitor (external prometheus/grafana)
+	ERR_GET_GRAFANA_DATASOURCE_FAILED      = EC(660000, "get datasource from grafana failed")
+	ERR_PROVISION_GRAFANA_DASHBOARD_FAILED = EC(660001, "provision dashboard into grafana failed")
+	ERR_EXTERNAL_PROMETHEUS_NOT_FOUND      = EC(660002, "external prometheus not found in monitor configure")
+	ERR_START_SERVICE_DISCOVERY_FAILED     = EC(660003, "start http service discovery failed")
+
 	// 690: execuetr task (others)
 	ERR_START_CRONTAB_IN_CONTAINER_FAILED = EC(690000, "start crontab in container failed")
 
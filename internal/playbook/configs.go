@@ -57,7 +57,7 @@ type SmartConfig struct {
 	ccs   []*configure.ClientConfig
 	pgcs  []*configure.PlaygroundConfig
 	mcs   []*configure.MonitorConfig
-	gc    *configure.GatewayConfig
+	gcs   []*configure.GatewayConfig
 	anys  []interface{}
 }
 
@@ -111,11 +111,11 @@ func (c *SmartConfig) GetMC(index int) *configure.MonitorConfig {
 	return c.mcs[index]
 }
 
-func (c *SmartConfig) GetGC() *configure.GatewayConfig {
-	if c.ctype != TYPE_CONFIG_GATEWAY {
+func (c *SmartConfig) GetGC(index int) *configure.GatewayConfig {
+	if index < 0 || index >= c.len || c.ctype != TYPE_CONFIG_GATEWAY {
 		return nil
 	}
-	return c.gc
+	return c.gcs[index]
 }
 
 func (c *SmartConfig) GetAny(index int) interface{} {
@@ -171,6 +171,10 @@ func NewSmartConfig(configs interface{}) (*SmartConfig, error) {
 			return c.mcs[i].GetOrder() < c.mcs[j].GetOrder()
 		})
 		c.len = len(c.mcs)
+	case []*configure.GatewayConfig:
+		c.ctype = TYPE_CONFIG_GATEWAY
+		c.gcs = configs.([]*configure.GatewayConfig)
+		c.len = len(c.gcs)
 	case []interface{}:
 		c.ctype = TYPE_CONFIG_ANY
 		c.anys = configs.([]interface{})
@@ -203,7 +207,7 @@ func NewSmartConfig(configs interface{}) (*SmartConfig, error) {
 		c.len = 1
 	case *configure.GatewayConfig:
 		c.ctype = TYPE_CONFIG_GATEWAY
-		c.gc = configs.(*configure.GatewayConfig)
+		c.gcs = append(c.gcs, configs.(*configure.GatewayConfig))
 		c.len = 1
 	case nil:
 		c.ctype = TYPE_CONFIG_NULL
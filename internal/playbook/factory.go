@@ -58,13 +58,18 @@ const (
 	CHECK_HOST_DATE
 	CHECK_CHUNKFILE_POOL
 	CHECK_S3
+	CHECK_HOST_MEMORY
+	CHECK_DISK
 	CLEAN_PRECHECK_ENVIRONMENT
 
 	// common
 	PULL_IMAGE
+	PREPARE_DISK
 	CREATE_CONTAINER
 	CREATE_MDSV2_CLI_CONTAINER
 	SYNC_CONFIG
+	DIFF_SERVICE_CONFIG
+	HOT_RELOAD_CONFIG
 	START_SERVICE
 	START_ETCD
 	ENABLE_ETCD_AUTH
@@ -85,6 +90,12 @@ const (
 	UPDATE_TOPOLOGY
 	INIT_SERVIE_STATUS
 	GET_SERVICE_STATUS
+	GET_DISK_STATUS
+	PROBE_SERVICE_HEALTH
+	PROBE_CLUSTER_HEALTH
+	WAIT_SERVICE_HEALTHY
+	TRANSFER_STORE_LEADER
+	BALANCE_STORE_LEADER
 	CLEAN_SERVICE
 	INIT_SUPPORT
 	COLLECT_REPORT
@@ -96,8 +107,14 @@ const (
 	CHECK_STORE_HEALTH
 	INIT_CLIENT_STATUS
 	GET_CLIENT_STATUS
+	CHECK_CLIENT_MOUNT
+	REMOUNT_CLIENT
+	RELOAD_CLIENT
+	CLIENT_CACHE
 	INSTALL_CLIENT
 	UNINSTALL_CLIENT
+	GATHER_HOST_FACTS
+	EXEC_SERVICE_COMMAND
 
 	// dingodb
 	START_DINGODB_DOCUMENT
@@ -121,6 +138,9 @@ const (
 	CREATE_MONITOR_CONTAINER
 	SYNC_MONITOR_ORIGIN_CONFIG
 	SYNC_MONITOR_ALT_CONFIG
+	SYNC_MONITOR_CLIENT_TARGET
+	SYNC_MONITOR_SERVER_TARGET
+	RELOAD_PROMETHEUS_CONFIG
 	SYNC_HOSTS_MAPPING
 	CLEAN_CONFIG_CONTAINER
 	START_MONITOR_SERVICE
@@ -130,6 +150,8 @@ const (
 	GET_MONITOR_STATUS
 	CLEAN_MONITOR_SERVICE
 	SYNC_GRAFANA_DASHBOARD
+	PUBLISH_MONITOR_TARGET
+	PROVISION_GRAFANA_DASHBOARD
 
 	// bs/target
 	START_TARGET_DAEMON
@@ -143,6 +165,9 @@ const (
 	CREATE_DINGOFS
 	MOUNT_FILESYSTEM
 	UMOUNT_FILESYSTEM
+	MOUNT_TARGET
+	UMOUNT_TARGET
+	RUN_FS_TOOL
 
 	// polarfs
 	DETECT_OS_RELEASE
@@ -158,6 +183,7 @@ const (
 
 	// gateway
 	START_GATEWAY
+	STOP_GATEWAY
 
 	// dingo executor
 	SYNC_JAVA_OPTS
@@ -187,7 +213,8 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 		// only need to execute task once per host
 		switch step.Type {
 		case CHECK_SSH_CONNECT,
-			GET_HOST_DATE:
+			GET_HOST_DATE,
+			CHECK_HOST_MEMORY:
 			host := config.GetDC(i).GetHost()
 			if once[host] {
 				continue
@@ -254,6 +281,10 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = checker.NewCheckChunkfilePoolTask(dingoadm, config.GetDC(i))
 		case CHECK_S3:
 			t, err = checker.NewCheckS3Task(dingoadm, config.GetDC(i))
+		case CHECK_HOST_MEMORY:
+			t, err = checker.NewCheckHostMemoryTask(dingoadm, config.GetDC(i))
+		case CHECK_DISK:
+			t, err = comm.NewCheckDiskTask(dingoadm, config.GetDC(i))
 		case CHECK_MDS_ADDRESS:
 			t, err = checker.NewCheckMdsAddressTask(dingoadm, config.GetCC(i))
 		case CHECK_STORE_HEALTH:
@@ -266,12 +297,18 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 		// common
 		case PULL_IMAGE:
 			t, err = comm.NewPullImageTask(dingoadm, config.GetDC(i))
+		case PREPARE_DISK:
+			t, err = comm.NewPrepareDiskTask(dingoadm, config.GetDC(i))
 		case CREATE_CONTAINER:
 			t, err = comm.NewCreateContainerTask(dingoadm, config.GetDC(i))
 		case CREATE_MDSV2_CLI_CONTAINER:
 			t, err = comm.NewCreateMdsv2CliContainerTask(dingoadm, config.GetDC(i))
 		case SYNC_CONFIG:
 			t, err = comm.NewSyncConfigTask(dingoadm, config.GetDC(i))
+		case DIFF_SERVICE_CONFIG:
+			t, err = comm.NewDiffServiceConfigTask(dingoadm, config.GetDC(i))
+		case HOT_RELOAD_CONFIG:
+			t, err = comm.NewHotReloadConfigTask(dingoadm, config.GetDC(i))
 		case START_SERVICE,
 			START_ETCD,
 			START_MDS,
@@ -306,6 +343,18 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = comm.NewInitServiceStatusTask(dingoadm, config.GetDC(i))
 		case GET_SERVICE_STATUS:
 			t, err = comm.NewGetServiceStatusTask(dingoadm, config.GetDC(i))
+		case GET_DISK_STATUS:
+			t, err = comm.NewGetDiskStatusTask(dingoadm, config.GetDC(i))
+		case PROBE_SERVICE_HEALTH:
+			t, err = comm.NewProbeServiceHealthTask(dingoadm, config.GetDC(i))
+		case PROBE_CLUSTER_HEALTH:
+			t, err = comm.NewProbeClusterHealthTask(dingoadm, config.GetDC(i))
+		case WAIT_SERVICE_HEALTHY:
+			t, err = comm.NewWaitServiceHealthyTask(dingoadm, config.GetDC(i))
+		case TRANSFER_STORE_LEADER:
+			t, err = comm.NewTransferStoreLeaderTask(dingoadm, config.GetDC(i))
+		case BALANCE_STORE_LEADER:
+			t, err = comm.NewBalanceStoreLeaderTask(dingoadm, config.GetDC(i))
 		case CLEAN_SERVICE:
 			t, err = comm.NewCleanServiceTask(dingoadm, config.GetDC(i))
 		case INIT_SUPPORT:
@@ -324,10 +373,22 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = comm.NewInitClientStatusTask(dingoadm, config.GetAny(i))
 		case GET_CLIENT_STATUS:
 			t, err = comm.NewGetClientStatusTask(dingoadm, config.GetAny(i))
+		case CHECK_CLIENT_MOUNT:
+			t, err = comm.NewCheckClientMountTask(dingoadm, config.GetAny(i))
+		case REMOUNT_CLIENT:
+			t, err = comm.NewRemountClientTask(dingoadm, config.GetAny(i))
+		case RELOAD_CLIENT:
+			t, err = comm.NewReloadClientTask(dingoadm, config.GetAny(i))
+		case CLIENT_CACHE:
+			t, err = comm.NewClientCacheTask(dingoadm, config.GetAny(i))
 		case INSTALL_CLIENT:
 			t, err = comm.NewInstallClientTask(dingoadm, config.GetCC(i))
 		case UNINSTALL_CLIENT:
 			t, err = comm.NewUninstallClientTask(dingoadm, nil)
+		case GATHER_HOST_FACTS:
+			t, err = comm.NewGatherHostFactsTask(dingoadm, config.GetHC(i))
+		case EXEC_SERVICE_COMMAND:
+			t, err = comm.NewExecServiceTask(dingoadm, config.GetDC(i))
 		// bs
 		case FORMAT_CHUNKFILE_POOL:
 			t, err = bs.NewFormatChunkfilePoolTask(dingoadm, config.GetFC(i))
@@ -361,10 +422,16 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = checker.NewClientS3ConfigureTask(dingoadm, config.GetCC(i))
 		case CREATE_DINGOFS:
 			t, err = fs.NewCreateDingoFSTask(dingoadm, config.GetCC(i))
+		case RUN_FS_TOOL:
+			t, err = fs.NewRunFSToolTask(dingoadm, config.GetDC(i))
 		case MOUNT_FILESYSTEM:
 			t, err = fs.NewMountFSTask(dingoadm, config.GetCC(i))
 		case UMOUNT_FILESYSTEM:
 			t, err = fs.NewUmountFSTask(dingoadm, config.GetCC(i))
+		case MOUNT_TARGET:
+			t, err = fs.NewMountTargetTask(dingoadm, config.GetAny(i))
+		case UMOUNT_TARGET:
+			t, err = fs.NewUmountTargetTask(dingoadm, config.GetAny(i))
 		// polarfs
 		case DETECT_OS_RELEASE:
 			t, err = bs.NewDetectOSReleaseTask(dingoadm, nil)
@@ -390,6 +457,16 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = monitor.NewCreateContainerTask(dingoadm, config.GetMC(i))
 		case SYNC_MONITOR_ORIGIN_CONFIG, SYNC_MONITOR_ALT_CONFIG:
 			t, err = monitor.NewSyncConfigTask(dingoadm, config.GetMC(i))
+		case PUBLISH_MONITOR_TARGET:
+			t, err = monitor.NewPublishTargetTask(dingoadm, config.GetMC(i))
+		case PROVISION_GRAFANA_DASHBOARD:
+			t, err = monitor.NewProvisionDashboardTask(dingoadm, config.GetMC(i))
+		case SYNC_MONITOR_CLIENT_TARGET:
+			t, err = monitor.NewSyncClientTargetTask(dingoadm, config.GetMC(i))
+		case SYNC_MONITOR_SERVER_TARGET:
+			t, err = monitor.NewSyncServerTargetTask(dingoadm, config.GetMC(i))
+		case RELOAD_PROMETHEUS_CONFIG:
+			t, err = monitor.NewReloadPrometheusTask(dingoadm, config.GetMC(i))
 		case SYNC_HOSTS_MAPPING:
 			t, err = monitor.NewSyncHostsMappingTask(dingoadm, config.GetMC(i))
 		case CLEAN_CONFIG_CONTAINER:
@@ -407,7 +484,9 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 		case CLEAN_MONITOR_SERVICE:
 			t, err = monitor.NewCleanMonitorTask(dingoadm, config.GetMC(i))
 		case START_GATEWAY:
-			t, err = gateway.NewStartGatewayTask(dingoadm, config.GetGC())
+			t, err = gateway.NewStartGatewayTask(dingoadm, config.GetGC(i))
+		case STOP_GATEWAY:
+			t, err = gateway.NewStopGatewayTask(dingoadm, config.GetAny(i))
 		// dingo executor
 		case SYNC_JAVA_OPTS:
 			t, err = comm.NewSyncJavaOptsTask(dingoadm, config.GetDC(i))
@@ -428,6 +507,7 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 		if config.GetType() == TYPE_CONFIG_DEPLOY { // merge task status into one
 			t.SetTid(config.GetDC(i).GetId())
 			t.SetPtid(config.GetDC(i).GetParentId())
+			p.dcs[t.Tid()] = config.GetDC(i)
 		}
 		ts.AddTask(t)
 	}
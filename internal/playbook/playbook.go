@@ -29,10 +29,26 @@
 package playbook
 
 import (
+	"strings"
+	"time"
+
 	"github.com/dingodb/dingoadm/cli/cli"
+	configure "github.com/dingodb/dingoadm/internal/configure/dingoadm"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/tasks"
 )
 
+var (
+	// name of idempotent step in dingoadm.cfg, which configures retries and timeout of step
+	STEP_POLICY_NAMES = map[int]string{
+		PULL_IMAGE:         configure.STEP_PULL_IMAGE,
+		GET_SERVICE_STATUS: configure.STEP_GET_SERVICE_STATUS,
+		GET_CLIENT_STATUS:  configure.STEP_GET_CLIENT_STATUS,
+		GET_MONITOR_STATUS: configure.STEP_GET_MONITOR_STATUS,
+	}
+)
+
 /*
  * playbook
  * ├── tasks1 (e.g.: pull image)
@@ -56,6 +72,10 @@ type (
 		Type    int
 		Configs interface{}
 		Options map[string]interface{}
+		// steps of services start in dependency order are in different tiers,
+		// in continue-on-error mode the steps of the next tiers are skipped
+		// if some tasks in this tier failed, as their dependency not ready
+		Tier int
 		tasks.ExecOptions
 	}
 
@@ -63,6 +83,18 @@ type (
 		dingoadm  *cli.DingoAdm
 		steps     []*PlaybookStep
 		postSteps []*PlaybookStep
+		dcs       map[string]*topology.DeployConfig // key: task id
+		failures  []Failure
+		continued bool
+	}
+
+	// Failure is the failed task of playbook, which is collected for summary
+	Failure struct {
+		Host      string
+		ServiceId string
+		Task      string // title of task
+		Step      string // the step which failed in task
+		Err       error
 	}
 
 	ExecOptions = tasks.ExecOptions
@@ -72,6 +104,8 @@ func NewPlaybook(dingoadm *cli.DingoAdm) *Playbook {
 	return &Playbook{
 		dingoadm: dingoadm,
 		steps:    []*PlaybookStep{},
+		dcs:      map[string]*topology.DeployConfig{},
+		failures: []Failure{},
 	}
 }
 
@@ -83,15 +117,123 @@ func (p *Playbook) AddPostStep(s *PlaybookStep) {
 	p.postSteps = append(p.postSteps, s)
 }
 
+// ContinueOnError makes the playbook go on with the remaining steps even if
+// some tasks failed, the failed tasks can be retrieved by Failures()
+func (p *Playbook) ContinueOnError() {
+	p.continued = true
+	for _, step := range p.steps {
+		step.SkipError = true
+	}
+}
+
+func (p *Playbook) Failures() []Failure {
+	return p.failures
+}
+
+// e.g. host=10.0.0.1  role=mds  containerId=1863158e02a6
+func getHostFromSubname(subname string) string {
+	for _, item := range strings.Fields(subname) {
+		if strings.HasPrefix(item, "host=") {
+			return strings.TrimPrefix(item, "host=")
+		}
+	}
+	return "-"
+}
+
+func (p *Playbook) addFailures(ts *tasks.Tasks) {
+	for _, failure := range ts.Failures() {
+		t := failure.Task
+		host, serviceId := getHostFromSubname(t.Subname()), "-"
+		if dc, ok := p.dcs[t.Tid()]; ok {
+			host, serviceId = dc.GetHost(), p.dingoadm.GetServiceId(dc.GetId())
+		}
+		step := t.FailedStep()
+		if len(step) == 0 {
+			step = "-"
+		}
+		p.failures = append(p.failures, Failure{
+			Host:      host,
+			ServiceId: serviceId,
+			Task:      t.Name(),
+			Step:      step,
+			Err:       failure.Err,
+		})
+	}
+}
+
+// addSkippedFailures records services of the step which skipped because
+// the tier it depends on failed, each service is recorded once
+func (p *Playbook) addSkippedFailures(step *PlaybookStep, failedTier int, skipped map[string]bool) {
+	dcs, ok := step.Configs.([]*topology.DeployConfig)
+	if !ok {
+		return
+	}
+	for _, dc := range dcs {
+		serviceId := p.dingoadm.GetServiceId(dc.GetId())
+		if skipped[serviceId] {
+			continue
+		}
+		skipped[serviceId] = true
+		p.failures = append(p.failures, Failure{
+			Host:      dc.GetHost(),
+			ServiceId: serviceId,
+			Task:      "-",
+			Step:      "-",
+			Err: errno.ERR_DEPENDENCY_TIER_FAILED.
+				F("role=%s, skipped as tasks in tier %d failed", dc.GetRole(), failedTier),
+		})
+	}
+}
+
+// applyStepPolicy sets timeout for tasks of the step, and returns execute
+// options with retries, the retries specified by step takes precedence
+func (p *Playbook) applyStepPolicy(step *PlaybookStep) ExecOptions {
+	config := p.dingoadm.Config()
+	name, ok := STEP_POLICY_NAMES[step.Type]
+	if !ok {
+		name = configure.STEP_DEFAULT
+	}
+	p.dingoadm.SetExecTimeout(config.GetStepTimeout(name))
+
+	options := step.ExecOptions
+	if options.Retries == 0 {
+		options.Retries = config.GetStepRetries(name)
+	}
+	if options.RetryBackoff == 0 {
+		options.RetryBackoff = time.Duration(config.GetRetryBackoff()) * time.Second
+	}
+	return options
+}
+
 func (p *Playbook) run(steps []*PlaybookStep) error {
+	defer p.dingoadm.SetExecTimeout(0)
+
+	var skipped error
+	failedTier := -1
+	skippedServices := map[string]bool{}
 	for i, step := range steps {
+		if failedTier >= 0 && step.Tier > failedTier {
+			p.addSkippedFailures(step, failedTier, skippedServices)
+			continue
+		}
+
+		options := p.applyStepPolicy(step)
 		tasks, err := p.createTasks(step)
 		if err != nil {
 			return err
 		}
 
-		err = tasks.Execute(step.ExecOptions)
-		if err != nil && step.Type != CHECK_PORT_IN_USE {
+		err = tasks.Execute(options)
+		p.addFailures(tasks)
+		if err != nil && p.continued {
+			// NOTE: go on with the next step, the first skipped error is returned at last
+			if skipped == nil {
+				skipped = err
+			}
+			if failedTier < 0 || step.Tier < failedTier {
+				failedTier = step.Tier
+			}
+		} else if err != nil && step.Type != CHECK_PORT_IN_USE {
 			return err
 		}
 
@@ -100,7 +242,7 @@ func (p *Playbook) run(steps []*PlaybookStep) error {
 			p.dingoadm.WriteOutln("")
 		}
 	}
-	return nil
+	return skipped
 }
 
 func (p *Playbook) Run() error {
@@ -25,6 +25,8 @@
 
 package driver
 
+import "errors"
+
 type IQueryResult interface {
 	Next() bool
 	Scan(dest ...any) error
@@ -35,9 +37,31 @@ type IWriteResult interface {
 	LastInsertId() (int64, error)
 }
 
+// ErrNoRowsAffected is returned by commit if any checked write affected no rows
+var ErrNoRowsAffected = errors.New("no rows affected by checked write")
+
+/*
+ * ITx is a transaction, which MUST be ended by Commit or Rollback.
+ *
+ * NOTE: there is no query in transaction, because rqlite only executes the
+ * writes as a batch on commit, any read-modify-write should put its condition
+ * into the SQL (e.g. INSERT ... WHERE NOT EXISTS) and use CheckedWrite for it.
+ */
+type ITx interface {
+	Write(query string, args ...any) error
+	// CheckedWrite is a write which must affect rows, otherwise commit
+	// returns ErrNoRowsAffected and nothing is committed
+	CheckedWrite(query string, args ...any) error
+	Commit() error
+	Rollback() error
+}
+
 type IDataBaseDriver interface {
 	Open(dbUrl string) error
 	Close() error
 	Query(query string, args ...any) (IQueryResult, error)
 	Write(query string, args ...any) (IWriteResult, error)
+	Begin() (ITx, error)
+	// Backup dumps a consistent snapshot of database into local file
+	Backup(dest string) error
 }
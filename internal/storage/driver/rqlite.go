@@ -1,6 +1,10 @@
 package driver
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
 	"strings"
 	"sync"
 
@@ -9,7 +13,8 @@ import (
 )
 
 type RQLiteDB struct {
-	conn *rqlite.Connection
+	conn    *rqlite.Connection
+	connURL string
 	sync.Mutex
 }
 
@@ -21,10 +26,17 @@ type WriteResult struct {
 	result rqlite.WriteResult
 }
 
+type RQLiteTx struct {
+	db         *RQLiteDB
+	statements []rqlite.ParameterizedStatement
+	checked    map[int]bool // index of checked write in statements
+}
+
 var (
 	_ IDataBaseDriver = (*RQLiteDB)(nil)
 	_ IQueryResult    = (*QueryResult)(nil)
 	_ IWriteResult    = (*WriteResult)(nil)
+	_ ITx             = (*RQLiteTx)(nil)
 )
 
 func NewRQLiteDB() *RQLiteDB {
@@ -38,6 +50,7 @@ func (db *RQLiteDB) Open(url string) error {
 		return err
 	}
 	db.conn = conn
+	db.connURL = connURL
 	return nil
 }
 
@@ -86,3 +99,78 @@ func (db *RQLiteDB) Write(query string, args ...any) (IWriteResult, error) {
 	)
 	return &WriteResult{result: result}, err
 }
+
+// Begin starts a transaction: writes are buffered and executed
+// as a single atomic batch on commit
+func (db *RQLiteDB) Begin() (ITx, error) {
+	db.Lock() // unlocked by commit or rollback
+	return &RQLiteTx{db: db, checked: map[int]bool{}}, nil
+}
+
+func (tx *RQLiteTx) Write(query string, args ...any) error {
+	tx.statements = append(tx.statements, rqlite.ParameterizedStatement{
+		Query:     query,
+		Arguments: append([]interface{}{}, args...),
+	})
+	return nil
+}
+
+func (tx *RQLiteTx) CheckedWrite(query string, args ...any) error {
+	tx.checked[len(tx.statements)] = true
+	return tx.Write(query, args...)
+}
+
+func (tx *RQLiteTx) Commit() error {
+	defer tx.db.Unlock()
+	if len(tx.statements) == 0 {
+		return nil
+	}
+
+	// NOTE: all statements are executed as a single transaction by rqlite
+	results, err := tx.db.conn.WriteParameterized(tx.statements)
+	for _, result := range results {
+		if result.Err != nil { // more detail than the generic error
+			return result.Err
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// NOTE: the batch can't be aborted halfway, so statements after the checked
+	// write MUST carry the same condition, which makes them affect nothing too
+	for i, result := range results {
+		if tx.checked[i] && result.RowsAffected == 0 {
+			return ErrNoRowsAffected
+		}
+	}
+	return nil
+}
+
+func (tx *RQLiteTx) Rollback() error {
+	defer tx.db.Unlock()
+	tx.statements = nil
+	return nil
+}
+
+func (db *RQLiteDB) Backup(dest string) error {
+	db.Lock()
+	defer db.Unlock()
+
+	resp, err := http.Get(db.connURL + "/db/backup")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("backup rqlite failed: %s", resp.Status)
+	}
+
+	file, err := os.OpenFile(dest, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(file, resp.Body)
+	return err
+}
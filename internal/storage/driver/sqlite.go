@@ -46,10 +46,17 @@ type Result struct {
 	result sql.Result
 }
 
+type SQLiteTx struct {
+	db         *SQLiteDB
+	tx         *sql.Tx
+	noAffected bool // any checked write affected no rows
+}
+
 var (
 	_ IDataBaseDriver = (*SQLiteDB)(nil)
 	_ IQueryResult    = (*Rows)(nil)
 	_ IWriteResult    = (*Result)(nil)
+	_ ITx             = (*SQLiteTx)(nil)
 )
 
 func NewSQLiteDB() *SQLiteDB {
@@ -106,3 +113,54 @@ func (db *SQLiteDB) Write(query string, args ...any) (IWriteResult, error) {
 	result, err := stmt.Exec(args...)
 	return &Result{result: result}, err
 }
+
+func (db *SQLiteDB) Begin() (ITx, error) {
+	db.Lock() // unlocked by commit or rollback
+	tx, err := db.db.Begin()
+	if err != nil {
+		db.Unlock()
+		return nil, err
+	}
+	return &SQLiteTx{db: db, tx: tx}, nil
+}
+
+func (tx *SQLiteTx) Write(query string, args ...any) error {
+	_, err := tx.tx.Exec(query, args...)
+	return err
+}
+
+func (tx *SQLiteTx) CheckedWrite(query string, args ...any) error {
+	result, err := tx.tx.Exec(query, args...)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	} else if n == 0 {
+		tx.noAffected = true
+	}
+	return nil
+}
+
+func (tx *SQLiteTx) Commit() error {
+	defer tx.db.Unlock()
+	if tx.noAffected {
+		tx.tx.Rollback()
+		return ErrNoRowsAffected
+	}
+	return tx.tx.Commit()
+}
+
+func (tx *SQLiteTx) Rollback() error {
+	defer tx.db.Unlock()
+	return tx.tx.Rollback()
+}
+
+func (db *SQLiteDB) Backup(dest string) error {
+	db.Lock()
+	defer db.Unlock()
+
+	_, err := db.db.Exec("VACUUM INTO ?", dest)
+	return err
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dingodb/dingoadm/internal/storage/driver"
+)
+
+var (
+	ErrSchemaTooNew = fmt.Errorf("database schema is newer than dingoadm supported")
+)
+
+// Migration upgrades database schema from version-1 to version,
+// NEVER modify or remove a released migration, append a new one instead
+type Migration struct {
+	Version     int
+	Description string
+	Statements  []string
+}
+
+type SchemaMigration struct {
+	Version     int
+	Description string
+	AppliedTime time.Time
+}
+
+type SchemaStatus struct {
+	Current int
+	Latest  int
+	Applied []SchemaMigration
+	Pending []Migration
+}
+
+var (
+	MIGRATIONS = []Migration{
+		{
+			Version:     1,
+			Description: "initial schema",
+			Statements: []string{
+				CreateVersionTable,
+				CreateHostsTable,
+				CreateClustersTable,
+				CreateContainersTable,
+				CreateClientsTable,
+				CreatePlaygroundTable,
+				CreateAuditTable,
+				CreateMonitorTable,
+				CreateAnyTable,
+			},
+		},
+	}
+)
+
+func latestVersion(migrations []Migration) int {
+	latest := 0
+	for _, m := range migrations {
+		if m.Version > latest {
+			latest = m.Version
+		}
+	}
+	return latest
+}
+
+func (s *Storage) getSchemaMigrations() ([]SchemaMigration, error) {
+	result, err := s.db.Query(SelectSchemaMigrations)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	migrations := []SchemaMigration{}
+	var m SchemaMigration
+	for result.Next() {
+		err = result.Scan(&m.Version, &m.Description, &m.AppliedTime)
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, m)
+	}
+	return migrations, nil
+}
+
+func (s *Storage) schemaStatus(migrations []Migration) (SchemaStatus, error) {
+	status := SchemaStatus{Latest: latestVersion(migrations)}
+	applied, err := s.getSchemaMigrations()
+	if err != nil {
+		return status, err
+	}
+
+	done := map[int]bool{}
+	for _, m := range applied {
+		done[m.Version] = true
+		if m.Version > status.Current {
+			status.Current = m.Version
+		}
+	}
+	status.Applied = applied
+	for _, m := range migrations {
+		if !done[m.Version] {
+			status.Pending = append(status.Pending, m)
+		}
+	}
+	return status, nil
+}
+
+// migrate applies pending migrations in order, each migration runs in a transaction
+// together with its record, so a failed migration leaves nothing behind
+func (s *Storage) migrate(migrations []Migration) ([]Migration, error) {
+	_, err := s.db.Write(CreateSchemaMigrationsTable)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.schemaStatus(migrations)
+	if err != nil {
+		return nil, err
+	} else if status.Current > status.Latest {
+		return nil, fmt.Errorf("%w: current version %d, supported version %d",
+			ErrSchemaTooNew, status.Current, status.Latest)
+	}
+
+	applied := []Migration{}
+	for _, m := range status.Pending {
+		err = s.tx(func(tx driver.ITx) error {
+			for _, query := range m.Statements {
+				if err := tx.Write(query); err != nil {
+					return err
+				}
+			}
+			return tx.Write(InsertSchemaMigration, m.Version, m.Description)
+		})
+		if err != nil {
+			return applied, fmt.Errorf("apply migration %d (%s): %w", m.Version, m.Description, err)
+		}
+		applied = append(applied, m)
+	}
+	return applied, nil
+}
+
+// SchemaStatus returns the applied and pending migrations of database
+func (s *Storage) SchemaStatus() (SchemaStatus, error) {
+	return s.schemaStatus(MIGRATIONS)
+}
+
+// Migrate applies pending migrations, which also runs on storage created
+func (s *Storage) Migrate() ([]Migration, error) {
+	return s.migrate(MIGRATIONS)
+}
+
+// Migrated returns migrations which applied on storage created
+func (s *Storage) Migrated() []Migration {
+	return s.migrated
+}
+
+// Backup dumps database into local file
+func (s *Storage) Backup(dest string) error {
+	return s.db.Backup(dest)
+}
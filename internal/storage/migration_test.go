@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package storage
+
+import (
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStorage(t *testing.T) *Storage {
+	s, err := NewStorage("sqlite://" + path.Join(t.TempDir(), "dingoadm.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestMigrate(t *testing.T) {
+	assert := assert.New(t)
+
+	// initial schema applied on storage created
+	s := newTestStorage(t)
+	assert.Len(s.Migrated(), len(MIGRATIONS))
+	status, err := s.SchemaStatus()
+	assert.Nil(err)
+	assert.Equal(latestVersion(MIGRATIONS), status.Current)
+	assert.Len(status.Pending, 0)
+
+	// pending migration applied and recorded
+	next := latestVersion(MIGRATIONS) + 1
+	migrations := append(MIGRATIONS, Migration{
+		Version:     next,
+		Description: "add column",
+		Statements:  []string{`ALTER TABLE clients ADD COLUMN labels TEXT NOT NULL DEFAULT ''`},
+	})
+	applied, err := s.migrate(migrations)
+	assert.Nil(err)
+	assert.Len(applied, 1)
+	status, err = s.schemaStatus(migrations)
+	assert.Nil(err)
+	assert.Equal(next, status.Current)
+
+	// refuse to run against newer schema
+	_, err = s.migrate(MIGRATIONS)
+	assert.True(errors.Is(err, ErrSchemaTooNew))
+}
+
+func TestMigrateRollback(t *testing.T) {
+	assert := assert.New(t)
+
+	s := newTestStorage(t)
+	next := latestVersion(MIGRATIONS) + 1
+	migrations := append(MIGRATIONS, Migration{
+		Version:     next,
+		Description: "broken",
+		Statements: []string{
+			`CREATE TABLE broken (id INTEGER)`,
+			`INSERT INTO not_exist VALUES(1)`,
+		},
+	})
+	_, err := s.migrate(migrations)
+	assert.NotNil(err)
+
+	// nothing left behind by failed migration
+	status, err := s.schemaStatus(migrations)
+	assert.Nil(err)
+	assert.Equal(next-1, status.Current)
+	_, err = s.db.Query(`SELECT * FROM broken`)
+	assert.NotNil(err)
+}
+
+func TestBackup(t *testing.T) {
+	assert := assert.New(t)
+
+	s := newTestStorage(t)
+	assert.Nil(s.SetHosts("hosts"))
+	dest := path.Join(t.TempDir(), "backup.db")
+	assert.Nil(s.Backup(dest))
+
+	backup, err := NewStorage("sqlite://" + dest)
+	assert.Nil(err)
+	defer backup.Close()
+	hostses, err := backup.GetHostses()
+	assert.Nil(err)
+	assert.Len(hostses, 1)
+	assert.Equal("hosts", hostses[0].Data)
+}
@@ -43,6 +43,27 @@ package storage
 
 import "time"
 
+// schema migrations
+var (
+	// table: schema_migrations
+	CreateSchemaMigrationsTable = `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			description TEXT NOT NULL,
+			applied_time DATE NOT NULL
+		)
+	`
+
+	// insert schema migration
+	InsertSchemaMigration = `
+		INSERT INTO schema_migrations(version, description, applied_time)
+		VALUES(?, ?, datetime('now','localtime'))
+	`
+
+	// select schema migrations
+	SelectSchemaMigrations = `SELECT * FROM schema_migrations ORDER BY version`
+)
+
 // version
 type Version struct {
 	Id          int
@@ -60,11 +81,11 @@ var (
 		)
 	`
 
-	// insert version
-	InsertVersion = `INSERT INTO version(version, lastconfirm) VALUES(?, "")`
+	// insert version if absent
+	InsertVersion = `INSERT INTO version(version, lastconfirm) SELECT ?, "" WHERE NOT EXISTS (SELECT 1 FROM version)`
 
 	// set version
-	SetVersion = `UPDATE version SET version = ?, lastconfirm = ? WHERE id = ?`
+	SetVersion = `UPDATE version SET version = ?, lastconfirm = ? WHERE id = (SELECT MIN(id) FROM version)`
 
 	// select version
 	SelectVersion = `SELECT * FROM version`
@@ -87,11 +108,17 @@ var (
 		)
 	`
 
-	// insert hosts
-	InsertHosts = `INSERT INTO hosts(data, lastmodified_time) VALUES(?, datetime('now','localtime'))`
+	// insert hosts if absent
+	InsertHosts = `
+		INSERT INTO hosts(data, lastmodified_time)
+		SELECT ?, datetime('now','localtime') WHERE NOT EXISTS (SELECT 1 FROM hosts)
+	`
 
 	// set hosts
-	SetHosts = `UPDATE hosts SET data = ?, lastmodified_time = datetime('now','localtime') WHERE id = ?`
+	SetHosts = `
+		UPDATE hosts SET data = ?, lastmodified_time = datetime('now','localtime')
+		WHERE id = (SELECT MIN(id) FROM hosts)
+	`
 
 	// select hosts
 	SelectHosts = `SELECT * FROM hosts`
@@ -130,6 +157,13 @@ var (
 		VALUES(?, ?, ?, ?, "", datetime('now','localtime'))
 	`
 
+	// insert cluster if the name is absent
+	InsertClusterIfNameAbsent = `
+		INSERT INTO clusters(uuid, name, description, topology, pool, create_time)
+		SELECT ?, ?, ?, ?, "", datetime('now','localtime')
+		WHERE NOT EXISTS (SELECT 1 FROM clusters WHERE name = ?)
+	`
+
 	// delete cluster
 	DeleteCluster = `DELETE from clusters WHERE name = ?`
 
@@ -157,8 +191,11 @@ var (
 	// set cluster pool
 	SetClusterPool = `UPDATE clusters SET topology = ?, pool = ? WHERE id = ?`
 
-	// rename cluster name
-	RenameClusterName = `UPDATE clusters SET name = ? WHERE name = ?`
+	// rename cluster name if the new name is absent
+	RenameClusterName = `
+		UPDATE clusters SET name = ?
+		WHERE name = ? AND NOT EXISTS (SELECT 1 FROM clusters WHERE name = ?)
+	`
 )
 
 // service
@@ -182,6 +219,15 @@ var (
 	// insert service
 	InsertService = `INSERT INTO containers(id, cluster_id, container_id) VALUES(?, ?, ?)`
 
+	// insert service into cluster which specified by name and uuid
+	InsertServiceByCluster = `
+		INSERT INTO containers(id, cluster_id, container_id)
+		SELECT ?, id, ? FROM clusters WHERE name = ? AND uuid = ?
+	`
+
+	// delete services in cluster which specified by name
+	DeleteServicesByClusterName = `DELETE FROM containers WHERE cluster_id IN (SELECT id FROM clusters WHERE name = ?)`
+
 	// select service
 	SelectService = `SELECT * FROM containers WHERE id = ?`
 
@@ -328,9 +374,15 @@ var (
 	// set item
 	SetAnyItem = `UPDATE any SET data = ? WHERE id = ?`
 
+	// replace item
+	ReplaceAnyItem = `REPLACE INTO any(id, data) VALUES(?, ?)`
+
 	// select item by id
 	SelectAnyItem = `SELECT * FROM any WHERE id = ?`
 
+	// select items by id prefix
+	SelectAnyItemsByPrefix = `SELECT * FROM any WHERE id LIKE ?`
+
 	// delete item
 	DeleteAnyItem = `DELETE from any WHERE id = ?`
 )
@@ -374,5 +426,7 @@ var (
 
 	DeleteMonitor = `DELETE FROM monitors WHERE cluster_id = ?`
 
+	DeleteMonitorByClusterName = `DELETE FROM monitors WHERE cluster_id IN (SELECT id FROM clusters WHERE name = ?)`
+
 	ReplaceMonitor = `REPLACE INTO monitors (cluster_id, monitor) VALUES(?, ?)`
 )
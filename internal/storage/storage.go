@@ -51,7 +51,8 @@ type Monitor struct {
 }
 
 type Storage struct {
-	db driver.IDataBaseDriver
+	db       driver.IDataBaseDriver
+	migrated []Migration
 }
 
 func NewStorage(dbURL string) (*Storage, error) {
@@ -76,26 +77,24 @@ func NewStorage(dbURL string) (*Storage, error) {
 }
 
 func (s *Storage) init() error {
-	sqls := []string{
-		CreateVersionTable,
-		CreateHostsTable,
-		CreateClustersTable,
-		CreateContainersTable,
-		CreateClientsTable,
-		CreatePlaygroundTable,
-		CreateAuditTable,
-		CreateMonitorTable,
-		CreateAnyTable,
-	}
-
-	for _, sql := range sqls {
-		_, err := s.db.Write(sql)
-		if err != nil {
-			return err
-		}
+	migrated, err := s.Migrate()
+	s.migrated = migrated
+	return err
+}
+
+// tx runs fn in a transaction, which committed if fn succeed, otherwise rolled back
+func (s *Storage) tx(fn func(tx driver.ITx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
 	}
 
-	return nil
+	err = fn(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
 }
 
 func (s *Storage) write(query string, args ...any) error {
@@ -109,13 +108,14 @@ func (s *Storage) Close() error {
 
 // version
 func (s *Storage) SetVersion(version, lastConfirm string) error {
-	versions, err := s.GetVersions()
-	if err != nil {
-		return err
-	} else if len(versions) == 0 {
-		return s.write(InsertVersion, version)
-	}
-	return s.write(SetVersion, version, lastConfirm, versions[0].Id)
+	// NOTE: insert and update in one batch, so concurrent dingoadm
+	// processes can't insert the version twice
+	return s.tx(func(tx driver.ITx) error {
+		if err := tx.Write(InsertVersion, version); err != nil {
+			return err
+		}
+		return tx.CheckedWrite(SetVersion, version, lastConfirm)
+	})
 }
 
 func (s *Storage) GetVersions() ([]Version, error) {
@@ -137,13 +137,12 @@ func (s *Storage) GetVersions() ([]Version, error) {
 
 // hosts
 func (s *Storage) SetHosts(data string) error {
-	hostses, err := s.GetHostses()
-	if err != nil {
-		return err
-	} else if len(hostses) == 0 {
-		return s.write(InsertHosts, data)
-	}
-	return s.write(SetHosts, data, hostses[0].Id)
+	return s.tx(func(tx driver.ITx) error {
+		if err := tx.Write(InsertHosts, data); err != nil {
+			return err
+		}
+		return tx.CheckedWrite(SetHosts, data)
+	})
 }
 
 func (s *Storage) GetHostses() ([]Hosts, error) {
@@ -168,19 +167,55 @@ func (s *Storage) InsertCluster(name, uuid, description, topology string) error
 	return s.write(InsertCluster, uuid, name, description, topology)
 }
 
+// ImportCluster inserts cluster with its services, and return error if name exists
+func (s *Storage) ImportCluster(cluster Cluster, services []Service) error {
+	err := s.tx(func(tx driver.ITx) error {
+		err := tx.CheckedWrite(InsertClusterIfNameAbsent,
+			cluster.UUId, cluster.Name, cluster.Description, cluster.Topology, cluster.Name)
+		if err != nil {
+			return err
+		}
+		// NOTE: cluster id is unknown until committed for rqlite, so select it by
+		// name and uuid, which inserts nothing if the cluster above not inserted
+		for _, service := range services {
+			err = tx.Write(InsertServiceByCluster, service.Id, service.ContainerId, cluster.Name, cluster.UUId)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == driver.ErrNoRowsAffected {
+		return fmt.Errorf("cluster name already exists: %s", cluster.Name)
+	}
+	return err
+}
+
+// DeleteCluster deletes cluster with its services and monitor
 func (s *Storage) DeleteCluster(name string) error {
-	return s.write(DeleteCluster, name)
+	return s.tx(func(tx driver.ITx) error {
+		for _, query := range []string{
+			DeleteServicesByClusterName,
+			DeleteMonitorByClusterName,
+			DeleteCluster,
+		} {
+			if err := tx.Write(query, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // RenameClusterName update cluster name, and return error if new name exists
 func (s *Storage) RenameClusterName(oldName, newName string) error {
-	clusters, err := s.GetClusters(newName)
-	if err != nil {
-		return err
-	} else if len(clusters) > 0 {
-		return fmt.Errorf("cluster name already exists: %s", newName)
+	err := s.tx(func(tx driver.ITx) error {
+		return tx.CheckedWrite(RenameClusterName, newName, oldName, newName)
+	})
+	if err == driver.ErrNoRowsAffected {
+		return fmt.Errorf("cluster name already exists or cluster not found: %s -> %s", oldName, newName)
 	}
-	return s.write(RenameClusterName, newName, oldName)
+	return err
 }
 
 func (s *Storage) getClusters(query string, args ...interface{}) ([]Cluster, error) {
@@ -440,20 +475,17 @@ func (s *Storage) GetAuditLog(id int64) ([]AuditLog, error) {
 // any item prefix
 const (
 	PREFIX_CLIENT_CONFIG = 0x01
+	PREFIX_HOST_FACTS    = 0x02
+	PREFIX_GATEWAY       = 0x03
+	PREFIX_MAINTENANCE   = 0x04
 )
 
 func (s *Storage) realId(prefix int, id string) string {
 	return fmt.Sprintf("%d:%s", prefix, id)
 }
 
-func (s *Storage) InsertClientConfig(id, data string) error {
-	id = s.realId(PREFIX_CLIENT_CONFIG, id)
-	return s.write(InsertAnyItem, id, data)
-}
-
-func (s *Storage) GetClientConfig(id string) ([]Any, error) {
-	id = s.realId(PREFIX_CLIENT_CONFIG, id)
-	result, err := s.db.Query(SelectAnyItem, id)
+func (s *Storage) getAnyItems(query string, args ...interface{}) ([]Any, error) {
+	result, err := s.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -472,6 +504,21 @@ func (s *Storage) GetClientConfig(id string) ([]Any, error) {
 	return items, nil
 }
 
+func (s *Storage) InsertClientConfig(id, data string) error {
+	id = s.realId(PREFIX_CLIENT_CONFIG, id)
+	return s.write(InsertAnyItem, id, data)
+}
+
+func (s *Storage) GetClientConfig(id string) ([]Any, error) {
+	id = s.realId(PREFIX_CLIENT_CONFIG, id)
+	return s.getAnyItems(SelectAnyItem, id)
+}
+
+func (s *Storage) SetClientConfig(id, data string) error {
+	id = s.realId(PREFIX_CLIENT_CONFIG, id)
+	return s.write(SetAnyItem, data, id)
+}
+
 func (s *Storage) DeleteClientConfig(id string) error {
 	id = s.realId(PREFIX_CLIENT_CONFIG, id)
 	return s.write(DeleteAnyItem, id)
@@ -511,3 +558,50 @@ func (s *Storage) DeleteMonitor(clusterId int) error {
 func (s *Storage) ReplaceMonitor(m Monitor) error {
 	return s.write(ReplaceMonitor, m.ClusterId, m.Monitor)
 }
+
+// host facts
+func (s *Storage) ReplaceHostFacts(host, data string) error {
+	id := s.realId(PREFIX_HOST_FACTS, host)
+	return s.write(ReplaceAnyItem, id, data)
+}
+
+func (s *Storage) GetHostFacts(host string) ([]Any, error) {
+	id := s.realId(PREFIX_HOST_FACTS, host)
+	return s.getAnyItems(SelectAnyItem, id)
+}
+
+func (s *Storage) GetAllHostFacts() ([]Any, error) {
+	return s.getAnyItems(SelectAnyItemsByPrefix, s.realId(PREFIX_HOST_FACTS, "%"))
+}
+
+// gateway
+func (s *Storage) ReplaceGateway(id, data string) error {
+	id = s.realId(PREFIX_GATEWAY, id)
+	return s.write(ReplaceAnyItem, id, data)
+}
+
+func (s *Storage) GetGateways() ([]Any, error) {
+	return s.getAnyItems(SelectAnyItemsByPrefix, s.realId(PREFIX_GATEWAY, "%"))
+}
+
+func (s *Storage) DeleteGateway(id string) error {
+	id = s.realId(PREFIX_GATEWAY, id)
+	return s.write(DeleteAnyItem, id)
+}
+
+// maintenance
+func (s *Storage) maintenanceId(clusterId int, host string) string {
+	return s.realId(PREFIX_MAINTENANCE, fmt.Sprintf("%d:%s", clusterId, host))
+}
+
+func (s *Storage) ReplaceMaintenance(clusterId int, host, data string) error {
+	return s.write(ReplaceAnyItem, s.maintenanceId(clusterId, host), data)
+}
+
+func (s *Storage) GetMaintenances(clusterId int) ([]Any, error) {
+	return s.getAnyItems(SelectAnyItemsByPrefix, s.maintenanceId(clusterId, "%"))
+}
+
+func (s *Storage) DeleteMaintenance(clusterId int, host string) error {
+	return s.write(DeleteAnyItem, s.maintenanceId(clusterId, host))
+}
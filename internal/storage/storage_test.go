@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportAndDeleteCluster(t *testing.T) {
+	assert := assert.New(t)
+
+	s := newTestStorage(t)
+	cluster := Cluster{Name: "c1", UUId: "uuid", Topology: "topology"}
+	services := []Service{
+		{Id: "s1", ContainerId: "container1"},
+		{Id: "s2", ContainerId: "container2"},
+	}
+	assert.Nil(s.ImportCluster(cluster, services))
+	assert.NotNil(s.ImportCluster(cluster, services)) // name exists
+
+	c, err := s.GetClusterByName("c1")
+	assert.Nil(err)
+	got, err := s.GetServices(c.Id)
+	assert.Nil(err)
+	assert.Len(got, 2)
+
+	// duplicate service id rolls back the whole import
+	assert.NotNil(s.ImportCluster(Cluster{Name: "c2"}, services))
+	clusters, err := s.GetClusters("c2")
+	assert.Nil(err)
+	assert.Len(clusters, 0)
+
+	assert.Nil(s.ReplaceMonitor(Monitor{ClusterId: c.Id, Monitor: "monitor"}))
+	assert.Nil(s.DeleteCluster("c1"))
+	got, err = s.GetServices(c.Id)
+	assert.Nil(err)
+	assert.Len(got, 0)
+	m, err := s.GetMonitor(c.Id)
+	assert.Nil(err)
+	assert.Equal("", m.Monitor)
+}
+
+func TestRenameClusterName(t *testing.T) {
+	assert := assert.New(t)
+
+	s := newTestStorage(t)
+	assert.Nil(s.InsertCluster("c1", "uuid1", "", ""))
+	assert.Nil(s.InsertCluster("c2", "uuid2", "", ""))
+	assert.NotNil(s.RenameClusterName("c1", "c2"))
+	assert.NotNil(s.RenameClusterName("c4", "c5")) // cluster not found
+	assert.Nil(s.RenameClusterName("c1", "c3"))
+	clusters, err := s.GetClusters("c3")
+	assert.Nil(err)
+	assert.Len(clusters, 1)
+}
+
+func TestSetVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	s := newTestStorage(t)
+	assert.Nil(s.SetVersion("v1", ""))
+	assert.Nil(s.SetVersion("v2", "2026-10-19"))
+	versions, err := s.GetVersions()
+	assert.Nil(err)
+	assert.Len(versions, 1)
+	assert.Equal("v2", versions[0].Version)
+	assert.Equal("2026-10-19", versions[0].LastConfirm)
+}
+
+func TestSetHosts(t *testing.T) {
+	assert := assert.New(t)
+
+	s := newTestStorage(t)
+	assert.Nil(s.SetHosts("hosts1"))
+	assert.Nil(s.SetHosts("hosts2"))
+	hostses, err := s.GetHostses()
+	assert.Nil(err)
+	assert.Len(hostses, 1)
+	assert.Equal("hosts2", hostses[0].Data)
+}
+
+func TestMaintenance(t *testing.T) {
+	assert := assert.New(t)
+
+	s := newTestStorage(t)
+	assert.Nil(s.ReplaceMaintenance(1, "host1", "data1"))
+	assert.Nil(s.ReplaceMaintenance(1, "host1", "data2")) // enter again replaces the record
+	assert.Nil(s.ReplaceMaintenance(1, "host2", "data3"))
+	assert.Nil(s.ReplaceMaintenance(11, "host1", "data4"))
+
+	items, err := s.GetMaintenances(1)
+	assert.Nil(err)
+	datas := []string{}
+	for _, item := range items {
+		datas = append(datas, item.Data)
+	}
+	assert.ElementsMatch([]string{"data2", "data3"}, datas)
+
+	// exit removes the record of host in the cluster only
+	assert.Nil(s.DeleteMaintenance(1, "host1"))
+	items, err = s.GetMaintenances(1)
+	assert.Nil(err)
+	assert.Len(items, 1)
+	assert.Equal("data3", items[0].Data)
+	items, err = s.GetMaintenances(11)
+	assert.Nil(err)
+	assert.Len(items, 1)
+	assert.Equal("data4", items[0].Data)
+}
@@ -69,6 +69,12 @@ var (
 	//go:embed shell/start_gateway.sh
 	START_GATEWAY string
 
+	//go:embed shell/client_mount.sh
+	CLIENT_MOUNT string
+
+	//go:embed shell/client_cache.sh
+	CLIENT_CACHE string
+
 	// DingoFS MdsV2
 	//go:embed shell/create_mdsv2_tables.sh
 	CREATE_MDSV2_TABLES string
@@ -89,6 +95,16 @@ var (
 	//go:embed shell/sync_prometheus.sh
 	SYNC_PROMETHEUS string
 
+	// Prometheus clients and gateways target
+	//go:embed shell/sync_client_target.sh
+	SYNC_CLIENT_TARGET string
+
+	// Prometheus services target and alert rules
+	//go:embed shell/sync_server_target.sh
+	SYNC_SERVER_TARGET string
+	//go:embed shell/alert_rules.yml
+	ALERT_RULES string
+
 	// Grafana dashboard
 	//go:embed shell/server_metric_zh.json
 	GRAFANA_SERVER_METRIC string
@@ -96,4 +112,16 @@ var (
 	// Extract /etc/hosts mapping
 	//go:embed shell/extract_hosts.sh
 	EXTRACT_HOSTS string
+
+	// Gather host facts
+	//go:embed shell/host_facts.sh
+	HOST_FACTS string
+
+	// Prepare disk for service directories
+	//go:embed shell/disk.sh
+	PREPARE_DISK string
+
+	// Kubernetes config merge (init container)
+	//go:embed shell/merge_config.sh
+	MERGE_CONFIG string
 )
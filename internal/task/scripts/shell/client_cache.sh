@@ -0,0 +1,49 @@
+#!/usr/bin/env bash
+# usage: bash client_cache.sh status DIR...
+#        bash client_cache.sh clean DIR...
+# print usage of each cache directory line by line as 'DIR=SIZE,AVAIL,TOTAL' in bytes,
+# the size is -1 if directory not exist, the 'clean' mode removes all cached blocks
+# in directory before printing
+
+g_mode=$1
+shift
+
+usage() {
+    local dir=$1
+    if [ ! -d "${dir}" ]; then
+        echo "${dir}=-1,0,0"
+        return
+    fi
+    local size
+    local space
+    size=$(du -sb "${dir}" 2>/dev/null | awk '{ print $1 }')
+    space=$(df -B1 --output=avail,size "${dir}" 2>/dev/null | tail -n 1 | awk '{ print $1","$2 }')
+    echo "${dir}=${size:-0},${space:-0,0}"
+}
+
+clean() {
+    local dir=$1
+    if [ -z "${dir}" ] || [ "${dir}" == "/" ]; then
+        echo "error=refuse to clean directory '${dir}'"
+        return
+    fi
+    if [ -d "${dir}" ]; then
+        find "${dir}" -mindepth 1 -delete || echo "error=clean ${dir} failed"
+    fi
+}
+
+for dir in "$@"; do
+    case ${g_mode} in
+        status)
+            usage "${dir}"
+            ;;
+        clean)
+            clean "${dir}"
+            usage "${dir}"
+            ;;
+        *)
+            echo "error=unknown mode '${g_mode}'"
+            exit 0
+            ;;
+    esac
+done
@@ -0,0 +1,95 @@
+#!/usr/bin/env bash
+# usage: bash client_mount.sh check MOUNT_POINT
+#        bash client_mount.sh clean MOUNT_POINT
+#        bash client_mount.sh drain MOUNT_POINT
+# print state of client mount point line by line as 'key=value',
+# the 'clean' mode lazy umounts the broken mount point before client restart,
+# the 'drain' mode umounts the healthy mount point only if no process uses it
+
+g_mode=$1
+g_mount_point=$2
+g_timeout=10
+
+print() {
+    echo "$1=$2"
+}
+
+# state of mount point:
+#   mounted     : filesystem mounted and accessible
+#   broken      : filesystem mounted but fuse daemon is gone (Transport endpoint is not connected)
+#   hung        : filesystem mounted but not responding
+#   not-mounted : nothing mounted on mount point
+#   absent      : mount point not exist
+get_state() {
+    local out
+    out=$(timeout ${g_timeout} stat -c %i "${g_mount_point}" 2>&1)
+    local ret=$?
+    if [ ${ret} -eq 124 ]; then
+        echo "hung"
+    elif echo "${out}" | grep -q 'Transport endpoint is not connected'; then
+        echo "broken"
+    elif [ ${ret} -ne 0 ]; then
+        echo "absent"
+    elif mountpoint -q "${g_mount_point}"; then
+        echo "mounted"
+    else
+        echo "not-mounted"
+    fi
+}
+
+get_fs_type() {
+    awk -v mnt="${g_mount_point}" '$2 == mnt { fstype = $3 } END { print fstype }' /proc/mounts
+}
+
+check() {
+    print state "$(get_state)"
+    print fs_type "$(get_fs_type)"
+}
+
+clean() {
+    local state
+    state=$(get_state)
+    if [ "${state}" == "broken" ] || [ "${state}" == "hung" ]; then
+        fusermount -u -z "${g_mount_point}" 2>/dev/null || umount -l "${g_mount_point}"
+        if [ $? -ne 0 ]; then
+            print error "umount ${g_mount_point} failed"
+        fi
+    fi
+    check
+}
+
+# number of processes which open files (or cwd) under mount point
+get_users() {
+    fuser -m "${g_mount_point}" 2>/dev/null | wc -w
+}
+
+drain() {
+    local state
+    state=$(get_state)
+    if [ "${state}" == "mounted" ]; then
+        local users
+        users=$(get_users)
+        print users "${users}"
+        # non-lazy umount fails with 'target is busy' if any file opened,
+        # so in-flight I/O never be interrupted
+        if [ "${users}" -eq 0 ]; then
+            umount "${g_mount_point}" 2>/dev/null || fusermount -u "${g_mount_point}" 2>/dev/null
+        fi
+    fi
+    check
+}
+
+case ${g_mode} in
+    check)
+        check
+        ;;
+    clean)
+        clean
+        ;;
+    drain)
+        drain
+        ;;
+    *)
+        print error "unknown mode '${g_mode}'"
+        ;;
+esac
@@ -0,0 +1,137 @@
+#!/usr/bin/env bash
+# usage: bash disk.sh status DEVICE MOUNT_POINT
+#        bash disk.sh prepare DEVICE MOUNT_POINT FS_TYPE MOUNT_OPTIONS FORCE
+# print disk status line by line as 'key=value', the 'error' line tells why disk can't be prepared
+
+g_mode=$1
+g_device=$2
+g_mount_point=$3
+g_fs_type=${4:-ext4}
+g_mount_options=${5:-defaults,noatime}
+g_force=${6:-false}
+g_fstab=/etc/fstab
+g_fstab_lock=/var/lock/dingoadm-fstab.lock
+g_warning="GENERATED BY DINGOADM, DONT EDIT THIS"
+
+print() {
+    echo "$1=$2"
+}
+
+get_uuid() {
+    blkid -o value -s UUID "${g_device}" 2>/dev/null
+}
+
+# mount point of device itself, and the first mount point of its partitions
+get_mounted() {
+    lsblk -n -d -o MOUNTPOINT "${g_device}" 2>/dev/null | head -n 1
+}
+
+get_partition_mounted() {
+    lsblk -n -o MOUNTPOINT "${g_device}" 2>/dev/null | tail -n +2 | sed '/^$/d' | head -n 1
+}
+
+in_fstab() {
+    local uuid=$1
+    [ -n "${uuid}" ] && grep -q "^UUID=${uuid}[[:space:]]" ${g_fstab}
+}
+
+is_empty_dir() {
+    [ ! -d "$1" ] || [ -z "$(ls -A "$1" 2>/dev/null)" ]
+}
+
+status() {
+    print device "${g_device}"
+    print mount_point "${g_mount_point}"
+    if [ ! -b "${g_device}" ]; then
+        print state not-block-device
+        return
+    fi
+
+    local mounted=$(get_mounted)
+    local fstype=$(blkid -p -o value -s TYPE "${g_device}" 2>/dev/null)
+    local uuid=$(get_uuid)
+    local partitions=$(lsblk -n -o NAME "${g_device}" 2>/dev/null | tail -n +2 | wc -l)
+    print fs_type "${fstype}"
+    print uuid "${uuid}"
+    print size "$(lsblk -b -d -n -o SIZE "${g_device}" 2>/dev/null | tr -d ' ')"
+    print partitions "${partitions}"
+    print mounted_on "${mounted:-$(get_partition_mounted)}"
+    in_fstab "${uuid}" && print fstab yes || print fstab no
+    is_empty_dir "${g_mount_point}" && print mount_point_empty yes || print mount_point_empty no
+
+    if [ "${mounted}" == "${g_mount_point}" ]; then
+        print state mounted
+        df -P -B1 "${g_mount_point}" 2>/dev/null | tail -n 1 | awk '{print "avail="$4; print "used_percent="$5}'
+    elif [ -n "${mounted}" ] || [ -n "$(get_partition_mounted)" ]; then
+        print state mounted-elsewhere
+    elif [ -n "${fstype}" ] || [ "${partitions}" -gt 0 ]; then
+        print state not-empty
+    else
+        print state empty
+    fi
+}
+
+fail() {
+    print error "$1"
+    status
+    exit 0
+}
+
+edit_fstab() {
+    local uuid=$(get_uuid)
+    if [ -z "${uuid}" ]; then
+        return 1
+    fi
+
+    (
+        flock -x 200
+        cp -n ${g_fstab} "${g_fstab}-$(date +%F).backup"
+        sed -i -e "\#^UUID=${uuid}[[:space:]]#d" \
+            -e "\#[[:space:]]${g_mount_point}[[:space:]].*${g_warning}\$#d" ${g_fstab} &&
+        echo "UUID=${uuid}  ${g_mount_point}  ${g_fs_type}  ${g_mount_options}  0  0  # ${g_warning}" >> ${g_fstab}
+    ) 200>${g_fstab_lock}
+}
+
+prepare() {
+    if [ ! -b "${g_device}" ]; then
+        fail not-block-device
+    fi
+
+    # already prepared, only make sure the fstab record exist
+    local mounted=$(get_mounted)
+    if [ "${mounted}" == "${g_mount_point}" ]; then
+        if ! in_fstab "$(get_uuid)"; then
+            edit_fstab || fail edit-fstab-failed
+        fi
+        status
+        return
+    elif [ -n "${mounted}" ] || [ -n "$(get_partition_mounted)" ]; then
+        fail mounted-elsewhere
+    fi
+
+    local fstype=$(blkid -p -o value -s TYPE "${g_device}" 2>/dev/null)
+    local partitions=$(lsblk -n -o NAME "${g_device}" 2>/dev/null | tail -n +2 | wc -l)
+    if [ "${g_force}" != "true" ]; then
+        if [ -n "${fstype}" ] || [ "${partitions}" -gt 0 ]; then
+            fail not-empty
+        elif ! is_empty_dir "${g_mount_point}"; then
+            fail mount-point-not-empty
+        fi
+    fi
+
+    wipefs -a -q "${g_device}" >/dev/null 2>&1
+    case ${g_fs_type} in
+        xfs) mkfs.xfs -f -q "${g_device}" >/dev/null 2>&1 || fail mkfs-failed ;;
+        *) mkfs.ext4 -F -q "${g_device}" >/dev/null 2>&1 || fail mkfs-failed ;;
+    esac
+    mkdir -p "${g_mount_point}" || fail mkdir-failed
+    mount -t ${g_fs_type} -o ${g_mount_options} "${g_device}" "${g_mount_point}" || fail mount-failed
+    edit_fstab || fail edit-fstab-failed
+    status
+}
+
+case ${g_mode} in
+    status) status ;;
+    prepare) prepare ;;
+    *) fail unknown-mode ;;
+esac
@@ -0,0 +1,56 @@
+#!/usr/bin/env bash
+# usage: bash host_facts.sh [docker|podman]
+# print host facts section by section, each section starts with '@@@ <name>'
+
+g_engine=${1:-docker}
+
+section() {
+    echo "@@@ $1"
+}
+
+section hostname
+hostname 2>/dev/null
+
+section os
+cat /etc/os-release 2>/dev/null
+
+section kernel
+uname -r 2>/dev/null
+
+section arch
+uname -m 2>/dev/null
+
+section cpu
+nproc 2>/dev/null
+grep -m1 'model name' /proc/cpuinfo 2>/dev/null | cut -d: -f2-
+
+section memory
+grep MemTotal /proc/meminfo 2>/dev/null
+
+section numa
+ls -d /sys/devices/system/node/node[0-9]* 2>/dev/null | wc -l
+
+section lsblk
+lsblk -b -n -P -o NAME,TYPE,SIZE,ROTA,FSTYPE,MOUNTPOINT 2>/dev/null
+
+section filesystem
+df -P -T -B1 -x tmpfs -x devtmpfs -x overlay -x squashfs 2>/dev/null | tail -n +2
+
+section docker
+${g_engine} version --format '{{.Server.Version}}' 2>/dev/null
+
+section timesync
+for service in chronyd chrony ntpd ntp systemd-timesyncd; do
+    if systemctl is-active --quiet ${service} 2>/dev/null; then
+        echo "service=${service}"
+        break
+    fi
+done
+echo "synchronized=$(timedatectl show -p NTPSynchronized --value 2>/dev/null)"
+
+section ports
+if command -v ss >/dev/null 2>&1; then
+    ss -lnt 2>/dev/null | awk 'NR>1 {print $4}'
+else
+    netstat -lnt 2>/dev/null | awk 'NR>2 {print $4}'
+fi | awk -F: '{print $NF}' | sort -n -u
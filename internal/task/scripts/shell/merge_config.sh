@@ -0,0 +1,58 @@
+#!/usr/bin/env bash
+# usage: bash merge_config.sh DELIMITER OVERRIDES_FILE TEMPLATE_FILE OUTPUT_FILE
+#
+# Replace every "key<DELIMITER>value" line in TEMPLATE_FILE whose key appears
+# in OVERRIDES_FILE, keeping the original indentation. Overrides prefixed with
+# "--" (mds v2 dynamic config) which not exist in template are appended.
+
+if [ $# -ne 4 ]; then
+  echo "Usage: $0 DELIMITER OVERRIDES_FILE TEMPLATE_FILE OUTPUT_FILE"
+  exit 1
+fi
+
+delimiter="$1"
+overrides="$2"
+template="$3"
+output="$4"
+
+if [ ! -f "$template" ]; then
+  echo "Error: template '$template' not found."
+  exit 2
+fi
+
+mkdir -p "$(dirname "$output")"
+if [ ! -s "$overrides" ]; then
+  cp "$template" "$output"
+  exit 0
+fi
+
+awk -v d="$delimiter" '
+  NR == FNR {
+    i = index($0, d)
+    if (i > 0) {
+      overrides[substr($0, 1, i - 1)] = substr($0, i + length(d))
+    }
+    next
+  }
+  {
+    i = index($0, d)
+    if (i > 0) {
+      key = substr($0, 1, i - 1)
+      trimmed = key
+      sub(/^[ \t]+/, "", trimmed)
+      if (trimmed in overrides) {
+        print key d overrides[trimmed]
+        seen[trimmed] = 1
+        next
+      }
+    }
+    print
+  }
+  END {
+    for (key in overrides) {
+      if (!(key in seen) && substr(key, 1, 2) == "--") {
+        print key d overrides[key]
+      }
+    }
+  }
+' "$overrides" "$template" > "$output"
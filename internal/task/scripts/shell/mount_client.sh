@@ -11,6 +11,10 @@ g_mnt=""
 g_client_binary="/dingofs/client/sbin/dingo-client"
 g_client_config="/dingofs/client/conf/client.conf"
 g_tool_config="/etc/dingo/dingo.yaml"
+g_client_mount_script="/client_mount.sh"
+# quota is configured on first start only, the container restarted by reload
+# or restart policy must not revert the quota which updated afterwards
+g_quota_configured="/.quota_configured"
 g_fuse_args=""
 
 # quota
@@ -50,6 +54,12 @@ fi
 
 function cleanMountpoint(){
 
+    # lazy umount the broken or hung mount point left by the exited client,
+    # which happens when container restarted by docker restart policy
+    if [ -f "${g_client_mount_script}" ]; then
+        bash "${g_client_mount_script}" clean "${g_mnt}"
+    fi
+
     # Check if mountpoint path is broken (Transport endpoint is not connected)
     mountpoint -q "${g_mnt}"
     # check if mountpoint is mount point which code is 0
@@ -153,21 +163,22 @@ checkfs "${args[@]}"
 ret=$?
 
 if [ $ret -eq 0 ]; then
-    if [[ -n "$capacity" && "$capacity" -ne 0 ]]; then
-        echo -e "\nConfig fs quota: capacity=$capacity"
-        $g_dingofs_tool config fs --fsname $g_fsname --capacity $capacity
-    fi
-    if [ $? -ne 0 ]; then
-        echo "Config fs quota failed, exiting..."
-        exit 1
-    fi
-    if [[ -n "$inodes" && "$inodes" -ne 0 ]]; then
-        echo "Config fs quota: inode=$inodes"
-        $g_dingofs_tool config fs --fsname $g_fsname --capacity $inodes
-    fi
-    if [ $? -ne 0 ]; then
-        echo "Config fs quota failed, exiting..."
-        exit 1
+    if [ ! -f "${g_quota_configured}" ]; then
+        if [[ -n "$capacity" && "$capacity" -ne 0 ]]; then
+            echo -e "\nConfig fs quota: capacity=$capacity"
+            if ! $g_dingofs_tool config fs --fsname "$g_fsname" --capacity "$capacity"; then
+                echo "Config fs quota failed, exiting..."
+                exit 1
+            fi
+        fi
+        if [[ -n "$inodes" && "$inodes" -ne 0 ]]; then
+            echo "Config fs quota: inodes=$inodes"
+            if ! $g_dingofs_tool config fs --fsname "$g_fsname" --inodes "$inodes"; then
+                echo "Config fs quota failed, exiting..."
+                exit 1
+            fi
+        fi
+        touch "${g_quota_configured}"
     fi
 
     echo -e "\nBootstrap dingo-fuse service, command: $g_client_binary --flagfile ${g_client_config} mds://${g_mdsaddr}/${g_fsname} ${g_mnt}"
@@ -0,0 +1,16 @@
+#!/usr/bin/env bash
+# usage: sync_client_target.sh <prometheus_config_path> <target_file>
+# append file_sd job for clients and gateways once, the target file reloaded by prometheus itself
+PROMETHEUS_CONFIG_PATH=$1
+TARGET_FILE=$2
+
+if grep -q "job_name: 'dingofs_client'" ${PROMETHEUS_CONFIG_PATH}; then
+    exit 0
+fi
+
+cat <<EOF2 >> ${PROMETHEUS_CONFIG_PATH}
+
+  - job_name: 'dingofs_client'
+    file_sd_configs:
+      - files: ['${TARGET_FILE}']
+EOF2
@@ -0,0 +1,26 @@
+#!/usr/bin/env bash
+# usage: sync_server_target.sh <prometheus_config_path> <target_file> <rules_file>
+# scrape services by the target file which labels services on hosts in maintenance,
+# instead of the one generated by monitor sync, and load alert rules once
+PROMETHEUS_CONFIG_PATH=$1
+TARGET_FILE=$2
+RULES_FILE=$3
+
+if grep -q "files: \['target.json'\]" ${PROMETHEUS_CONFIG_PATH}; then
+    sed -i "s/files: \['target.json'\]/files: ['${TARGET_FILE}']/" ${PROMETHEUS_CONFIG_PATH}
+elif ! grep -q "'${TARGET_FILE}'" ${PROMETHEUS_CONFIG_PATH}; then
+    cat <<EOF2 >> ${PROMETHEUS_CONFIG_PATH}
+
+  - job_name: 'dingo_server'
+    file_sd_configs:
+      - files: ['${TARGET_FILE}']
+EOF2
+fi
+
+if grep -q "'${RULES_FILE}'" ${PROMETHEUS_CONFIG_PATH}; then
+    exit 0
+elif grep -q "^rule_files:" ${PROMETHEUS_CONFIG_PATH}; then
+    sed -i "/^rule_files:/a\  - '${RULES_FILE}'" ${PROMETHEUS_CONFIG_PATH}
+else
+    sed -i "/^scrape_configs:/i rule_files:\n  - '${RULES_FILE}'\n" ${PROMETHEUS_CONFIG_PATH}
+fi
@@ -29,6 +29,10 @@
 package step
 
 import (
+	"reflect"
+	"runtime"
+	"strings"
+
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/task/context"
 	"github.com/dingodb/dingoadm/internal/utils"
@@ -47,6 +51,26 @@ func (s *Lambda) Execute(ctx *context.Context) error {
 	return s.Lambda(ctx)
 }
 
+// Name returns name of the function which made the lambda,
+// e.g. github.com/x/checker.CheckPortInUse.func1 -> checker.CheckPortInUse
+func (s *Lambda) Name() string {
+	fn := runtime.FuncForPC(reflect.ValueOf(s.Lambda).Pointer())
+	if fn == nil {
+		return "Lambda"
+	}
+	name := fn.Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = strings.TrimSuffix(name, "-fm")
+	for {
+		i := strings.LastIndex(name, ".func")
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+	return name
+}
+
 func PostHandle(Success *bool, Out *string, out string, err error, ec *errno.ErrorCode) error {
 	if Out != nil {
 		*Out = utils.TrimSuffixRepeat(out, "\n")
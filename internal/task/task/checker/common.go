@@ -215,6 +215,11 @@ func getServiceListenAddresses(dc *topology.DeployConfig) []Address {
 			IP:   dc.GetListenIp(),
 			Port: dc.GetDingoDBMySQLPort(),
 		})
+		address = append(address, Address{
+			Role: ROLE_DINGODB_EXECUTOR,
+			IP:   dc.GetListenIp(),
+			Port: dc.GetDingoDBMetricsPort(),
+		})
 	case ROLE_DINGODB_WEB:
 		address = append(address, Address{
 			Role: ROLE_DINGODB_WEB,
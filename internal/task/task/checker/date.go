@@ -49,6 +49,8 @@ type Time struct {
 	time int64
 }
 
+func (t Time) Host() string { return t.host }
+
 func step2Pre(start *int64) step.LambdaType {
 	return func(ctx *context.Context) error {
 		*start = time.Now().Unix()
@@ -110,26 +112,32 @@ func NewGetHostDate(curveadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Ta
 	return t, nil
 }
 
-func checkDate(dingoadm *cli.DingoAdm) step.LambdaType {
-	return func(ctx *context.Context) error {
-		var minT, maxT Time
-		min, max := int64(0), int64(0)
-		m := newIfNil(dingoadm)
-		for _, t := range m {
-			if min == 0 || t.time < min {
-				min = t.time
-				minT = t
-			}
-			if max == 0 || t.time > max {
-				max = t.time
-				maxT = t
-			}
+// GetHostTimeDifference returns the max time difference between hosts which gathered by GetHostDate task,
+// and the hosts which have the max and min time
+func GetHostTimeDifference(dingoadm *cli.DingoAdm) (int64, Time, Time) {
+	var minT, maxT Time
+	min, max := int64(0), int64(0)
+	m := newIfNil(dingoadm)
+	for _, t := range m {
+		if min == 0 || t.time < min {
+			min = t.time
+			minT = t
+		}
+		if max == 0 || t.time > max {
+			max = t.time
+			maxT = t
 		}
+	}
+	return max - min, maxT, minT
+}
 
-		if max-min > MAX_TIME_DIFFERENCE {
+func checkDate(dingoadm *cli.DingoAdm) step.LambdaType {
+	return func(ctx *context.Context) error {
+		diff, maxT, minT := GetHostTimeDifference(dingoadm)
+		if diff > MAX_TIME_DIFFERENCE {
 			return errno.ERR_HOST_TIME_DIFFERENCE_OVER_30_SECONDS.
 				F("difference=%d %s(%d) %s(%d)",
-					max-min, maxT.host, maxT.time, minT.host, minT.time)
+					diff, maxT.host, maxT.time, minT.host, minT.time)
 		}
 		return nil
 	}
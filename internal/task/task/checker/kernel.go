@@ -114,11 +114,15 @@ func NewCheckKernelVersionTask(curveadm *cli.DingoAdm, dc *topology.DeployConfig
 
 	// add step to task
 	var out string
-	t.AddStep(&step.UnixName{
-		KernelRelease: true,
-		Out:           &out,
-		ExecOptions:   curveadm.ExecOptions(),
-	})
+	if facts := configure.GetFreshHostFacts(curveadm, dc.GetHost()); facts != nil && len(facts.Kernel) > 0 {
+		out = facts.Kernel // reuse the cached facts instead of probing host
+	} else {
+		t.AddStep(&step.UnixName{
+			KernelRelease: true,
+			Out:           &out,
+			ExecOptions:   curveadm.ExecOptions(),
+		})
+	}
 	t.AddStep(&step.Lambda{
 		Lambda: checkKernelVersion(&out, dc),
 	})
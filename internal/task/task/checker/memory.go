@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package checker
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	// keys of executor java_opts, which 'java.' prefix trimmed and lowercased
+	JAVA_OPT_XMS                    = "xms"
+	JAVA_OPT_XMX                    = "xmx"
+	JAVA_OPT_SOFT_MAX_HEAP_SIZE     = "softmaxheapsize"
+	JAVA_OPT_MAX_DIRECT_MEMORY_SIZE = "maxdirectmemorysize"
+
+	REGEX_JAVA_SIZE     = "^(\\d+)([kKmMgGtT]?)$"
+	REGEX_MEMINFO_TOTAL = "^MemTotal:\\s+(\\d+)\\s+kB$"
+)
+
+var (
+	JAVA_SIZE_OPTS = []string{
+		JAVA_OPT_XMS,
+		JAVA_OPT_XMX,
+		JAVA_OPT_SOFT_MAX_HEAP_SIZE,
+		JAVA_OPT_MAX_DIRECT_MEMORY_SIZE,
+	}
+)
+
+// parseJavaSize parses size like java -Xmx argument, e.g. 512m, 2g, 1048576
+func parseJavaSize(size string) (uint64, bool) {
+	mu := regexp.MustCompile(REGEX_JAVA_SIZE).FindStringSubmatch(strings.TrimSpace(size))
+	if len(mu) == 0 {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(mu[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	switch strings.ToLower(mu[2]) {
+	case "k":
+		n *= humanize.KiByte
+	case "m":
+		n *= humanize.MiByte
+	case "g":
+		n *= humanize.GiByte
+	case "t":
+		n *= humanize.TiByte
+	}
+	return n, true
+}
+
+// parseJavaOpts returns the size options of executor in bytes
+func parseJavaOpts(dc *topology.DeployConfig) (map[string]uint64, error) {
+	opts := map[string]uint64{}
+	javaOpts := dc.GetDingoExecutorJavaOpts()
+	for _, key := range JAVA_SIZE_OPTS {
+		value, ok := javaOpts[key]
+		if !ok {
+			continue
+		}
+		size, ok := parseJavaSize(fmt.Sprintf("%v", value))
+		if !ok || size == 0 {
+			return nil, errno.ERR_INVALID_EXECUTOR_JAVA_OPTS.
+				F("%s.host[%s].java.%s: %v", dc.GetRole(), dc.GetHost(), key, value)
+		}
+		opts[key] = size
+	}
+	return opts, nil
+}
+
+func checkHostMemory(dingoadm *cli.DingoAdm, host string, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		mu := regexp.MustCompile(REGEX_MEMINFO_TOTAL).FindStringSubmatch(strings.TrimSpace(*out))
+		if len(mu) == 0 {
+			return errno.ERR_UNRECOGNIZED_HOST_MEMORY.F("meminfo: %s", *out)
+		}
+		total, err := strconv.ParseUint(mu[1], 10, 64)
+		if err != nil {
+			return errno.ERR_UNRECOGNIZED_HOST_MEMORY.F("meminfo: %s", *out)
+		}
+		total *= humanize.KiByte
+
+		// all executors on the same host share the memory
+		var require uint64
+		dcs := dingoadm.MemStorage().Get(comm.KEY_ALL_DEPLOY_CONFIGS).([]*topology.DeployConfig)
+		for _, dc := range dcs {
+			if dc.GetRole() != ROLE_DINGODB_EXECUTOR || dc.GetHost() != host {
+				continue
+			}
+			opts, err := parseJavaOpts(dc)
+			if err != nil {
+				return err
+			}
+			require += opts[JAVA_OPT_XMX] + opts[JAVA_OPT_MAX_DIRECT_MEMORY_SIZE]
+		}
+
+		if require > total {
+			return errno.ERR_EXECUTOR_MEMORY_EXCEED_HOST_MEMORY.
+				F("host[%s] require %s, total %s", host, humanize.IBytes(require), humanize.IBytes(total))
+		}
+		return nil
+	}
+}
+
+func NewCheckHostMemoryTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s", dc.GetHost(), dc.GetRole())
+	t := task.NewTask("Check Host Memory <service>", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var out string
+	if facts := configure.GetFreshHostFacts(dingoadm, dc.GetHost()); facts != nil && facts.MemTotal > 0 {
+		// reuse the cached facts instead of probing host
+		out = fmt.Sprintf("MemTotal: %d kB", facts.MemTotal/humanize.KiByte)
+	} else {
+		t.AddStep(&step.Command{
+			Command:     "grep MemTotal /proc/meminfo",
+			Out:         &out,
+			ExecOptions: dingoadm.ExecOptions(),
+		})
+	}
+	t.AddStep(&step.Lambda{
+		Lambda: checkHostMemory(dingoadm, dc.GetHost(), &out),
+	})
+
+	return t, nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJavaSize(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		size   string
+		expect uint64
+		ok     bool
+	}{
+		{"1048576", 1048576, true},
+		{"512k", 512 * 1024, true},
+		{"512m", 512 * 1024 * 1024, true},
+		{"2G", 2 * 1024 * 1024 * 1024, true},
+		{"1t", 1024 * 1024 * 1024 * 1024, true},
+		{"2gb", 0, false},
+		{"-1g", 0, false},
+		{"", 0, false},
+	}
+
+	for _, t := range tests {
+		size, ok := parseJavaSize(t.size)
+		assert.Equal(t.ok, ok)
+		assert.Equal(t.expect, size)
+	}
+}
@@ -51,7 +51,7 @@ const (
 )
 
 // TASK: check port in use
-func checkPortInUse(success *bool, out *string, host string, port int) step.LambdaType {
+func CheckPortInUse(success *bool, out *string, host string, port int) step.LambdaType {
 	return func(ctx *context.Context) error {
 		if !*success {
 			return errno.ERR_GET_CONNECTION_INFORMATION_FAILED.S(*out)
@@ -117,7 +117,7 @@ func (s *step2CheckPortStatus) Execute(ctx *context.Context) error {
 		ExecOptions: s.dingoadm.ExecOptions(),
 	})
 	steps = append(steps, &step.Lambda{
-		Lambda: checkPortInUse(s.success, &out, s.dc.GetHost(), s.port),
+		Lambda: CheckPortInUse(s.success, &out, s.dc.GetHost(), s.port),
 	})
 
 	for _, step := range steps {
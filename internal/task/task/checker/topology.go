@@ -29,11 +29,13 @@
 package checker
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/dingodb/dingoadm/cli/cli"
 	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/task/context"
@@ -82,6 +84,56 @@ type (
 		dcs       []*topology.DeployConfig
 		skipRoles []string
 	}
+
+	// check whether the listen port is duplicate on the same host,
+	// the service listen on 0.0.0.0 in most cases
+	step2CheckPortDuplicate struct {
+		dcs []*topology.DeployConfig
+	}
+
+	// check list for dingo-store/dingodb/dingofs(mds v2):
+	//   (1) coordinator requires an odd number of services
+	//   (2) default_replica_num <= number of hosts for store/document/index
+	//   (3) instance_start_id is unique for each role
+	step2CheckDingoServices struct {
+		dingoadm *cli.DingoAdm
+		dcs      []*topology.DeployConfig
+	}
+
+	// check whether the executor java_opts is valid
+	step2CheckJavaOpts struct {
+		dc *topology.DeployConfig
+	}
+
+	// check whether the disk section is valid and each device is used once on the same host
+	step2CheckDiskConfigure struct {
+		dcs []*topology.DeployConfig
+	}
+
+	// check whether the pool has enough failure domains which derived from host labels
+	step2CheckFailureDomains struct {
+		dingoadm *cli.DingoAdm
+		dcs      []*topology.DeployConfig
+	}
+)
+
+var (
+	// roles which the instance_start_id is used by
+	INSTANCE_ID_ROLES = []string{
+		ROLE_COORDINATOR,
+		ROLE_STORE,
+		ROLE_DINGODB_DOCUMENT,
+		ROLE_DINGODB_INDEX,
+		ROLE_DINGODB_DISKANN,
+		ROLE_FS_MDS,
+	}
+
+	// roles which the data is replicated by default_replica_num
+	REPLICA_ROLES = []string{
+		ROLE_STORE,
+		ROLE_DINGODB_DOCUMENT,
+		ROLE_DINGODB_INDEX,
+	}
 )
 
 func (s *step2CheckSSHConfigure) Execute(ctx *context.Context) error {
@@ -174,6 +226,127 @@ func (s *step2CheckAddressDuplicate) Execute(ctx *context.Context) error {
 	return nil
 }
 
+func (s *step2CheckPortDuplicate) Execute(ctx *context.Context) error {
+	// curvebs/curvefs services on the same host share the layout legitimately
+	if len(s.dcs) == 0 || !isDingoKind(s.dcs[0]) {
+		return nil
+	}
+
+	used := map[string]string{}
+	for _, dc := range s.dcs {
+		for _, address := range getServiceListenAddresses(dc) {
+			key := fmt.Sprintf("%s:%d", dc.GetHost(), address.Port)
+			if role, ok := used[key]; ok {
+				return errno.ERR_DUPLICATE_LISTEN_PORT.
+					F("port %d used by %s and %s (host[%s])", address.Port, role, dc.GetRole(), dc.GetHost())
+			}
+			used[key] = dc.GetRole()
+		}
+	}
+	return nil
+}
+
+func isDingoKind(dc *topology.DeployConfig) bool {
+	kind := dc.GetKind()
+	return kind == topology.KIND_DINGOSTORE || kind == topology.KIND_DINGODB ||
+		(kind == topology.KIND_DINGOFS &&
+			dc.GetCtx().Lookup(topology.CTX_KEY_MDS_VERSION) == topology.CTX_VAL_MDS_V2)
+}
+
+func getDistinctHostNum(dcs []*topology.DeployConfig) int {
+	hosts := map[string]bool{}
+	for _, dc := range dcs {
+		hosts[dc.GetHost()] = true
+	}
+	return len(hosts)
+}
+
+func (s *step2CheckDingoServices) Execute(ctx *context.Context) error {
+	if len(s.dcs) == 0 || !isDingoKind(s.dcs[0]) {
+		return nil
+	}
+
+	// (1) coordinator requires an odd number of services
+	coordinators := s.dingoadm.FilterDeployConfigByRole(s.dcs, ROLE_COORDINATOR)
+	if len(coordinators)%2 == 0 && len(coordinators) > 0 {
+		return errno.ERR_COORDINATOR_REQUIRES_ODD_SERVICES.
+			F("coordinator services: %d", len(coordinators))
+	}
+
+	// (2) default_replica_num <= number of hosts
+	for _, role := range REPLICA_ROLES {
+		dcs := s.dingoadm.FilterDeployConfigByRole(s.dcs, role)
+		if len(dcs) == 0 {
+			continue
+		}
+		num := getDistinctHostNum(dcs)
+		for _, dc := range dcs {
+			if dc.GetDingoStoreReplicaNum() > num {
+				return errno.ERR_REPLICA_NUM_EXCEED_HOSTS.
+					F("%s.host[%s].default_replica_num: %d, %s hosts: %d",
+						role, dc.GetHost(), dc.GetDingoStoreReplicaNum(), role, num)
+			}
+		}
+	}
+
+	// (3) instance_start_id is unique for each role
+	for _, role := range INSTANCE_ID_ROLES {
+		used := map[int]string{}
+		for _, dc := range s.dingoadm.FilterDeployConfigByRole(s.dcs, role) {
+			id := dc.GetDingoInstanceId()
+			if host, ok := used[id]; ok {
+				return errno.ERR_DUPLICATE_INSTANCE_START_ID.
+					F("%s.host[%s] and %s.host[%s].instance_start_id: %d", role, host, role, dc.GetHost(), id)
+			}
+			used[id] = dc.GetHost()
+		}
+	}
+
+	return nil
+}
+
+func (s *step2CheckJavaOpts) Execute(ctx *context.Context) error {
+	dc := s.dc
+	if dc.GetRole() != ROLE_DINGODB_EXECUTOR {
+		return nil
+	}
+
+	opts, err := parseJavaOpts(dc)
+	if err != nil {
+		return err
+	}
+	xmx, hasXmx := opts[JAVA_OPT_XMX]
+	if !hasXmx {
+		return nil
+	}
+	for _, key := range []string{JAVA_OPT_XMS, JAVA_OPT_SOFT_MAX_HEAP_SIZE} {
+		if size, ok := opts[key]; ok && size > xmx {
+			return errno.ERR_INVALID_EXECUTOR_JAVA_OPTS.
+				F("%s.host[%s].java.%s is larger than java.%s", dc.GetRole(), dc.GetHost(), key, JAVA_OPT_XMX)
+		}
+	}
+	return nil
+}
+
+func (s *step2CheckDiskConfigure) Execute(ctx *context.Context) error {
+	used := map[string]string{}
+	for _, dc := range s.dcs {
+		disks, err := dc.GetDisks()
+		if err != nil {
+			return err
+		}
+		for _, disk := range disks {
+			key := fmt.Sprintf("%s:%s", dc.GetHost(), disk.Device)
+			if dir, ok := used[key]; ok {
+				return errno.ERR_DUPLICATE_DISK_DEVICE.
+					F("%s used by %s and %s.disk.%s (host[%s])", disk.Device, dir, dc.GetRole(), disk.Dir, dc.GetHost())
+			}
+			used[key] = fmt.Sprintf("%s.disk.%s", dc.GetRole(), disk.Dir)
+		}
+	}
+	return nil
+}
+
 func (s *step2CheckServices) getHostNum(dcs []*topology.DeployConfig) int {
 	num := 0
 	exist := map[string]bool{}
@@ -275,6 +448,32 @@ func (s *step2CheckServices) Execute(ctx *context.Context) error {
 	return nil
 }
 
+func (s *step2CheckFailureDomains) Execute(ctx *context.Context) error {
+	if len(s.dcs) == 0 || s.dcs[0].GetKind() != topology.KIND_DINGOFS {
+		return nil
+	}
+
+	hostLabels, err := s.dingoadm.GetHostLabels()
+	if err != nil {
+		return err
+	}
+
+	// validate against replicas of the existing pool if the pool created
+	replicas := configure.DEFAULT_REPLICAS_PER_COPYSET
+	if data := s.dingoadm.ClusterPoolData(); len(data) > 0 {
+		pool := configure.DingoFsClusterTopo{}
+		if err := json.Unmarshal([]byte(data), &pool); err != nil {
+			return errno.ERR_DECODE_CLUSTER_POOL_JSON_FAILED.E(err)
+		}
+		replicas = pool.GetReplicas()
+	}
+
+	// NOTE: generate pool will sort the deploy configs, so we pass a copy of it
+	dcs := append([]*topology.DeployConfig{}, s.dcs...)
+	_, err = configure.GenerateClusterPool(dcs, configure.Poolset{}, replicas, hostLabels)
+	return err
+}
+
 func NewCheckTopologyTask(dingoadm *cli.DingoAdm, null interface{}) (*task.Task, error) {
 	// new task
 	dcs := dingoadm.MemStorage().Get(comm.KEY_ALL_DEPLOY_CONFIGS).([]*topology.DeployConfig)
@@ -292,12 +491,25 @@ func NewCheckTopologyTask(dingoadm *cli.DingoAdm, null interface{}) (*task.Task,
 		t.AddStep(&step2CheckDirectoryPath{dc: dc})
 	}
 	t.AddStep(&step2CheckDataDirectoryDuplicate{dcs: dcs})
+	t.AddStep(&step2CheckDiskConfigure{dcs: dcs})
 	t.AddStep(&step2CheckAddressDuplicate{dcs: dcs})
 	t.AddStep(&step2CheckServices{
 		dcs:       dcs,
 		dingoadm:  dingoadm,
 		skipRoles: dingoadm.MemStorage().Get(comm.KEY_SKIP_CHECKS_ROLES).([]string),
 	})
+	t.AddStep(&step2CheckPortDuplicate{dcs: dcs})
+	t.AddStep(&step2CheckDingoServices{
+		dcs:      dcs,
+		dingoadm: dingoadm,
+	})
+	for _, dc := range dcs {
+		t.AddStep(&step2CheckJavaOpts{dc: dc})
+	}
+	t.AddStep(&step2CheckFailureDomains{
+		dcs:      dcs,
+		dingoadm: dingoadm,
+	})
 	for _, dc := range dcs {
 		t.AddStep(&step2CheckS3Configure{
 			dc:       dc,
@@ -0,0 +1,415 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/storage"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/scripts"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	"github.com/dingodb/dingoadm/internal/task/task/fs"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dingodb/dingoadm/pkg/module"
+)
+
+const (
+	CLIENT_CACHE_OP_STATUS = "status"
+	CLIENT_CACHE_OP_CLEAN  = "clean"
+	CLIENT_CACHE_OP_WARMUP = "warmup"
+
+	// hit ratio is unknown if metrics of client not available
+	CACHE_HIT_RATIO_UNKNOWN = -1
+
+	FORMAT_CLIENT_METRIC_COMMAND = "curl -s http://127.0.0.1:%d/vars || wget -qO- http://127.0.0.1:%d/vars"
+)
+
+type (
+	ClientCacheOptions struct {
+		Op    string
+		Paths []string // paths to warmup, relative to mount point or absolute path under it
+	}
+
+	// ClientCache is the mounted dingofs client with its config
+	ClientCache struct {
+		Client storage.Client
+		Config *configure.ClientConfig
+		Shares []storage.Client // clients on the same host which map the same cache host path
+	}
+
+	CacheDirStatus struct {
+		Dir      string `json:"dir"`       // cache directory in container
+		HostPath string `json:"host_path"` // empty if cache directory not mapped to host
+		Size     int64  `json:"size"`      // -1 if cache directory not exist
+		Avail    int64  `json:"avail"`
+		Total    int64  `json:"total"`
+		volume   string // host path of volume which cache directory mapped by
+	}
+
+	ClientCacheStatus struct {
+		Id         string           `json:"id"`
+		Host       string           `json:"host"`
+		FSName     string           `json:"fsname"`
+		MountPoint string           `json:"mount_point"`
+		Container  string           `json:"container"`
+		Dirs       []CacheDirStatus `json:"dirs"`
+		HitRatio   float64          `json:"hit_ratio"`
+		Warmup     []string         `json:"warmup,omitempty"`
+		Error      string           `json:"error,omitempty"`
+	}
+
+	step2ClientCache struct {
+		dingoadm    *cli.DingoAdm
+		options     ClientCacheOptions
+		status      ClientCacheStatus
+		containerId string
+		shares      []storage.Client
+		metricPort  int
+		scriptPath  string
+		execOptions module.ExecOptions
+	}
+)
+
+func setClientCacheStatus(memStorage *utils.SafeMap, status ClientCacheStatus) {
+	memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]ClientCacheStatus{}
+		v := kv.Get(comm.KEY_ALL_CLIENT_CACHE_STATUS)
+		if v != nil {
+			m = v.(map[string]ClientCacheStatus)
+		}
+		m[status.Id] = status
+		kv.Set(comm.KEY_ALL_CLIENT_CACHE_STATUS, m)
+		return nil
+	})
+}
+
+// getCacheDirs maps cache directories in container to host by volumes of client container
+func getCacheDirs(cc *configure.ClientConfig) []CacheDirStatus {
+	volumes := fs.GetClientVolumes(cc)
+	dirs := []CacheDirStatus{}
+	for _, dir := range cc.GetDiskCacheDirs() {
+		status := CacheDirStatus{Dir: dir}
+		matched := ""
+		for _, volume := range volumes {
+			cpath := strings.TrimSuffix(volume.ContainerPath, "/")
+			if (dir == cpath || strings.HasPrefix(dir, cpath+"/")) && len(cpath) > len(matched) {
+				matched = cpath
+				status.HostPath = path.Join(volume.HostPath, strings.TrimPrefix(dir, cpath))
+				status.volume = volume.HostPath
+			}
+		}
+		dirs = append(dirs, status)
+	}
+	return dirs
+}
+
+// cleanable returns true if host path of cache directory is strictly below
+// the volume, cleaning the volume itself may remove data not belongs to cache
+func (s CacheDirStatus) cleanable() bool {
+	volume := path.Clean(s.volume)
+	if len(s.HostPath) == 0 || len(s.volume) == 0 || volume == "/" {
+		return false
+	}
+	return strings.HasPrefix(path.Clean(s.HostPath), volume+"/")
+}
+
+// shareCacheDir returns true if any host path of cache directories is the same
+// as or nested in the other one
+func shareCacheDir(dirs, others []CacheDirStatus) bool {
+	nested := func(p, parent string) bool {
+		return p == parent || strings.HasPrefix(p, strings.TrimSuffix(parent, "/")+"/")
+	}
+	for _, dir := range dirs {
+		for _, other := range others {
+			if len(dir.HostPath) == 0 || len(other.HostPath) == 0 {
+				continue
+			}
+			p1, p2 := path.Clean(dir.HostPath), path.Clean(other.HostPath)
+			if nested(p1, p2) || nested(p2, p1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetSharedCacheClients returns clients in caches on the same host as cache,
+// which cache directories share host path with it
+func GetSharedCacheClients(cache ClientCache, caches []ClientCache) []storage.Client {
+	shares := []storage.Client{}
+	dirs := getCacheDirs(cache.Config)
+	for _, other := range caches {
+		if other.Client.Id == cache.Client.Id || other.Client.Host != cache.Client.Host {
+			continue
+		} else if shareCacheDir(dirs, getCacheDirs(other.Config)) {
+			shares = append(shares, other.Client)
+		}
+	}
+	return shares
+}
+
+// parseCacheHitRatio calculates hit ratio from bvar metrics of client,
+// which sums all counters of cache hit and miss
+func parseCacheHitRatio(out string) float64 {
+	var hit, miss float64
+	for _, line := range strings.Split(out, "\n") {
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(kv[0]))
+		value, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64)
+		if err != nil || !strings.Contains(key, "cache") {
+			continue
+		} else if strings.Contains(key, "hit") && !strings.Contains(key, "ratio") {
+			hit += value
+		} else if strings.Contains(key, "miss") {
+			miss += value
+		}
+	}
+	if hit+miss == 0 {
+		return CACHE_HIT_RATIO_UNKNOWN
+	}
+	return hit / (hit + miss)
+}
+
+// getWarmupPath converts path under mount point to path in client container
+func getWarmupPath(mountPoint, p string) string {
+	if !strings.HasPrefix(p, "/") {
+		p = path.Join(mountPoint, p)
+	}
+	return configure.GetFSClientMountPath(path.Clean(p))
+}
+
+func (s *step2ClientCache) running() bool {
+	return s.status.Container == CLIENT_CONTAINER_RUNNING
+}
+
+func (s *step2ClientCache) execInContainer(ctx *context.Context, command string) (string, error) {
+	return ctx.Module().DockerCli().
+		ContainerExec(s.containerId, "sh -c "+utils.ShellQuote(command)).
+		Execute(s.execOptions)
+}
+
+func (s *step2ClientCache) containerStatus(ctx *context.Context, containerId string) string {
+	out, err := ctx.Module().DockerCli().InspectContainer(containerId).
+		AddOption("--format '{{.State.Status}}'").
+		Execute(s.execOptions)
+	return utils.Choose(err == nil, strings.TrimSpace(out), comm.CLIENT_STATUS_LOSED)
+}
+
+func (s *step2ClientCache) inspectContainer(ctx *context.Context) {
+	s.status.Container = s.containerStatus(ctx, s.containerId)
+}
+
+// checkShares denies cleaning cache directories which running client on
+// the same host also uses
+func (s *step2ClientCache) checkShares(ctx *context.Context) error {
+	for _, client := range s.shares {
+		if s.containerStatus(ctx, client.ContainerId) == CLIENT_CONTAINER_RUNNING {
+			return errno.ERR_CLEAN_SHARED_CACHE_DIR.
+				F("host=%s mountPoint=%s shares cache directory with running client %s",
+					s.status.Host, s.status.MountPoint, client.Id)
+		}
+	}
+	return nil
+}
+
+// usage runs client_cache.sh for cache directories mapped to host
+func (s *step2ClientCache) usage(ctx *context.Context, mode string) error {
+	paths := []string{}
+	for _, dir := range s.status.Dirs {
+		if mode == CLIENT_CACHE_OP_CLEAN && len(dir.HostPath) > 0 && !dir.cleanable() {
+			return errno.ERR_CLEAN_CACHE_DIR_DENIED.
+				F("cache directory %s (host path %s) is not a sub-directory of volume %s",
+					dir.Dir, dir.HostPath, dir.volume)
+		}
+	}
+	for _, dir := range s.status.Dirs {
+		if len(dir.HostPath) > 0 {
+			paths = append(paths, utils.ShellQuote(dir.HostPath))
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	var out string
+	err := (&step.Command{
+		Command:     fmt.Sprintf("bash %s %s %s", s.scriptPath, mode, strings.Join(paths, " ")),
+		Out:         &out,
+		ExecOptions: s.execOptions,
+	}).Execute(ctx)
+	if err != nil {
+		return err
+	}
+	m := parseConfigLines(out, "=")
+	for i, dir := range s.status.Dirs {
+		items := strings.Split(m[dir.HostPath], ",")
+		if len(items) != 3 {
+			continue
+		}
+		s.status.Dirs[i].Size, _ = strconv.ParseInt(items[0], 10, 64)
+		s.status.Dirs[i].Avail, _ = strconv.ParseInt(items[1], 10, 64)
+		s.status.Dirs[i].Total, _ = strconv.ParseInt(items[2], 10, 64)
+	}
+	if len(m["error"]) > 0 {
+		return errno.ERR_CLEAN_CACHE_FAILED.S(m["error"])
+	}
+	return nil
+}
+
+func (s *step2ClientCache) hitRatio(ctx *context.Context) {
+	s.status.HitRatio = CACHE_HIT_RATIO_UNKNOWN
+	if !s.running() {
+		return
+	}
+	out, err := s.execInContainer(ctx, fmt.Sprintf(FORMAT_CLIENT_METRIC_COMMAND, s.metricPort, s.metricPort))
+	if err == nil {
+		s.status.HitRatio = parseCacheHitRatio(out)
+	}
+}
+
+func (s *step2ClientCache) warmup(ctx *context.Context) error {
+	if !s.running() {
+		return errno.ERR_CLIENT_NOT_RUNNING.
+			F("host=%s mountPoint=%s container=%s", s.status.Host, s.status.MountPoint, s.status.Container)
+	}
+	for _, p := range s.options.Paths {
+		target := getWarmupPath(s.status.MountPoint, p)
+		command := fmt.Sprintf("%s warmup add %s", topology.GetDingoFSProjectLayout().FSToolsBinaryPath, utils.ShellQuote(target))
+		out, err := s.execInContainer(ctx, command)
+		if err != nil {
+			return errno.ERR_WARMUP_CACHE_FAILED.S(out)
+		}
+		s.status.Warmup = append(s.status.Warmup, p)
+	}
+	return nil
+}
+
+func (s *step2ClientCache) execute(ctx *context.Context) error {
+	s.inspectContainer(ctx)
+	switch s.options.Op {
+	case CLIENT_CACHE_OP_CLEAN:
+		if s.running() {
+			return errno.ERR_CLEAN_RUNNING_CLIENT.
+				F("host=%s mountPoint=%s", s.status.Host, s.status.MountPoint)
+		} else if err := s.checkShares(ctx); err != nil {
+			return err
+		}
+		return s.usage(ctx, CLIENT_CACHE_OP_CLEAN)
+	case CLIENT_CACHE_OP_WARMUP:
+		return s.warmup(ctx)
+	}
+
+	err := s.usage(ctx, CLIENT_CACHE_OP_STATUS)
+	if err != nil {
+		return err
+	}
+	s.hitRatio(ctx)
+	return nil
+}
+
+func (s *step2ClientCache) Execute(ctx *context.Context) error {
+	err := s.execute(ctx)
+	if err != nil {
+		s.status.Error = err.Error()
+	}
+	setClientCacheStatus(s.dingoadm.MemStorage(), s.status)
+	return err
+}
+
+// NewClientCacheTask reports, cleans or warmups cache of dingofs client,
+// v is ClientCache
+func NewClientCacheTask(dingoadm *cli.DingoAdm, v interface{}) (*task.Task, error) {
+	cache := v.(ClientCache)
+	client := cache.Client
+	auxInfo := fs.AuxInfo{}
+	if client.Kind != topology.KIND_DINGOFS {
+		return nil, nil
+	} else if err := json.Unmarshal([]byte(client.AuxInfo), &auxInfo); err != nil {
+		return nil, nil
+	}
+	hc, err := dingoadm.GetHost(client.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	options := dingoadm.MemStorage().Get(comm.KEY_CLIENT_CACHE_OPTIONS).(ClientCacheOptions)
+	name := map[string]string{
+		CLIENT_CACHE_OP_STATUS: "Get Client Cache Status",
+		CLIENT_CACHE_OP_CLEAN:  "Clean Client Cache",
+		CLIENT_CACHE_OP_WARMUP: "Warmup Client Cache",
+	}[options.Op]
+	subname := fmt.Sprintf("host=%s mountPoint=%s containerId=%s",
+		client.Host, auxInfo.MountPoint, tui.TrimContainerId(client.ContainerId))
+	t := task.NewTask(name, subname, hc.GetSSHConfig())
+
+	// add step to task
+	execOptions := dingoadm.ExecOptions()
+	scriptPath := utils.RandFilename("/tmp") + ".sh"
+	metricPort := auxInfo.MetricPort
+	if metricPort <= 0 {
+		metricPort = cache.Config.GetDummyPort()
+	}
+	t.AddStep(&step.InstallFile{
+		HostDestPath: scriptPath,
+		Content:      &scripts.CLIENT_CACHE,
+		ExecOptions:  execOptions,
+	})
+	t.AddStep(&step2ClientCache{
+		dingoadm: dingoadm,
+		options:  options,
+		status: ClientCacheStatus{
+			Id:         client.Id,
+			Host:       client.Host,
+			FSName:     auxInfo.FSName,
+			MountPoint: auxInfo.MountPoint,
+			Dirs:       getCacheDirs(cache.Config),
+			HitRatio:   CACHE_HIT_RATIO_UNKNOWN,
+		},
+		containerId: client.ContainerId,
+		shares:      cache.Shares,
+		metricPort:  metricPort,
+		scriptPath:  scriptPath,
+		execOptions: execOptions,
+	})
+	t.AddPostStep(&step.RemoveFile{
+		Files:       []string{scriptPath},
+		ExecOptions: execOptions,
+	})
+
+	return t, nil
+}
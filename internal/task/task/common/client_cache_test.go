@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheDirCleanable(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		hostPath  string
+		volume    string
+		cleanable bool
+	}{
+		{"/data/cache/blocks", "/data/cache", true},
+		{"/data/cache/blocks/", "/data/cache/", true},
+		{"/data/cache", "/data/cache", false}, // volume itself
+		{"/data/cache", "/data/cache/", false},
+		{"/data/cache2", "/data/cache", false},
+		{"/var", "/", false}, // volume is root
+		{"", "/data/cache", false},
+		{"/data/cache/blocks", "", false},
+	}
+	for _, tt := range tests {
+		dir := CacheDirStatus{HostPath: tt.hostPath, volume: tt.volume}
+		assert.Equal(tt.cleanable, dir.cleanable(), "hostPath=%s volume=%s", tt.hostPath, tt.volume)
+	}
+}
+
+func TestShareCacheDir(t *testing.T) {
+	assert := assert.New(t)
+	dirs := func(paths ...string) []CacheDirStatus {
+		statuses := []CacheDirStatus{}
+		for _, p := range paths {
+			statuses = append(statuses, CacheDirStatus{HostPath: p})
+		}
+		return statuses
+	}
+
+	tests := []struct {
+		dirs   []CacheDirStatus
+		others []CacheDirStatus
+		share  bool
+	}{
+		{dirs("/data/cache/blocks"), dirs("/data/cache/blocks/"), true},
+		{dirs("/data/cache/blocks"), dirs("/data/cache"), true}, // nested
+		{dirs("/data/cache"), dirs("/data/cache/blocks"), true},
+		{dirs("/data1/cache", "/data2/cache"), dirs("/data2/cache"), true},
+		{dirs("/data/cache1"), dirs("/data/cache"), false},
+		{dirs("/data/cache"), dirs(""), false}, // not mapped to host
+		{dirs(), dirs("/data/cache"), false},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.share, shareCacheDir(tt.dirs, tt.others), "%v %v", tt.dirs, tt.others)
+	}
+}
+
+func TestGetSharedCacheClients(t *testing.T) {
+	assert := assert.New(t)
+	newCache := func(id, host, hostPath string) ClientCache {
+		cc, err := configure.ParseClientConfigData(fmt.Sprintf(`
+kind: dingofs
+mds.addr: 10.0.0.1:7400
+mount_dirs: %s:/cache
+disk_cache.cache_dir: /cache/blocks
+`, hostPath), configure.FS_TYPE_VKS_V2)
+		assert.Nil(err)
+		return ClientCache{Client: storage.Client{Id: id, Host: host}, Config: cc}
+	}
+
+	cache := newCache("c1", "host1", "/data/cache")
+	caches := []ClientCache{
+		cache,
+		newCache("c2", "host1", "/data/cache"),  // same host path
+		newCache("c3", "host1", "/data/cache2"), // different host path
+		newCache("c4", "host2", "/data/cache"),  // different host
+	}
+	shares := GetSharedCacheClients(cache, caches)
+	assert.Equal([]storage.Client{{Id: "c2", Host: "host1"}}, shares)
+}
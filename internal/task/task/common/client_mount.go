@@ -0,0 +1,373 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/storage"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/scripts"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	"github.com/dingodb/dingoadm/internal/task/task/fs"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dingodb/dingoadm/pkg/module"
+)
+
+const (
+	// state of client mount point reported by client_mount.sh
+	CLIENT_MOUNT_STATE_MOUNTED     = "mounted"
+	CLIENT_MOUNT_STATE_BROKEN      = "broken"
+	CLIENT_MOUNT_STATE_HUNG        = "hung"
+	CLIENT_MOUNT_STATE_NOT_MOUNTED = "not-mounted"
+	CLIENT_MOUNT_STATE_ABSENT      = "absent"
+
+	CLIENT_MOUNT_MODE_CHECK = "check"
+	CLIENT_MOUNT_MODE_CLEAN = "clean"
+	CLIENT_MOUNT_MODE_DRAIN = "drain"
+
+	CLIENT_CONTAINER_RUNNING = "running"
+
+	DEFAULT_REMOUNT_TIMEOUT = 60 * time.Second
+	REMOUNT_POLL_INTERVAL   = 2 * time.Second
+)
+
+type (
+	ClientMountStatus struct {
+		Id          string `json:"id"`
+		Host        string `json:"host"`
+		FSName      string `json:"fsname"`
+		MountPoint  string `json:"mount_point"`
+		ContainerId string `json:"container_id"`
+		Container   string `json:"container"` // status of client container, e.g. running, exited
+		State       string `json:"state"`
+		FSType      string `json:"fs_type"`
+		Users       int    `json:"users,omitempty"` // processes using the mount point while draining
+		Persistent  bool   `json:"persistent"`
+		Remounted   bool   `json:"remounted"`
+		Error       string `json:"error,omitempty"`
+		// duration from client container restarted to filesystem accessible again
+		Downtime time.Duration `json:"downtime,omitempty"`
+	}
+
+	// ClientReload is the new config which reloaded by client,
+	// quota of filesystem is updated if Quota is true
+	ClientReload struct {
+		Client storage.Client
+		Config *configure.ClientConfig
+		Quota  bool
+	}
+
+	step2ReloadClientConfig struct {
+		client      storage.Client
+		fsname      string
+		config      *configure.ClientConfig
+		quota       bool
+		execOptions module.ExecOptions
+	}
+
+	step2CheckClientMount struct {
+		t           *task.Task
+		dingoadm    *cli.DingoAdm
+		status      ClientMountStatus
+		scriptPath  string
+		remount     bool
+		graceful    bool   // wait mount point idle and umount it before restart
+		config      string // the reloaded config, which stored once client restarted
+		timeout     time.Duration
+		execOptions module.ExecOptions
+	}
+)
+
+// Healthy returns true if client container is running and the filesystem is accessible
+func (s ClientMountStatus) Healthy() bool {
+	return s.Container == CLIENT_CONTAINER_RUNNING && s.State == CLIENT_MOUNT_STATE_MOUNTED
+}
+
+func setClientMountStatus(memStorage *utils.SafeMap, status ClientMountStatus) {
+	memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]ClientMountStatus{}
+		v := kv.Get(comm.KEY_ALL_CLIENT_MOUNT_STATUS)
+		if v != nil {
+			m = v.(map[string]ClientMountStatus)
+		}
+		m[status.Id] = status
+		kv.Set(comm.KEY_ALL_CLIENT_MOUNT_STATUS, m)
+		return nil
+	})
+}
+
+func (s *step2CheckClientMount) inspect(ctx *context.Context, mode string) error {
+	status := &s.status
+	out, err := ctx.Module().DockerCli().InspectContainer(status.ContainerId).
+		AddOption("--format '{{.State.Status}}'").
+		Execute(s.execOptions)
+	status.Container = utils.Choose(err == nil, strings.TrimSpace(out), comm.CLIENT_STATUS_LOSED)
+
+	out = ""
+	err = (&step.Command{
+		Command:     fmt.Sprintf("bash %s %s %s", s.scriptPath, mode, utils.ShellQuote(status.MountPoint)),
+		Out:         &out,
+		ExecOptions: s.execOptions,
+	}).Execute(ctx)
+	if err != nil {
+		return err
+	}
+	return parseClientMountState(status, out)
+}
+
+// parseClientMountState parses the 'key=value' lines printed by client_mount.sh
+func parseClientMountState(status *ClientMountStatus, out string) error {
+	m := parseConfigLines(out, "=")
+	status.State = m["state"]
+	status.FSType = m["fs_type"]
+	status.Users, _ = strconv.Atoi(m["users"])
+	if len(m["error"]) > 0 {
+		return errno.ERR_UNMOUNT_FILE_SYSTEMS_FAILED.S(m["error"])
+	}
+	return nil
+}
+
+// drain waits until no process uses the mount point, then umounts it,
+// the umount fails if any file opened, so no in-flight I/O interrupted
+func (s *step2CheckClientMount) drain(ctx *context.Context, deadline time.Time) error {
+	status := &s.status
+	for {
+		err := s.inspect(ctx, CLIENT_MOUNT_MODE_DRAIN)
+		if err != nil {
+			return err
+		} else if status.State != CLIENT_MOUNT_STATE_MOUNTED {
+			return nil
+		} else if time.Now().After(deadline) {
+			return errno.ERR_CLIENT_MOUNT_BUSY.
+				F("host=%s mountPoint=%s users=%d", status.Host, status.MountPoint, status.Users)
+		}
+		s.t.SetProgress(fmt.Sprintf("[draining, %d users]", status.Users))
+		time.Sleep(REMOUNT_POLL_INTERVAL)
+	}
+}
+
+// remountClient restarts client container, and the client re-mounts filesystem on startup.
+// The graceful remount (e.g. reload config) drains the healthy mount point first, otherwise
+// the stale mount point is cleaned (lazy umount) which only for broken or hung mount point
+func (s *step2CheckClientMount) remountClient(ctx *context.Context) error {
+	status := &s.status
+	if status.Container == comm.CLIENT_STATUS_LOSED {
+		return errno.ERR_FS_CLIENT_LOSED.
+			F("host=%s mountPoint=%s", status.Host, status.MountPoint)
+	}
+
+	var err error
+	deadline := time.Now().Add(s.timeout)
+	if s.graceful {
+		err = s.drain(ctx, deadline)
+	} else {
+		err = s.inspect(ctx, CLIENT_MOUNT_MODE_CLEAN)
+	}
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	err = (&step.RestartContainer{
+		ContainerId: status.ContainerId,
+		ExecOptions: s.execOptions,
+	}).Execute(ctx)
+	if err != nil {
+		return err
+	} else if len(s.config) > 0 {
+		err = s.dingoadm.Storage().SetClientConfig(status.Id, s.config)
+		if err != nil {
+			return errno.ERR_SET_CLIENT_CONFIG_FAILED.E(err)
+		}
+	}
+
+	deadline = time.Now().Add(s.timeout)
+	for {
+		err = s.inspect(ctx, CLIENT_MOUNT_MODE_CHECK)
+		if err == nil && status.Healthy() {
+			status.Remounted = true
+			status.Downtime = time.Since(start)
+			return nil
+		} else if time.Now().After(deadline) {
+			return errno.ERR_WAIT_REMOUNT_TIMEOUT.
+				F("host=%s mountPoint=%s state=%s", status.Host, status.MountPoint, status.State)
+		}
+		s.t.SetProgress(fmt.Sprintf("[%s]", status.State))
+		time.Sleep(REMOUNT_POLL_INTERVAL)
+	}
+}
+
+func (s *step2CheckClientMount) Execute(ctx *context.Context) error {
+	var err error
+	if s.remount {
+		err = s.remountClient(ctx)
+	} else {
+		err = s.inspect(ctx, CLIENT_MOUNT_MODE_CHECK)
+	}
+	if err != nil {
+		s.status.Error = err.Error()
+	}
+	setClientMountStatus(s.dingoadm.MemStorage(), s.status)
+	return err
+}
+
+// Execute syncs client.conf in container with the new config and updates filesystem
+// quota, the config takes effect (and stored) after client restarted
+func (s *step2ReloadClientConfig) Execute(ctx *context.Context) error {
+	cc := s.config
+	containerId := s.client.ContainerId
+	err := (&step.SyncFile{
+		ContainerSrcId:    &containerId,
+		ContainerSrcPath:  path.Join(configure.GetFSProjectRoot(), "conf", "client.template.conf"),
+		ContainerDestId:   &containerId,
+		ContainerDestPath: configure.GetFSClientConfPath(),
+		KVFieldSplit:      comm.CLIENT_CONFIG_DELIMITER,
+		Mutate:            newClientMutate(cc, comm.CLIENT_CONFIG_DELIMITER),
+		ExecOptions:       s.execOptions,
+	}).Execute(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.quota {
+		command := fs.GenConfigFSCommand(topology.GetDingoFSProjectLayout().FSToolsBinaryPath,
+			cc.GetClusterMDSAddr(configure.FS_TYPE_VKS_V2), s.fsname,
+			int64(cc.GetQuotaCapacity()), int64(cc.GetQuotaInodes()))
+		out, err := ctx.Module().DockerCli().
+			ContainerExec(containerId, "sh -c "+utils.ShellQuote(command)).
+			Execute(s.execOptions)
+		if err != nil {
+			return errno.ERR_RUN_DINGOFS_TOOL_FAILED.S(out)
+		}
+	}
+	return nil
+}
+
+func reloadConfigData(reload *ClientReload) string {
+	if reload == nil {
+		return ""
+	}
+	return reload.Config.GetData()
+}
+
+func newClientMountTask(dingoadm *cli.DingoAdm, v interface{}, remount bool) (*task.Task, error) {
+	var reload *ClientReload
+	client, ok := v.(storage.Client)
+	if !ok {
+		r := v.(ClientReload)
+		client, reload = r.Client, &r
+	}
+	auxInfo := fs.AuxInfo{}
+	if client.Kind != topology.KIND_DINGOFS {
+		return nil, nil
+	} else if err := json.Unmarshal([]byte(client.AuxInfo), &auxInfo); err != nil ||
+		len(auxInfo.MountPoint) == 0 {
+		return nil, nil
+	}
+	hc, err := dingoadm.GetHost(client.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	name := utils.Choose(remount, "Remount Client", "Check Client Mount")
+	if reload != nil {
+		name = "Reload Client"
+	}
+	subname := fmt.Sprintf("host=%s mountPoint=%s containerId=%s",
+		client.Host, auxInfo.MountPoint, tui.TrimContainerId(client.ContainerId))
+	t := task.NewTask(name, subname, hc.GetSSHConfig())
+
+	// add step to task
+	timeout := DEFAULT_REMOUNT_TIMEOUT
+	if v := dingoadm.MemStorage().Get(comm.KEY_REMOUNT_TIMEOUT); v != nil {
+		timeout = v.(time.Duration)
+	}
+	options := dingoadm.ExecOptions()
+	scriptPath := utils.RandFilename("/tmp") + ".sh"
+	t.AddStep(&step.InstallFile{
+		HostDestPath: scriptPath,
+		Content:      &scripts.CLIENT_MOUNT,
+		ExecOptions:  options,
+	})
+	if reload != nil {
+		t.AddStep(&step2ReloadClientConfig{
+			client:      client,
+			fsname:      auxInfo.FSName,
+			config:      reload.Config,
+			quota:       reload.Quota,
+			execOptions: options,
+		})
+	}
+	t.AddStep(&step2CheckClientMount{
+		t:        t,
+		dingoadm: dingoadm,
+		status: ClientMountStatus{
+			Id:          client.Id,
+			Host:        client.Host,
+			FSName:      auxInfo.FSName,
+			MountPoint:  auxInfo.MountPoint,
+			ContainerId: client.ContainerId,
+			Persistent:  auxInfo.Persistent,
+		},
+		scriptPath:  scriptPath,
+		remount:     remount,
+		graceful:    reload != nil,
+		config:      reloadConfigData(reload),
+		timeout:     timeout,
+		execOptions: options,
+	})
+	t.AddPostStep(&step.RemoveFile{
+		Files:       []string{scriptPath},
+		ExecOptions: options,
+	})
+
+	return t, nil
+}
+
+// NewCheckClientMountTask detects whether the mount point of dingofs client is broken
+func NewCheckClientMountTask(dingoadm *cli.DingoAdm, v interface{}) (*task.Task, error) {
+	return newClientMountTask(dingoadm, v, false)
+}
+
+// NewRemountClientTask cleans the stale mount point and restarts dingofs client
+func NewRemountClientTask(dingoadm *cli.DingoAdm, v interface{}) (*task.Task, error) {
+	return newClientMountTask(dingoadm, v, true)
+}
+
+// NewReloadClientTask updates config of dingofs client and restarts it,
+// v is ClientReload
+func NewReloadClientTask(dingoadm *cli.DingoAdm, v interface{}) (*task.Task, error) {
+	return newClientMountTask(dingoadm, v, true)
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/scripts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseClientMountState(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		out    string
+		state  string
+		fsType string
+		users  int
+		err    bool
+	}{
+		{"state=mounted\nfs_type=fuse.dingofs\n", CLIENT_MOUNT_STATE_MOUNTED, "fuse.dingofs", 0, false},
+		{"users=3\nstate=mounted\nfs_type=fuse.dingofs", CLIENT_MOUNT_STATE_MOUNTED, "fuse.dingofs", 3, false},
+		{"users=0\nstate=not-mounted\nfs_type=\n", CLIENT_MOUNT_STATE_NOT_MOUNTED, "", 0, false},
+		{"state=broken\nfs_type=fuse.dingofs\n", CLIENT_MOUNT_STATE_BROKEN, "fuse.dingofs", 0, false},
+		{"state=hung\n", CLIENT_MOUNT_STATE_HUNG, "", 0, false},
+		{"state=absent\nfs_type=\n", CLIENT_MOUNT_STATE_ABSENT, "", 0, false},
+		{"error=umount /mnt/dingofs failed\nstate=broken\n", CLIENT_MOUNT_STATE_BROKEN, "", 0, true},
+		{"error=unknown mode 'foo'\n", "", "", 0, true},
+		{"", "", "", 0, false},
+	}
+	for _, tt := range tests {
+		status := ClientMountStatus{Users: 5}
+		err := parseClientMountState(&status, tt.out)
+		assert.Equal(tt.state, status.State, tt.out)
+		assert.Equal(tt.fsType, status.FSType, tt.out)
+		assert.Equal(tt.users, status.Users, tt.out)
+		if tt.err {
+			assert.Equal(errno.ERR_UNMOUNT_FILE_SYSTEMS_FAILED.GetCode(), err.(*errno.ErrorCode).GetCode(), tt.out)
+		} else {
+			assert.Nil(err, tt.out)
+		}
+	}
+}
+
+func TestClientMountScript(t *testing.T) {
+	assert := assert.New(t)
+	script := filepath.Join(t.TempDir(), "client_mount.sh")
+	assert.Nil(os.WriteFile(script, []byte(scripts.CLIENT_MOUNT), 0644))
+
+	tests := []struct {
+		mode  string
+		state string
+		err   bool
+	}{
+		{CLIENT_MOUNT_MODE_CHECK, CLIENT_MOUNT_STATE_ABSENT, false},
+		{CLIENT_MOUNT_MODE_CLEAN, CLIENT_MOUNT_STATE_ABSENT, false},
+		{CLIENT_MOUNT_MODE_DRAIN, CLIENT_MOUNT_STATE_ABSENT, false},
+		{"foo", "", true},
+	}
+	for _, tt := range tests {
+		out, err := exec.Command("bash", script, tt.mode, "/not/exist/mount/point").Output()
+		assert.Nil(err, tt.mode)
+		status := ClientMountStatus{}
+		err = parseClientMountState(&status, string(out))
+		assert.Equal(tt.state, status.State, tt.mode)
+		assert.Equal(tt.err, err != nil, tt.mode)
+	}
+}
+
+func TestClientMountStatusHealthy(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		container string
+		state     string
+		healthy   bool
+	}{
+		{CLIENT_CONTAINER_RUNNING, CLIENT_MOUNT_STATE_MOUNTED, true},
+		{CLIENT_CONTAINER_RUNNING, CLIENT_MOUNT_STATE_BROKEN, false},
+		{CLIENT_CONTAINER_RUNNING, CLIENT_MOUNT_STATE_HUNG, false},
+		{CLIENT_CONTAINER_RUNNING, CLIENT_MOUNT_STATE_NOT_MOUNTED, false},
+		{CLIENT_CONTAINER_RUNNING, CLIENT_MOUNT_STATE_ABSENT, false},
+		{CLIENT_CONTAINER_RUNNING, "", false},
+		{"exited", CLIENT_MOUNT_STATE_MOUNTED, false},
+		{comm.CLIENT_STATUS_LOSED, CLIENT_MOUNT_STATE_MOUNTED, false},
+	}
+	for _, tt := range tests {
+		status := ClientMountStatus{Container: tt.container, State: tt.state}
+		assert.Equal(tt.healthy, status.Healthy(), "%s/%s", tt.container, tt.state)
+	}
+}
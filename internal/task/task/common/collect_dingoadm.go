@@ -27,17 +27,40 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
 	"path"
 
 	"github.com/dingodb/dingoadm/cli/cli"
 	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/task/context"
 	"github.com/dingodb/dingoadm/internal/task/step"
 	"github.com/dingodb/dingoadm/internal/task/task"
 	"github.com/dingodb/dingoadm/internal/utils"
 )
 
+const (
+	SUPPORT_HOST_FACTS_FILE = "host_facts.json"
+)
+
+// saveHostFactsForSupport saves the cached host facts into support tarball,
+// the facts is optional, so nothing saved if it not gathered
+func saveHostFactsForSupport(dingoadm *cli.DingoAdm, dir string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		facts, err := configure.GetAllHostFacts(dingoadm)
+		if err != nil || len(facts) == 0 {
+			return nil
+		}
+		bytes, err := json.MarshalIndent(facts, "", "  ")
+		if err != nil {
+			return nil
+		}
+		return utils.WriteFile(path.Join(dir, SUPPORT_HOST_FACTS_FILE), string(bytes), 0644)
+	}
+}
+
 func NewCollectCurveAdmTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
 	// NOTE: we think it's not a good idae to collect curveadm's datbase file...
 	// new task
@@ -71,6 +94,9 @@ func NewCollectCurveAdmTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (
 			ExecOptions: options,
 		})
 	}
+	t.AddStep(&step.Lambda{
+		Lambda: saveHostFactsForSupport(dingoadm, localPath),
+	})
 	t.AddStep(&step.Tar{
 		File:        localPath,
 		Archive:     localTarballPath,
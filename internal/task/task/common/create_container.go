@@ -84,6 +84,7 @@ const (
 	ENV_DINGODB_EXECUTOR_ROLE         = "DINGO_ROLE"
 	ENV_DINGODB_EXECUTOR_HOSTNAME     = "DINGO_HOSTNAME"
 	ENV_DINGODB_EXECUTOR_COORDINATORS = "DINGO_COORDINATORS"
+	ENV_DINGODB_EXECUTOR_METRICS_PORT = "DINGO_METRICS_PORT"
 )
 
 type Step2GetService struct {
@@ -297,6 +298,9 @@ func configExecutorENV(envs []string, dc *topology.DeployConfig) []string {
 		coordinator_addr, _ = dc.GetVariables().Get("coordinator_addr")
 	}
 	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGODB_EXECUTOR_COORDINATORS, coordinator_addr))
+	if dc.GetRole() == topology.ROLE_DINGODB_EXECUTOR {
+		envs = append(envs, fmt.Sprintf("%s=%d", ENV_DINGODB_EXECUTOR_METRICS_PORT, dc.GetDingoDBMetricsPort()))
+	}
 	return envs
 }
 
@@ -66,11 +66,15 @@ func getClusterPool(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (configur
 	if err != nil {
 		return oldPool, err
 	}
+	hostLabels, err := dingoadm.GetHostLabels()
+	if err != nil {
+		return oldPool, err
+	}
 
 	// 1) generate a new default pool
 	data := dingoadm.ClusterPoolData()
 	if len(data) == 0 {
-		return configure.GenerateDefaultClusterPool(dcs, poolset)
+		return configure.GenerateDefaultClusterPool(dcs, poolset, hostLabels)
 	}
 
 	// 2) OR change old pool and return it
@@ -78,7 +82,8 @@ func getClusterPool(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (configur
 	if err != nil {
 		return oldPool, err
 	}
-	pool, err := configure.GenerateDefaultClusterPool(dcs, poolset)
+	// NOTE: validate failure domains against replicas of the old pool
+	pool, err := configure.GenerateClusterPool(dcs, poolset, oldPool.GetReplicas(), hostLabels)
 	if err != nil {
 		return pool, err
 	}
@@ -111,7 +116,15 @@ func prepare(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (clusterPoolJson
 	if dingoadm.MemStorage().Get(comm.KEY_SCALE_OUT_CLUSTER) != nil { // scale out cluster
 		dcs := dingoadm.MemStorage().Get(comm.KEY_SCALE_OUT_CLUSTER).([]*topology.DeployConfig)
 		poolset := getPoolset(dingoadm, dc.GetKind())
-		configure.ScaleOutClusterPool(&clusterPool, dcs, poolset)
+		var hostLabels map[string][]string
+		hostLabels, err = dingoadm.GetHostLabels()
+		if err != nil {
+			return
+		}
+		err = configure.ScaleOutClusterPool(&clusterPool, dcs, poolset, hostLabels)
+		if err != nil {
+			return
+		}
 	} else if dingoadm.MemStorage().Get(comm.KEY_MIGRATE_SERVERS) != nil { // migrate servers
 		migrates := dingoadm.MemStorage().Get(comm.KEY_MIGRATE_SERVERS).([]*configure.MigrateServer)
 		configure.MigrateClusterServer(&clusterPool, migrates)
@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/scripts"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	DISK_MODE_STATUS  = "status"
+	DISK_MODE_PREPARE = "prepare"
+
+	// state and error reported by disk.sh
+	DISK_STATE_MOUNTED               = "mounted"
+	DISK_STATE_EMPTY                 = "empty"
+	DISK_STATE_NOT_EMPTY             = "not-empty"
+	DISK_STATE_MOUNTED_ELSEWHERE     = "mounted-elsewhere"
+	DISK_STATE_NOT_BLOCK_DEVICE      = "not-block-device"
+	DISK_STATE_MOUNT_POINT_NOT_EMPTY = "mount-point-not-empty"
+)
+
+type DiskStatus struct {
+	Id              string `json:"id"`
+	Role            string `json:"role"`
+	Host            string `json:"host"`
+	Dir             string `json:"dir"`
+	Device          string `json:"device"`
+	MountPoint      string `json:"mount_point"`
+	MountedOn       string `json:"mounted_on"`
+	FSType          string `json:"fs_type"`
+	UUID            string `json:"uuid"`
+	Size            uint64 `json:"size"`
+	Avail           uint64 `json:"avail"`
+	UsedPercent     string `json:"used_percent"`
+	InFSTab         bool   `json:"fstab"`
+	MountPointEmpty bool   `json:"-"`
+	State           string `json:"state"`
+	Error           string `json:"error,omitempty"`
+}
+
+func setDiskStatus(memStorage *utils.SafeMap, status DiskStatus) {
+	memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]DiskStatus{}
+		v := kv.Get(comm.KEY_ALL_DISK_STATUS)
+		if v != nil {
+			m = v.(map[string]DiskStatus)
+		}
+		m[fmt.Sprintf("%s:%s", status.Id, status.Dir)] = status
+		kv.Set(comm.KEY_ALL_DISK_STATUS, m)
+		return nil
+	})
+}
+
+// parseDiskStatus parses the 'key=value' lines printed by disk.sh
+func parseDiskStatus(status *DiskStatus, out string) {
+	m := parseConfigLines(out, "=")
+	size, _ := strconv.ParseUint(m["size"], 10, 64)
+	avail, _ := strconv.ParseUint(m["avail"], 10, 64)
+	status.MountedOn = m["mounted_on"]
+	status.FSType = m["fs_type"]
+	status.UUID = m["uuid"]
+	status.Size = size
+	status.Avail = avail
+	status.UsedPercent = m["used_percent"]
+	status.InFSTab = m["fstab"] == "yes"
+	status.MountPointEmpty = m["mount_point_empty"] == "yes"
+	status.State = m["state"]
+	status.Error = m["error"]
+}
+
+// checkDiskStatus returns error if the disk can't be used (or prepared) for service directory
+func checkDiskStatus(dc *topology.DeployConfig, disk topology.Disk, status DiskStatus, force bool) error {
+	reason := status.Error
+	if len(reason) == 0 {
+		switch status.State {
+		case DISK_STATE_MOUNTED:
+		case DISK_STATE_EMPTY:
+			if !status.MountPointEmpty && !force {
+				reason = DISK_STATE_MOUNT_POINT_NOT_EMPTY
+			}
+		case DISK_STATE_NOT_EMPTY:
+			if !force {
+				reason = DISK_STATE_NOT_EMPTY
+			}
+		case "": // nothing reported, e.g. disk.sh killed
+			reason = "no state"
+		default:
+			reason = status.State
+		}
+	}
+
+	subject := fmt.Sprintf("%s.host[%s].disk.%s: %s", dc.GetRole(), dc.GetHost(), disk.Dir, disk.Device)
+	switch reason {
+	case "":
+	case DISK_STATE_NOT_BLOCK_DEVICE:
+		return errno.ERR_NOT_A_BLOCK_DEVICE.F(subject)
+	case DISK_STATE_NOT_EMPTY:
+		return errno.ERR_DISK_NOT_EMPTY.
+			F("%s (fs_type=%s), use --force-disk to format it", subject, status.FSType)
+	case DISK_STATE_MOUNTED_ELSEWHERE:
+		return errno.ERR_DISK_MOUNTED_ON_OTHER_DIRECTORY.
+			F("%s (mounted on %s)", subject, status.MountedOn)
+	case DISK_STATE_MOUNT_POINT_NOT_EMPTY:
+		return errno.ERR_DISK_MOUNT_POINT_NOT_EMPTY.
+			F("%s (mount point %s), use --force-disk to mount over it", subject, disk.MountPoint)
+	default:
+		if len(status.Error) > 0 {
+			return errno.ERR_PREPARE_DISK_FAILED.F("%s (%s)", subject, reason)
+		}
+		return errno.ERR_UNRECOGNIZED_DISK_STATUS.F("%s (%s)", subject, reason)
+	}
+
+	if disk.MinFreeSpace == 0 {
+		return nil
+	}
+	free := status.Size
+	if status.State == DISK_STATE_MOUNTED {
+		free = status.Avail
+	}
+	if free < disk.MinFreeSpace {
+		return errno.ERR_DISK_INSUFFICIENT_FREE_SPACE.
+			F("%s (free %s, requires %s)", subject, humanize.IBytes(free), humanize.IBytes(disk.MinFreeSpace))
+	}
+	return nil
+}
+
+func checkDisk(dingoadm *cli.DingoAdm, dc *topology.DeployConfig, disk topology.Disk,
+	record bool, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		status := DiskStatus{
+			Id:         dingoadm.GetServiceId(dc.GetId()),
+			Role:       dc.GetRole(),
+			Host:       dc.GetHost(),
+			Dir:        disk.Dir,
+			Device:     disk.Device,
+			MountPoint: disk.MountPoint,
+		}
+		parseDiskStatus(&status, *out)
+
+		force := dingoadm.MemStorage().Get(comm.KEY_FORCE_DISK) == true
+		err := checkDiskStatus(dc, disk, status, force)
+		if !record {
+			return err
+		} else if code, ok := err.(*errno.ErrorCode); ok && len(status.Error) == 0 {
+			status.Error = code.GetDescription()
+		}
+		setDiskStatus(dingoadm.MemStorage(), status)
+		return nil
+	}
+}
+
+func newDiskTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig,
+	name, mode string, record bool) (*task.Task, error) {
+	disks, err := dc.GetDisks()
+	if err != nil {
+		return nil, err
+	} else if len(disks) == 0 {
+		return nil, nil
+	}
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	devices := []string{}
+	for _, disk := range disks {
+		devices = append(devices, disk.Device)
+	}
+	subname := fmt.Sprintf("host=%s role=%s devices=%s",
+		dc.GetHost(), dc.GetRole(), strings.Join(devices, ","))
+	t := task.NewTask(name, subname, hc.GetSSHConfig())
+
+	// add step to task
+	options := dingoadm.ExecOptions()
+	scriptPath := utils.RandFilename("/tmp") + ".sh"
+	t.AddStep(&step.InstallFile{
+		HostDestPath: scriptPath,
+		Content:      &scripts.PREPARE_DISK,
+		ExecOptions:  options,
+	})
+	force := dingoadm.MemStorage().Get(comm.KEY_FORCE_DISK) == true
+	for _, disk := range disks {
+		var out string
+		command := fmt.Sprintf("bash %s %s %s %s", scriptPath, mode,
+			utils.ShellQuote(disk.Device), utils.ShellQuote(disk.MountPoint))
+		if mode == DISK_MODE_PREPARE {
+			command = fmt.Sprintf("%s %s %s %t", command,
+				disk.FSType, utils.ShellQuote(disk.MountOptions), force)
+		}
+		t.AddStep(&step.Command{
+			Command:     command,
+			Out:         &out,
+			ExecOptions: options,
+		})
+		t.AddStep(&step.Lambda{
+			Lambda: checkDisk(dingoadm, dc, disk, record, &out),
+		})
+	}
+	t.AddStep(&step.RemoveFile{
+		Files:       []string{scriptPath},
+		ExecOptions: options,
+	})
+
+	return t, nil
+}
+
+// NewCheckDiskTask checks whether disks in service config could be prepared, nothing changed
+func NewCheckDiskTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	return newDiskTask(dingoadm, dc, "Check Disk <disk>", DISK_MODE_STATUS, false)
+}
+
+// NewPrepareDiskTask formats, mounts the disks and writes fstab records by UUID,
+// non-empty disk is refused unless forced
+func NewPrepareDiskTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	return newDiskTask(dingoadm, dc, "Prepare Disk", DISK_MODE_PREPARE, false)
+}
+
+func NewGetDiskStatusTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	return newDiskTask(dingoadm, dc, "Get Disk Status", DISK_MODE_STATUS, true)
+}
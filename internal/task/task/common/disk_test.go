@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDiskStatus(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		out    string
+		expect DiskStatus
+	}{
+		{
+			"state=mounted\nmounted_on=/data\nfs_type=ext4\nuuid=1234\nsize=1073741824\n" +
+				"avail=536870912\nused_percent=50%\nfstab=yes\nmount_point_empty=no\n",
+			DiskStatus{MountedOn: "/data", FSType: "ext4", UUID: "1234", Size: 1073741824,
+				Avail: 536870912, UsedPercent: "50%", InFSTab: true, State: DISK_STATE_MOUNTED},
+		},
+		{
+			"state=empty\nsize=1024\nfstab=no\nmount_point_empty=yes",
+			DiskStatus{Size: 1024, MountPointEmpty: true, State: DISK_STATE_EMPTY},
+		},
+		{
+			"state=mounted-elsewhere\nmounted_on=/mnt/other\nsize=abc\n",
+			DiskStatus{MountedOn: "/mnt/other", State: DISK_STATE_MOUNTED_ELSEWHERE},
+		},
+		{
+			"error=mkfs failed\n",
+			DiskStatus{Error: "mkfs failed"},
+		},
+		{"", DiskStatus{}},
+	}
+	for _, tt := range tests {
+		status := DiskStatus{}
+		parseDiskStatus(&status, tt.out)
+		assert.Equal(tt.expect, status, tt.out)
+	}
+}
+
+func TestCheckDiskStatus(t *testing.T) {
+	assert := assert.New(t)
+	dc := parseHotReloadTopology(t)
+	disk := topology.Disk{Dir: "data_dir", Device: "/dev/sdb", MountPoint: "/data"}
+	limited := disk
+	limited.MinFreeSpace = 1024
+
+	tests := []struct {
+		disk   topology.Disk
+		status DiskStatus
+		force  bool
+		expect *errno.ErrorCode
+	}{
+		// mounted
+		{disk, DiskStatus{State: DISK_STATE_MOUNTED}, false, nil},
+		// empty
+		{disk, DiskStatus{State: DISK_STATE_EMPTY, MountPointEmpty: true}, false, nil},
+		{disk, DiskStatus{State: DISK_STATE_EMPTY}, false, errno.ERR_DISK_MOUNT_POINT_NOT_EMPTY},
+		{disk, DiskStatus{State: DISK_STATE_EMPTY}, true, nil},
+		// not empty
+		{disk, DiskStatus{State: DISK_STATE_NOT_EMPTY}, false, errno.ERR_DISK_NOT_EMPTY},
+		{disk, DiskStatus{State: DISK_STATE_NOT_EMPTY}, true, nil},
+		// mounted elsewhere, force not works
+		{disk, DiskStatus{State: DISK_STATE_MOUNTED_ELSEWHERE}, false, errno.ERR_DISK_MOUNTED_ON_OTHER_DIRECTORY},
+		{disk, DiskStatus{State: DISK_STATE_MOUNTED_ELSEWHERE}, true, errno.ERR_DISK_MOUNTED_ON_OTHER_DIRECTORY},
+		// not block device
+		{disk, DiskStatus{State: DISK_STATE_NOT_BLOCK_DEVICE}, true, errno.ERR_NOT_A_BLOCK_DEVICE},
+		{disk, DiskStatus{Error: DISK_STATE_NOT_BLOCK_DEVICE}, false, errno.ERR_NOT_A_BLOCK_DEVICE},
+		// error reported by disk.sh
+		{disk, DiskStatus{State: DISK_STATE_EMPTY, Error: "mkfs failed"}, true, errno.ERR_PREPARE_DISK_FAILED},
+		// unknown state
+		{disk, DiskStatus{State: "unknown"}, false, errno.ERR_UNRECOGNIZED_DISK_STATUS},
+		{disk, DiskStatus{}, false, errno.ERR_UNRECOGNIZED_DISK_STATUS},
+		// free space: available of mounted disk, size of unmounted disk
+		{limited, DiskStatus{State: DISK_STATE_MOUNTED, Size: 4096, Avail: 1024}, false, nil},
+		{limited, DiskStatus{State: DISK_STATE_MOUNTED, Size: 4096, Avail: 1023}, false, errno.ERR_DISK_INSUFFICIENT_FREE_SPACE},
+		{limited, DiskStatus{State: DISK_STATE_EMPTY, MountPointEmpty: true, Size: 1024, Avail: 0}, false, nil},
+		{limited, DiskStatus{State: DISK_STATE_NOT_EMPTY, Size: 512, Avail: 4096}, true, errno.ERR_DISK_INSUFFICIENT_FREE_SPACE},
+		// free space is not checked for unusable disk
+		{limited, DiskStatus{State: DISK_STATE_NOT_EMPTY, Size: 512}, false, errno.ERR_DISK_NOT_EMPTY},
+	}
+	for i, tt := range tests {
+		err := checkDiskStatus(dc, tt.disk, tt.status, tt.force)
+		if tt.expect == nil {
+			assert.Nil(err, "case %d", i)
+		} else if assert.NotNil(err, "case %d", i) {
+			assert.Equal(tt.expect.GetCode(), err.(*errno.ErrorCode).GetCode(), "case %d", i)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+)
+
+const (
+	EXEC_EXIT_CODE_MARKER = "__DINGOADM_EXIT_CODE__="
+	EXEC_EXIT_CODE_ERROR  = -1 // command not executed, e.g. container not found
+)
+
+type ExecResult struct {
+	Id          string `json:"id"`
+	Role        string `json:"role"`
+	Host        string `json:"host"`
+	ContainerId string `json:"container_id"`
+	ExitCode    int    `json:"exit_code"`
+	Output      string `json:"output"`
+	Error       string `json:"error,omitempty"`
+}
+
+func setExecResult(memStorage *utils.SafeMap, id string, result ExecResult) {
+	memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]ExecResult{}
+		v := kv.Get(comm.KEY_ALL_EXEC_RESULTS)
+		if v != nil {
+			m = v.(map[string]ExecResult)
+		}
+		m[id] = result
+		kv.Set(comm.KEY_ALL_EXEC_RESULTS, m)
+		return nil
+	})
+}
+
+// wrapExecCommand runs command in subshell and appends its exit code into output,
+// so the command is regarded as success by the container engine
+func wrapExecCommand(command string) string {
+	return fmt.Sprintf("sh -c %s", utils.ShellQuote(fmt.Sprintf("(\n%s\n)\necho \"%s$?\"", command, EXEC_EXIT_CODE_MARKER)))
+}
+
+// parseExecOutput splits output of wrapped command into real output and exit code
+func parseExecOutput(out string) (string, int, bool) {
+	idx := strings.LastIndex(out, EXEC_EXIT_CODE_MARKER)
+	if idx < 0 {
+		return out, EXEC_EXIT_CODE_ERROR, false
+	}
+
+	code, err := strconv.Atoi(strings.TrimSpace(out[idx+len(EXEC_EXIT_CODE_MARKER):]))
+	if err != nil {
+		return out, EXEC_EXIT_CODE_ERROR, false
+	}
+	return strings.TrimSuffix(out[:idx], "\n"), code, true
+}
+
+func collectExecResult(dingoadm *cli.DingoAdm, result ExecResult,
+	success *bool, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		output, code, ok := parseExecOutput(*out)
+		result.Output, result.ExitCode = output, code
+		if !*success || !ok {
+			result.Error = *out
+			result.Output = ""
+		}
+		setExecResult(dingoadm.MemStorage(), result.Id, result)
+		return nil
+	}
+}
+
+func NewExecServiceTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingoadm.GetServiceId(dc.GetId())
+	result := ExecResult{
+		Id:       serviceId,
+		Role:     dc.GetRole(),
+		Host:     dc.GetHost(),
+		ExitCode: EXEC_EXIT_CODE_ERROR,
+	}
+	containerId, err := dingoadm.GetContainerId(serviceId)
+	if err != nil {
+		// NOTE: record the error and skip this service, the others go on
+		result.Error = err.Error()
+		setExecResult(dingoadm.MemStorage(), serviceId, result)
+		return nil, nil
+	}
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	result.ContainerId = containerId
+
+	// new task
+	command := dingoadm.MemStorage().Get(comm.KEY_EXEC_COMMAND).(string)
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Exec Command", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var out string
+	var success bool
+	t.AddStep(&step.ContainerExec{
+		ContainerId: &containerId,
+		Command:     wrapExecCommand(command),
+		Success:     &success,
+		Out:         &out,
+		ExecOptions: dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: collectExecResult(dingoadm, result, &success, &out),
+	})
+
+	return t, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapExecCommand(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("sh -c '(\necho hello\n)\necho \"__DINGOADM_EXIT_CODE__=$?\"'",
+		wrapExecCommand("echo hello"))
+
+	tests := []struct {
+		command string
+		output  string
+		code    int
+	}{
+		{"echo hello", "hello", 0},
+		{"printf hello", "hello", 0},
+		{"echo 'it''s'; exit 3", "its", 3},
+		{"echo a; echo b >&2; false", "a", 1},
+		{"cat /not/exist/file 2>/dev/null", "", 1},
+	}
+	for _, tt := range tests {
+		out, err := exec.Command("sh", "-c", wrapExecCommand(tt.command)).Output()
+		assert.Nil(err, tt.command)
+		output, code, ok := parseExecOutput(string(out))
+		assert.True(ok, tt.command)
+		assert.Equal(tt.output, output, tt.command)
+		assert.Equal(tt.code, code, tt.command)
+	}
+}
+
+func TestParseExecOutput(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		out    string
+		output string
+		code   int
+		ok     bool
+	}{
+		{"hello\n__DINGOADM_EXIT_CODE__=0\n", "hello", 0, true},
+		{"hello\n__DINGOADM_EXIT_CODE__=2", "hello", 2, true},
+		{"line1\nline2\n__DINGOADM_EXIT_CODE__=127\n", "line1\nline2", 127, true},
+		// output without trailing newline
+		{"hello__DINGOADM_EXIT_CODE__=0\n", "hello", 0, true},
+		{"__DINGOADM_EXIT_CODE__=1\n", "", 1, true},
+		// marker printed by the command itself, the last one wins
+		{"__DINGOADM_EXIT_CODE__=9\n__DINGOADM_EXIT_CODE__=0\n", "__DINGOADM_EXIT_CODE__=9", 0, true},
+		// missing marker
+		{"hello\n", "hello\n", EXEC_EXIT_CODE_ERROR, false},
+		{"", "", EXEC_EXIT_CODE_ERROR, false},
+		// broken exit code
+		{"hello\n__DINGOADM_EXIT_CODE__=\n", "hello\n__DINGOADM_EXIT_CODE__=\n", EXEC_EXIT_CODE_ERROR, false},
+	}
+	for _, tt := range tests {
+		output, code, ok := parseExecOutput(tt.out)
+		assert.Equal(tt.output, output, tt.out)
+		assert.Equal(tt.code, code, tt.out)
+		assert.Equal(tt.ok, ok, tt.out)
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure/hosts"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/scripts"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	"github.com/dingodb/dingoadm/internal/utils"
+)
+
+func saveHostFacts(dingoadm *cli.DingoAdm, host string, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		facts, err := hosts.ParseHostFacts(host, *out)
+		if err != nil {
+			return err
+		}
+
+		data, err := facts.Encode()
+		if err != nil {
+			return err
+		}
+		err = dingoadm.Storage().ReplaceHostFacts(host, data)
+		if err != nil {
+			return errno.ERR_REPLACE_HOST_FACTS_FAILED.E(err)
+		}
+		return nil
+	}
+}
+
+func NewGatherHostFactsTask(dingoadm *cli.DingoAdm, hc *hosts.HostConfig) (*task.Task, error) {
+	// new task
+	host := hc.GetHost()
+	subname := fmt.Sprintf("host=%s hostname=%s", host, hc.GetHostname())
+	t := task.NewTask("Gather Host Facts", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var out string
+	options := dingoadm.ExecOptions()
+	scriptPath := utils.RandFilename("/tmp") + ".sh"
+	t.AddStep(&step.InstallFile{
+		HostDestPath: scriptPath,
+		Content:      &scripts.HOST_FACTS,
+		ExecOptions:  options,
+	})
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf("bash %s %s", scriptPath, options.ExecWithEngine),
+		Out:         &out,
+		ExecOptions: options,
+	})
+	t.AddStep(&step.RemoveFile{
+		Files:       []string{scriptPath},
+		ExecOptions: options,
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: saveHostFacts(dingoadm, host, &out),
+	})
+
+	return t, nil
+}
@@ -0,0 +1,507 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dingodb/dingoadm/pkg/module"
+)
+
+const (
+	HEALTH_LEVEL_CRITICAL = "critical"
+	HEALTH_LEVEL_WARNING  = "warning"
+
+	HEALTH_ITEM_CONTAINER = "container"
+	HEALTH_ITEM_LIVENESS  = "liveness"
+	HEALTH_ITEM_DISK      = "disk"
+	HEALTH_ITEM_LEADER    = "leader"
+	HEALTH_ITEM_MEMBER    = "member"
+	HEALTH_ITEM_STORE     = "store"
+	HEALTH_ITEM_BALANCE   = "balance"
+	HEALTH_ITEM_CLOCK     = "clock"
+	HEALTH_ITEM_PROBE     = "probe"
+
+	// the probe id of cluster level findings
+	HEALTH_PROBE_CLUSTER = "cluster"
+
+	HEALTH_RESTART_COUNT_WARNING  = 3
+	HEALTH_DISK_USAGE_WARNING     = 80
+	HEALTH_DISK_USAGE_CRITICAL    = 90
+	HEALTH_LEADER_IMBALANCE_RATIO = 1.5
+	HEALTH_MIN_REGIONS_PER_STORE  = 2
+
+	COMMAND_INSPECT_HEALTH = "{{.State.Status}} {{.RestartCount}}"
+	COMMAND_BRPC_HEALTH    = "curl -s --connect-timeout 1 --max-time 3 http://%s:%d/health"
+	COMMAND_TCP_HEALTH     = "timeout 3 bash -c '</dev/tcp/%s/%d' && echo OK"
+	COMMAND_DISK_USAGE     = "for dir in %s; do echo \"@@@ $dir\"; df -P \"$dir\" 2>/dev/null | tail -n +2; done"
+	COMMAND_CLUSTER_HEALTH = "cd %s && echo '@@@ coordinator' && ./dingodb_cli GetCoordinatorMap 2>&1; " +
+		"echo '@@@ store' && ./dingodb_cli GetStoreMap 2>&1; " +
+		"echo '@@@ region' && ./dingodb_cli GetRegionMap 2>&1"
+	BRPC_HEALTH_OK = "OK"
+
+	HEALTH_SECTION_PREFIX        = "@@@ "
+	SIGNATURE_COORDINATOR_LEADER = "leader_location"
+	SIGNATURE_STORE_AVAILABLE    = "DINGODB_HAVE_STORE_AVAILABLE"
+)
+
+var (
+	REGEX_LEADER_STORE_ID       = regexp.MustCompile(`leader_store_id:\s*(\d+)`)
+	REGEX_COORDINATOR_LOCATIONS = regexp.MustCompile(`coordinator_locations\s*\{\s*host:\s*"([^"]+)"\s*port:\s*(\d+)`)
+)
+
+type (
+	HealthFinding struct {
+		Level      string `json:"level"`
+		Item       string `json:"item"`
+		Id         string `json:"id"`
+		Role       string `json:"role"`
+		Host       string `json:"host"`
+		Message    string `json:"message"`
+		Suggestion string `json:"suggestion"`
+	}
+
+	step2ProbeService struct {
+		dc          *topology.DeployConfig
+		serviceId   string
+		containerId string
+		memStorage  *utils.SafeMap
+		execOptions module.ExecOptions
+	}
+
+	diskUsage struct {
+		usage      int // percent
+		mountPoint string
+	}
+
+	step2ProbeCluster struct {
+		dc           *topology.DeployConfig
+		serviceId    string
+		containerId  string
+		coordinators []*topology.DeployConfig
+		nstore       int
+		memStorage   *utils.SafeMap
+		execOptions  module.ExecOptions
+	}
+)
+
+// setHealthFindings records findings of probe, an empty slice means the probe passed
+func setHealthFindings(memStorage *utils.SafeMap, probe string, findings []HealthFinding) {
+	memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string][]HealthFinding{}
+		v := kv.Get(comm.KEY_ALL_HEALTH_FINDINGS)
+		if v != nil {
+			m = v.(map[string][]HealthFinding)
+		}
+		m[probe] = findings
+		kv.Set(comm.KEY_ALL_HEALTH_FINDINGS, m)
+		return nil
+	})
+}
+
+func (s *step2ProbeService) finding(level, item, message, suggestion string) HealthFinding {
+	return HealthFinding{
+		Level:      level,
+		Item:       item,
+		Id:         s.serviceId,
+		Role:       s.dc.GetRole(),
+		Host:       s.dc.GetHost(),
+		Message:    message,
+		Suggestion: suggestion,
+	}
+}
+
+// probeLiveness returns the probed address and whether the service is alive,
+// empty address means the role has no liveness probe
+func (s *step2ProbeService) probeLiveness(ctx *context.Context) (string, bool) {
+	dc := s.dc
+	switch dc.GetRole() {
+	case topology.ROLE_COORDINATOR,
+		topology.ROLE_STORE,
+		topology.ROLE_DINGODB_DOCUMENT,
+		topology.ROLE_DINGODB_INDEX,
+		topology.ROLE_DINGODB_DISKANN:
+	case topology.ROLE_FS_MDS:
+		if dc.GetCtx().Lookup(topology.CTX_KEY_MDS_VERSION) != topology.CTX_VAL_MDS_V2 {
+			return "", false
+		}
+	case topology.ROLE_DINGODB_EXECUTOR:
+		// jdbc port of executor
+		address := fmt.Sprintf("%s:%d", dc.GetListenIp(), dc.GetDingoDBServerPort())
+		command := fmt.Sprintf(COMMAND_TCP_HEALTH, dc.GetListenIp(), dc.GetDingoDBServerPort())
+		out, err := ctx.Module().Shell().Command(command).Execute(s.execOptions)
+		return address, err == nil && strings.TrimSpace(out) == BRPC_HEALTH_OK
+	default:
+		return "", false
+	}
+
+	// brpc builtin health service
+	address := fmt.Sprintf("%s:%d", dc.GetListenIp(), dc.GetDingoServerPort())
+	command := fmt.Sprintf(COMMAND_BRPC_HEALTH, dc.GetListenIp(), dc.GetDingoServerPort())
+	out, err := ctx.Module().DockerCli().ContainerExec(s.containerId, command).Execute(s.execOptions)
+	return address, err == nil && strings.TrimSpace(out) == BRPC_HEALTH_OK
+}
+
+// parseDiskUsage parses output of COMMAND_DISK_USAGE which df runs for each directory
+// in its own section, returns usage percent and mount point of directories, the
+// directory not exist is absent
+func parseDiskUsage(out string) map[string]diskUsage {
+	m := map[string]diskUsage{}
+	for dir, lines := range splitHealthSections(out) {
+		for _, line := range lines {
+			fields := strings.Fields(line)
+			if len(dir) == 0 || len(fields) < 6 {
+				continue
+			}
+			usage, err := strconv.Atoi(strings.TrimSuffix(fields[4], "%"))
+			if err != nil {
+				continue
+			}
+			m[dir] = diskUsage{usage: usage, mountPoint: strings.Join(fields[5:], " ")}
+		}
+	}
+	return m
+}
+
+func (s *step2ProbeService) probeDisk(ctx *context.Context) []HealthFinding {
+	dirs := []string{}
+	for _, dir := range []string{s.dc.GetDataDir(), s.dc.GetDingoRaftDir()} {
+		if len(dir) > 0 && dir != comm.SERVICE_DIR_ABSENT {
+			dirs = append(dirs, dir)
+		}
+	}
+	if len(dirs) == 0 {
+		return nil
+	}
+
+	quoted := []string{}
+	for _, dir := range dirs {
+		quoted = append(quoted, utils.ShellQuote(dir))
+	}
+	command := fmt.Sprintf("bash -c %s", utils.ShellQuote(fmt.Sprintf(COMMAND_DISK_USAGE, strings.Join(quoted, " "))))
+	out, err := ctx.Module().Shell().Command(command).Execute(s.execOptions)
+	if err != nil {
+		return nil
+	}
+
+	findings := []HealthFinding{}
+	usages := parseDiskUsage(out)
+	for _, dir := range dirs {
+		du, ok := usages[dir]
+		if !ok {
+			continue
+		}
+		message := fmt.Sprintf("%s is %d%% full (filesystem %s)", dir, du.usage, du.mountPoint)
+		suggestion := fmt.Sprintf("dingoadm disk status --id %s", s.serviceId)
+		if du.usage >= HEALTH_DISK_USAGE_CRITICAL {
+			findings = append(findings, s.finding(HEALTH_LEVEL_CRITICAL, HEALTH_ITEM_DISK, message, suggestion))
+		} else if du.usage >= HEALTH_DISK_USAGE_WARNING {
+			findings = append(findings, s.finding(HEALTH_LEVEL_WARNING, HEALTH_ITEM_DISK, message, suggestion))
+		}
+	}
+	return findings
+}
+
+func (s *step2ProbeService) Execute(ctx *context.Context) error {
+	findings := []HealthFinding{}
+	defer func() { setHealthFindings(s.memStorage, s.serviceId, findings) }()
+
+	// (1) container state and restart count
+	cli := ctx.Module().DockerCli().InspectContainer(s.containerId)
+	cli.AddOption("--format='%s'", COMMAND_INSPECT_HEALTH)
+	out, err := cli.Execute(s.execOptions)
+	fields := strings.Fields(out)
+	if err != nil || len(fields) != 2 {
+		findings = append(findings, s.finding(HEALTH_LEVEL_CRITICAL, HEALTH_ITEM_CONTAINER,
+			"container not found", "dingoadm status --id "+s.serviceId))
+		return nil
+	}
+
+	state := fields[0]
+	restarts, _ := strconv.Atoi(fields[1])
+	if state == "restarting" {
+		findings = append(findings, s.finding(HEALTH_LEVEL_CRITICAL, HEALTH_ITEM_CONTAINER,
+			fmt.Sprintf("container is in restart loop (restarted %d times)", restarts),
+			"dingoadm enter "+s.serviceId))
+	} else if state != "running" {
+		findings = append(findings, s.finding(HEALTH_LEVEL_CRITICAL, HEALTH_ITEM_CONTAINER,
+			fmt.Sprintf("container is %s", state), "dingoadm start --id "+s.serviceId))
+	} else if restarts >= HEALTH_RESTART_COUNT_WARNING {
+		findings = append(findings, s.finding(HEALTH_LEVEL_WARNING, HEALTH_ITEM_CONTAINER,
+			fmt.Sprintf("container restarted %d times", restarts), "dingoadm enter "+s.serviceId))
+	}
+
+	// (2) service liveness
+	if state == "running" {
+		address, ok := s.probeLiveness(ctx)
+		if len(address) > 0 && !ok {
+			findings = append(findings, s.finding(HEALTH_LEVEL_CRITICAL, HEALTH_ITEM_LIVENESS,
+				fmt.Sprintf("service not responding on %s", address), "dingoadm restart --id "+s.serviceId))
+		}
+	}
+
+	// (3) disk usage of data/raft directory
+	findings = append(findings, s.probeDisk(ctx)...)
+	return nil
+}
+
+// NewProbeServiceHealthTask probes container state, liveness and disk usage of service
+func NewProbeServiceHealthTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingoadm.GetServiceId(dc.GetId())
+	containerId, err := dingoadm.GetContainerId(serviceId)
+	if dingoadm.IsSkip(dc) {
+		return nil, nil
+	} else if err != nil {
+		// NOTE: record the finding and skip this service, the others go on
+		setHealthFindings(dingoadm.MemStorage(), serviceId, []HealthFinding{{
+			Level:      HEALTH_LEVEL_CRITICAL,
+			Item:       HEALTH_ITEM_CONTAINER,
+			Id:         serviceId,
+			Role:       dc.GetRole(),
+			Host:       dc.GetHost(),
+			Message:    "service not deployed",
+			Suggestion: "dingoadm deploy",
+		}})
+		return nil, nil
+	}
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Probe Service Health", subname, hc.GetSSHConfig())
+
+	// add step to task
+	t.AddStep(&step2ProbeService{
+		dc:          dc,
+		serviceId:   serviceId,
+		containerId: containerId,
+		memStorage:  dingoadm.MemStorage(),
+		execOptions: dingoadm.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+func splitHealthSections(out string) map[string][]string {
+	sections := map[string][]string{}
+	name := ""
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, HEALTH_SECTION_PREFIX) {
+			name = strings.TrimSpace(strings.TrimPrefix(line, HEALTH_SECTION_PREFIX))
+			continue
+		}
+		sections[name] = append(sections[name], line)
+	}
+	return sections
+}
+
+// ClusterProbeId returns the probe id which cluster findings queried by coordinator
+// recorded with, the command picks the first coordinator answered
+func ClusterProbeId(serviceId string) string {
+	return fmt.Sprintf("%s@%s", HEALTH_PROBE_CLUSTER, serviceId)
+}
+
+// parseCoordinatorMembers returns address (host:port) of coordinators in coordinator map
+func parseCoordinatorMembers(lines []string) []string {
+	members := []string{}
+	for _, mu := range REGEX_COORDINATOR_LOCATIONS.FindAllStringSubmatch(strings.Join(lines, "\n"), -1) {
+		members = append(members, fmt.Sprintf("%s:%s", mu[1], mu[2]))
+	}
+	return members
+}
+
+// checkCoordinatorMembers compares coordinator map with coordinators in topology,
+// coordinator reports itself by hostname, so both listen ip and hostname are accepted
+func (s *step2ProbeCluster) checkCoordinatorMembers(lines []string) []HealthFinding {
+	members := parseCoordinatorMembers(lines)
+	if len(members) == 0 {
+		return nil
+	}
+
+	findings := []HealthFinding{}
+	found := map[string]bool{}
+	for _, member := range members {
+		found[member] = true
+	}
+	expected := map[string]bool{}
+	for _, dc := range s.coordinators {
+		ip := fmt.Sprintf("%s:%d", dc.GetListenIp(), dc.GetDingoServerPort())
+		hostname := fmt.Sprintf("%s:%d", dc.GetHostname(), dc.GetDingoServerPort())
+		expected[ip], expected[hostname] = true, true
+		if !found[ip] && !found[hostname] {
+			findings = append(findings, HealthFinding{
+				Level:      HEALTH_LEVEL_CRITICAL,
+				Item:       HEALTH_ITEM_MEMBER,
+				Id:         HEALTH_PROBE_CLUSTER,
+				Role:       topology.ROLE_COORDINATOR,
+				Host:       dc.GetHost(),
+				Message:    fmt.Sprintf("coordinator %s not in coordinator map", ip),
+				Suggestion: fmt.Sprintf("dingoadm status --role coordinator --host %s", dc.GetHost()),
+			})
+		}
+	}
+	for _, member := range members {
+		if !expected[member] {
+			findings = append(findings, HealthFinding{
+				Level:      HEALTH_LEVEL_WARNING,
+				Item:       HEALTH_ITEM_MEMBER,
+				Id:         HEALTH_PROBE_CLUSTER,
+				Role:       topology.ROLE_COORDINATOR,
+				Host:       s.dc.GetHost(),
+				Message:    fmt.Sprintf("coordinator %s in coordinator map but not in topology", member),
+				Suggestion: "dingoadm config show",
+			})
+		}
+	}
+	return findings
+}
+
+// checkLeaderBalance returns finding if some store holds too many or no region leaders
+func (s *step2ProbeCluster) checkLeaderBalance(lines []string) *HealthFinding {
+	leaders := map[string]int{}
+	total := 0
+	for _, line := range lines {
+		mu := REGEX_LEADER_STORE_ID.FindStringSubmatch(line)
+		if len(mu) == 2 && mu[1] != "0" {
+			leaders[mu[1]]++
+			total++
+		}
+	}
+	if s.nstore == 0 || total < s.nstore*HEALTH_MIN_REGIONS_PER_STORE {
+		return nil
+	}
+
+	min, max := total, 0
+	maxStore := ""
+	for store, n := range leaders {
+		if n > max {
+			max, maxStore = n, store
+		}
+		if n < min {
+			min = n
+		}
+	}
+	if len(leaders) < s.nstore {
+		min = 0
+	}
+	avg := float64(total) / float64(s.nstore)
+	if float64(max) <= avg*HEALTH_LEADER_IMBALANCE_RATIO && float64(min) >= avg/HEALTH_LEADER_IMBALANCE_RATIO {
+		return nil
+	}
+	return &HealthFinding{
+		Level: HEALTH_LEVEL_WARNING,
+		Item:  HEALTH_ITEM_BALANCE,
+		Id:    HEALTH_PROBE_CLUSTER,
+		Role:  topology.ROLE_STORE,
+		Message: fmt.Sprintf("region leaders are imbalanced: store %s holds %d, min %d, average %.1f (%d regions)",
+			maxStore, max, min, avg, total),
+		Suggestion: "dingoadm store balance",
+	}
+}
+
+func (s *step2ProbeCluster) Execute(ctx *context.Context) error {
+	findings := []HealthFinding{}
+	finding := func(level, item, role, message, suggestion string) HealthFinding {
+		return HealthFinding{
+			Level:      level,
+			Item:       item,
+			Id:         HEALTH_PROBE_CLUSTER,
+			Role:       role,
+			Host:       s.dc.GetHost(),
+			Message:    message,
+			Suggestion: suggestion,
+		}
+	}
+
+	command := fmt.Sprintf(COMMAND_CLUSTER_HEALTH, s.dc.GetProjectLayout().DingoStoreBinDir)
+	out, err := ctx.Module().DockerCli().ContainerExec(s.containerId, fmt.Sprintf("sh -c %s", utils.ShellQuote(command))).
+		Execute(s.execOptions)
+	if err != nil {
+		// NOTE: coordinator not answered records nothing, the next one will be used
+		return nil
+	}
+	defer func() { setHealthFindings(s.memStorage, ClusterProbeId(s.serviceId), findings) }()
+
+	sections := splitHealthSections(out)
+	if !strings.Contains(strings.Join(sections["coordinator"], "\n"), SIGNATURE_COORDINATOR_LEADER) {
+		findings = append(findings, finding(HEALTH_LEVEL_CRITICAL, HEALTH_ITEM_LEADER, topology.ROLE_COORDINATOR,
+			"coordinator has no leader", "dingoadm status --role coordinator"))
+	}
+	findings = append(findings, s.checkCoordinatorMembers(sections["coordinator"])...)
+	if !strings.Contains(strings.Join(sections["store"], "\n"), SIGNATURE_STORE_AVAILABLE) {
+		findings = append(findings, finding(HEALTH_LEVEL_CRITICAL, HEALTH_ITEM_STORE, topology.ROLE_STORE,
+			"no store available in store map", "dingoadm status --role store"))
+	}
+	if f := s.checkLeaderBalance(sections["region"]); f != nil {
+		f.Host = s.dc.GetHost()
+		findings = append(findings, *f)
+	}
+	return nil
+}
+
+// NewProbeClusterHealthTask probes coordinator leader and members, store map and region
+// leader balance by dingodb_cli in coordinator container
+func NewProbeClusterHealthTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingoadm.GetServiceId(dc.GetId())
+	containerId, err := dingoadm.GetContainerId(serviceId)
+	if err != nil {
+		// NOTE: coordinator without container is regarded as not answered
+		return nil, nil
+	}
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Probe Cluster Health", subname, hc.GetSSHConfig())
+
+	// add step to task
+	dcs := dingoadm.MemStorage().Get(comm.KEY_ALL_DEPLOY_CONFIGS).([]*topology.DeployConfig)
+	t.AddStep(&step2ProbeCluster{
+		dc:           dc,
+		serviceId:    serviceId,
+		containerId:  containerId,
+		coordinators: dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_COORDINATOR),
+		nstore:       len(dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_STORE)),
+		memStorage:   dingoadm.MemStorage(),
+		execOptions:  dingoadm.ExecOptions(),
+	})
+
+	return t, nil
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+)
+
+const (
+	DEFAULT_HEALTH_GATE_TIMEOUT = 2 * time.Minute
+	HEALTH_GATE_POLL_INTERVAL   = 3 * time.Second
+)
+
+type step2WaitServiceHealthy struct {
+	probe    *step2ProbeService
+	timeout  time.Duration
+	progress func(string)
+}
+
+// unhealthy returns the first finding which means service not serving,
+// disk usage findings never block the gate
+func (s *step2WaitServiceHealthy) unhealthy() *HealthFinding {
+	v := s.probe.memStorage.Get(comm.KEY_ALL_HEALTH_FINDINGS)
+	if v == nil {
+		return nil
+	}
+	for _, finding := range v.(map[string][]HealthFinding)[s.probe.serviceId] {
+		if finding.Level != HEALTH_LEVEL_CRITICAL {
+			continue
+		} else if finding.Item == HEALTH_ITEM_CONTAINER || finding.Item == HEALTH_ITEM_LIVENESS {
+			return &finding
+		}
+	}
+	return nil
+}
+
+func (s *step2WaitServiceHealthy) Execute(ctx *context.Context) error {
+	start := time.Now()
+	for {
+		if err := s.probe.Execute(ctx); err != nil {
+			return err
+		}
+		finding := s.unhealthy()
+		if finding == nil {
+			return nil
+		} else if time.Since(start) > s.timeout {
+			return errno.ERR_WAIT_SERVICE_HEALTHY_TIMEOUT.
+				F("%s after %s", finding.Message, s.timeout)
+		}
+		s.progress(fmt.Sprintf("[waiting %ds]", int(time.Since(start).Seconds())))
+		time.Sleep(HEALTH_GATE_POLL_INTERVAL)
+	}
+}
+
+func getHealthGateTimeout(dingoadm *cli.DingoAdm) time.Duration {
+	v := dingoadm.MemStorage().Get(comm.KEY_HEALTH_GATE_TIMEOUT)
+	if v == nil || v.(time.Duration) <= 0 {
+		return DEFAULT_HEALTH_GATE_TIMEOUT
+	}
+	return v.(time.Duration)
+}
+
+// NewWaitServiceHealthyTask probes service until its container running and service
+// responding, which used as the gate before services depend on it started
+func NewWaitServiceHealthyTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingoadm.GetServiceId(dc.GetId())
+	containerId, err := dingoadm.GetContainerId(serviceId)
+	if dingoadm.IsSkip(dc) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Wait Service Healthy", subname, hc.GetSSHConfig())
+
+	// add step to task
+	t.AddStep(&step2WaitServiceHealthy{
+		probe: &step2ProbeService{
+			dc:          dc,
+			serviceId:   serviceId,
+			containerId: containerId,
+			memStorage:  dingoadm.MemStorage(),
+			execOptions: dingoadm.ExecOptions(),
+		},
+		timeout:  getHealthGateTimeout(dingoadm),
+		progress: t.SetProgress,
+	})
+
+	return t, nil
+}
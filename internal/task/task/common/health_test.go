@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCoordinatorMembers(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		out    string
+		expect []string
+	}{
+		{
+			`leader_location {
+  host: "10.0.0.1"
+  port: 22001
+}
+coordinator_locations {
+  host: "10.0.0.1"
+  port: 22001
+}
+coordinator_locations {
+  host: "node2"
+  port: 22001
+}`,
+			[]string{"10.0.0.1:22001", "node2:22001"},
+		},
+		{`leader_location { host: "10.0.0.1" port: 22001 }`, []string{}},
+		{"connect to coordinator failed", []string{}},
+		{"", []string{}},
+	}
+
+	for _, t := range tests {
+		assert.Equal(t.expect, parseCoordinatorMembers(strings.Split(t.out, "\n")))
+	}
+}
+
+func TestParseDiskUsage(t *testing.T) {
+	assert := assert.New(t)
+
+	header := "Filesystem     1024-blocks      Used Available Capacity Mounted on\n"
+	tests := []struct {
+		out    string
+		expect map[string]diskUsage
+	}{
+		{
+			"@@@ /data/store\n" +
+				"/dev/nvme0n1    1000000   850000    150000      85% /data\n" +
+				"@@@ /raft/store\n" +
+				"/dev/nvme1n1    1000000   950000     50000      95% /raft\n",
+			map[string]diskUsage{
+				"/data/store": {usage: 85, mountPoint: "/data"},
+				"/raft/store": {usage: 95, mountPoint: "/raft"},
+			},
+		},
+		// the first directory not exist, the second one is not misattributed
+		{
+			"@@@ /data/store\n" +
+				"@@@ /raft/store\n" +
+				"/dev/nvme1n1    1000000   950000     50000      95% /raft\n",
+			map[string]diskUsage{
+				"/raft/store": {usage: 95, mountPoint: "/raft"},
+			},
+		},
+		// mount point with space, and df header is ignored
+		{
+			"@@@ /mnt/my disk/store\n" + header +
+				"/dev/sdb    1000000   100000    900000      10% /mnt/my disk\n",
+			map[string]diskUsage{
+				"/mnt/my disk/store": {usage: 10, mountPoint: "/mnt/my disk"},
+			},
+		},
+		// line out of section
+		{"/dev/sdb    1000000   100000    900000      10% /\n", map[string]diskUsage{}},
+		{"", map[string]diskUsage{}},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.expect, parseDiskUsage(tt.out), tt.out)
+	}
+}
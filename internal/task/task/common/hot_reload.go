@@ -0,0 +1,331 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dingodb/dingoadm/pkg/module"
+)
+
+const (
+	RELOAD_PATH_UNCHANGED = "unchanged"
+	RELOAD_PATH_HOT       = "hot-reload"
+	RELOAD_PATH_RESTART   = "restart"
+
+	// brpc builtin service, only flags which have validator can be set at runtime
+	URL_SET_GFLAG       = "http://%s:%d/flags/%s?setvalue=%s"
+	COMMAND_SET_GFLAG   = "curl -s -o /dev/null -w '%%{http_code}' --connect-timeout 1 --max-time 3 %s"
+	HTTP_STATUS_CODE_OK = "200"
+
+	MDSV2_EXTRA_CONFIG_PREFIX = "mds_"
+)
+
+type (
+	ConfigChange struct {
+		Key      string `json:"key"`
+		Flag     string `json:"flag,omitempty"`    // empty means not a runtime flag
+		Missing  bool   `json:"missing,omitempty"` // key not found in config file
+		OldValue string `json:"old_value"`
+		NewValue string `json:"new_value"`
+	}
+
+	ReloadResult struct {
+		Id      string         `json:"id"`
+		Role    string         `json:"role"`
+		Host    string         `json:"host"`
+		Path    string         `json:"path"`
+		Changes []ConfigChange `json:"changes"`
+		Reason  string         `json:"reason,omitempty"`
+	}
+
+	step2ApplyFlags struct {
+		dc          *topology.DeployConfig
+		serviceId   string
+		containerId string
+		memStorage  *utils.SafeMap
+		execOptions module.ExecOptions
+	}
+)
+
+func setReloadResult(memStorage *utils.SafeMap, id string, result ReloadResult) {
+	memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]ReloadResult{}
+		v := kv.Get(comm.KEY_ALL_RELOAD_RESULTS)
+		if v != nil {
+			m = v.(map[string]ReloadResult)
+		}
+		m[id] = result
+		kv.Set(comm.KEY_ALL_RELOAD_RESULTS, m)
+		return nil
+	})
+}
+
+func getReloadResult(memStorage *utils.SafeMap, id string) (ReloadResult, bool) {
+	v := memStorage.Get(comm.KEY_ALL_RELOAD_RESULTS)
+	if v == nil {
+		return ReloadResult{}, false
+	}
+	result, ok := v.(map[string]ReloadResult)[id]
+	return result, ok
+}
+
+// SupportHotReload returns whether the service changes gflags at runtime by brpc '/flags'
+func SupportHotReload(dc *topology.DeployConfig) bool {
+	switch dc.GetRole() {
+	case topology.ROLE_COORDINATOR, topology.ROLE_STORE:
+		return true
+	case topology.ROLE_FS_MDS:
+		return dc.GetCtx().Lookup(topology.CTX_KEY_MDS_VERSION) == topology.CTX_VAL_MDS_V2
+	}
+	return false
+}
+
+// getRuntimeFlag returns gflag name of the config file key, empty if it's not a gflag
+func getRuntimeFlag(dc *topology.DeployConfig, fileKey string) string {
+	if dc.GetRole() == topology.ROLE_FS_MDS {
+		return strings.TrimPrefix(fileKey, comm.MDSV2_CONFIG_PREFIX)
+	} else if strings.HasPrefix(fileKey, comm.STORE_GFLAGS_PREFIX) {
+		return strings.TrimPrefix(fileKey, comm.STORE_GFLAGS_PREFIX)
+	}
+	return ""
+}
+
+func parseConfigLines(content, delimiter string) map[string]string {
+	m := map[string]string{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		kv := strings.SplitN(line, delimiter, 2)
+		if len(kv) == 2 {
+			m[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+	return m
+}
+
+// diffServiceConfig compares the service config in topology with the config file
+// in container, which returns the changed keys sorted by key
+func diffServiceConfig(dc *topology.DeployConfig, content string) ([]ConfigChange, error) {
+	delimiter := getConfigDelimiter(dc.GetRole())
+	mutate := NewMutate(dc, delimiter, false)
+	current := parseConfigLines(content, delimiter)
+	fileKeys := map[string]string{} // lower case key: key in config file
+	for key := range current {
+		fileKeys[strings.ToLower(key)] = key
+	}
+
+	changes := []ConfigChange{}
+	for key := range dc.GetServiceConfig() {
+		fileKey := getConfigFileKey(dc, key)
+		if k, ok := fileKeys[strings.ToLower(fileKey)]; ok {
+			fileKey = k
+		}
+		out, err := mutate("", fileKey, "")
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE: sync only replaces keys in config file, except 'mds_' keys appended for mds v2
+		old, ok := current[fileKey]
+		appended := dc.GetRole() == topology.ROLE_FS_MDS && strings.HasPrefix(key, MDSV2_EXTRA_CONFIG_PREFIX)
+		value := strings.TrimSpace(strings.TrimPrefix(out, fileKey+delimiter))
+		if (ok || appended) && old == value {
+			continue
+		}
+		changes = append(changes, ConfigChange{
+			Key:      key,
+			Flag:     getRuntimeFlag(dc, fileKey),
+			Missing:  !ok && !appended,
+			OldValue: old,
+			NewValue: value,
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes, nil
+}
+
+// getReloadPath decides how the changes take effect, changes which can't be
+// set by gflag at runtime need restarting service
+func getReloadPath(changes []ConfigChange) (path, reason string) {
+	if len(changes) == 0 {
+		return RELOAD_PATH_UNCHANGED, ""
+	}
+	for _, change := range changes {
+		if change.Missing {
+			return RELOAD_PATH_RESTART, fmt.Sprintf("%s not found in config file", change.Key)
+		} else if len(change.Flag) == 0 {
+			return RELOAD_PATH_RESTART, fmt.Sprintf("%s is not a runtime flag", change.Key)
+		}
+	}
+	return RELOAD_PATH_HOT, ""
+}
+
+func checkConfigChanges(dc *topology.DeployConfig, serviceId string,
+	memStorage *utils.SafeMap, success *bool, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		result := ReloadResult{
+			Id:   serviceId,
+			Role: dc.GetRole(),
+			Host: dc.GetHost(),
+			Path: RELOAD_PATH_RESTART,
+		}
+		defer func() { setReloadResult(memStorage, serviceId, result) }()
+
+		if !*success {
+			result.Reason = "read config file failed"
+			return nil
+		}
+		changes, err := diffServiceConfig(dc, *out)
+		if err != nil {
+			return err
+		}
+
+		result.Changes = changes
+		result.Path, result.Reason = getReloadPath(changes)
+		return nil
+	}
+}
+
+// NewDiffServiceConfigTask records the changed keys of service config before it synced
+func NewDiffServiceConfigTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingoadm.GetServiceId(dc.GetId())
+	if !SupportHotReload(dc) {
+		setReloadResult(dingoadm.MemStorage(), serviceId, ReloadResult{
+			Id:     serviceId,
+			Role:   dc.GetRole(),
+			Host:   dc.GetHost(),
+			Path:   RELOAD_PATH_RESTART,
+			Reason: "hot reload not supported",
+		})
+		return nil, nil
+	}
+
+	containerId, err := dingoadm.GetContainerId(serviceId)
+	if dingoadm.IsSkip(dc) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Diff Service Config", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var out string
+	var success bool
+	files := []string{}
+	for _, conf := range dc.GetProjectLayout().ServiceConfFiles {
+		files = append(files, strings.TrimSpace(conf.TargetPath))
+	}
+	t.AddStep(&step.ContainerExec{
+		ContainerId: &containerId,
+		Command:     fmt.Sprintf("cat %s", strings.Join(files, " ")),
+		Success:     &success,
+		Out:         &out,
+		ExecOptions: dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: checkConfigChanges(dc, serviceId, dingoadm.MemStorage(), &success, &out),
+	})
+
+	return t, nil
+}
+
+func (s *step2ApplyFlags) Execute(ctx *context.Context) error {
+	result, ok := getReloadResult(s.memStorage, s.serviceId)
+	if !ok || result.Path != RELOAD_PATH_HOT {
+		return nil
+	}
+
+	dc := s.dc
+	for _, change := range result.Changes {
+		u := fmt.Sprintf(URL_SET_GFLAG, dc.GetListenIp(), dc.GetDingoServerPort(),
+			change.Flag, url.QueryEscape(change.NewValue))
+		command := fmt.Sprintf(COMMAND_SET_GFLAG, utils.ShellQuote(u))
+		cmd := ctx.Module().DockerCli().ContainerExec(s.containerId, command)
+		out, err := cmd.Execute(s.execOptions)
+		if err != nil || strings.TrimSpace(out) != HTTP_STATUS_CODE_OK {
+			// NOTE: flag without validator is not reloadable, restart instead
+			result.Path = RELOAD_PATH_RESTART
+			result.Reason = fmt.Sprintf("set flag %s failed", change.Flag)
+			break
+		}
+	}
+	setReloadResult(s.memStorage, s.serviceId, result)
+	return nil
+}
+
+// NewHotReloadConfigTask sets changed gflags by brpc '/flags' endpoint after config synced
+func NewHotReloadConfigTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingoadm.GetServiceId(dc.GetId())
+	result, ok := getReloadResult(dingoadm.MemStorage(), serviceId)
+	if !ok || result.Path != RELOAD_PATH_HOT {
+		return nil, nil
+	}
+
+	containerId, err := dingoadm.GetContainerId(serviceId)
+	if err != nil {
+		return nil, err
+	}
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Hot Reload Config", subname, hc.GetSSHConfig())
+
+	// add step to task
+	t.AddStep(&step2ApplyFlags{
+		dc:          dc,
+		serviceId:   serviceId,
+		containerId: containerId,
+		memStorage:  dingoadm.MemStorage(),
+		execOptions: dingoadm.ExecOptions(),
+	})
+
+	return t, nil
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/stretchr/testify/assert"
+)
+
+const TOPOLOGY_HOT_RELOAD = `
+kind: dingo-store
+global:
+  container_image: dingodatabase/dingo-store:latest
+
+coordinator_services:
+  config:
+    gflags.raft_max_entries: 100
+    region.split_check_size: 1024
+  deploy:
+    - host: host1
+`
+
+func parseHotReloadTopology(t *testing.T) *topology.DeployConfig {
+	ctx := topology.NewContext()
+	ctx.Add("host1", "10.0.0.1")
+	dcs, err := topology.ParseTopology(TOPOLOGY_HOT_RELOAD, ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(dcs))
+	return dcs[0]
+}
+
+func TestDiffServiceConfig(t *testing.T) {
+	assert := assert.New(t)
+	dc := parseHotReloadTopology(t)
+
+	tests := []struct {
+		content string
+		expect  []ConfigChange
+	}{
+		// unchanged
+		{
+			"-raft_max_entries=100\nregion.split_check_size=1024",
+			[]ConfigChange{},
+		},
+		// gflag changed
+		{
+			"# comment\n-raft_max_entries = 50\nregion.split_check_size=1024\n",
+			[]ConfigChange{
+				{Key: "gflags.raft_max_entries", Flag: "raft_max_entries", OldValue: "50", NewValue: "100"},
+			},
+		},
+		// key in config file differs in case
+		{
+			"-raft_max_Entries=50\nregion.split_check_size=1024",
+			[]ConfigChange{
+				{Key: "gflags.raft_max_entries", Flag: "raft_max_Entries", OldValue: "50", NewValue: "100"},
+			},
+		},
+		// not a gflag
+		{
+			"-raft_max_entries=100\nregion.split_check_size=512",
+			[]ConfigChange{
+				{Key: "region.split_check_size", OldValue: "512", NewValue: "1024"},
+			},
+		},
+		// key not found in config file
+		{
+			"region.split_check_size=1024",
+			[]ConfigChange{
+				{Key: "gflags.raft_max_entries", Flag: "raft_max_entries", Missing: true, NewValue: "100"},
+			},
+		},
+		{
+			"",
+			[]ConfigChange{
+				{Key: "gflags.raft_max_entries", Flag: "raft_max_entries", Missing: true, NewValue: "100"},
+				{Key: "region.split_check_size", Missing: true, NewValue: "1024"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		changes, err := diffServiceConfig(dc, tt.content)
+		assert.Nil(err)
+		assert.Equal(tt.expect, changes, tt.content)
+	}
+}
+
+func TestGetReloadPath(t *testing.T) {
+	assert := assert.New(t)
+
+	hot := ConfigChange{Key: "gflags.a", Flag: "a", OldValue: "1", NewValue: "2"}
+	static := ConfigChange{Key: "b", OldValue: "1", NewValue: "2"}
+	missing := ConfigChange{Key: "gflags.c", Flag: "c", Missing: true, NewValue: "2"}
+	tests := []struct {
+		changes []ConfigChange
+		path    string
+		reason  string
+	}{
+		{[]ConfigChange{}, RELOAD_PATH_UNCHANGED, ""},
+		{[]ConfigChange{hot}, RELOAD_PATH_HOT, ""},
+		{[]ConfigChange{hot, static}, RELOAD_PATH_RESTART, "b is not a runtime flag"},
+		{[]ConfigChange{hot, missing}, RELOAD_PATH_RESTART, "gflags.c not found in config file"},
+		{[]ConfigChange{missing, static}, RELOAD_PATH_RESTART, "gflags.c not found in config file"},
+	}
+	for _, tt := range tests {
+		path, reason := getReloadPath(tt.changes)
+		assert.Equal(tt.path, path)
+		assert.Equal(tt.reason, reason)
+	}
+}
@@ -40,63 +40,97 @@ import (
 	"github.com/dingodb/dingoadm/internal/task/step"
 	"github.com/dingodb/dingoadm/internal/task/task"
 	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dingodb/dingoadm/pkg/module"
 )
 
 /*
- * curvebs-v1.2.tar.gz"
- * ├── curvebs.deb
- * └── curvebs.rpm
+ * dingofs-client.tar.gz
+ * └── dingofs-client
+ *     ├── conf
+ *     │   └── client.template.conf
+ *     ├── lib
+ *     └── sbin
+ *         └── dingo-client
  *
- * curvefs-v2.3.tar.gz"
- * ├── curvefs.deb
- * └── curvefs.rpm
+ * the client image has same layout in /dingofs/client, except the
+ * config template which is /dingofs/conf/client.template.conf
  */
 const (
-	URL_CURVEBS_PACKAGE = "https://curveadm.nos-eastchina1.126.net/package/curvebs-v1.2.tar.gz"
-	URL_CURVEFS_PACKAGE = "https://curveadm.nos-eastchina1.126.net/package/curvefs-v2.3.tar.gz"
+	CLIENT_PACKAGE_SOURCE_IMAGE = "image"
+	CLIENT_PACKAGE_SOURCE_HTTP  = "http"
+	CLIENT_PACKAGE_SOURCE_LOCAL = "local"
 
-	PACKAGE_ITEM_NAME_CLIENT_DEB = "client.deb"
-	PACKAGE_ITEM_NAME_CLIENT_RPM = "client.rpm"
+	CLIENT_INSTALL_PREFIX          = "/dingofs/client"
+	CLIENT_BINARY_NAME             = "dingo-client"
+	CLIENT_CONFIG_TEMPLATE_NAME    = "client.template.conf"
+	CLIENT_IMAGE_CONFIG_TEMPLATE   = "/dingofs/conf/client.template.conf"
+	CLIENT_SYSTEMD_UNIT_DIR        = "/etc/systemd/system"
+	FORMAT_CLIENT_SYSTEMD_UNIT     = "dingofs-client-%s.service"
+	FORMAT_CLIENT_INSTALLER_NAME   = "dingofs-client-installer-%s"
+	FORMAT_CLIENT_SYSTEMD_UNIT_DEF = `[Unit]
+Description=DingoFS client of filesystem %s mounted on %s
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+Type=simple
+ExecStartPre=/bin/mkdir -p %s
+ExecStart=%s --flagfile %s %s %s
+ExecStop=/bin/sh -c 'fusermount -u "$$0" || umount -l "$$0"' %s
+Restart=on-failure
+RestartSec=5
+
+[Install]
+WantedBy=multi-user.target
+`
 
 	KIND_CURVEBS = topology.KIND_CURVEBS
 
 	CLIENT_CONFIG_DELIMITER = "="
 )
 
-type step2InstallPackage struct {
-	root     string
-	release  string
-	curveadm *cli.DingoAdm
-}
+type (
+	// InstallClientOptions specifies where the client package comes from:
+	//   image: extract from the client container image (default)
+	//   http(s)://...: download tarball from the mirror on host
+	//   otherwise: tarball on local machine, upload it to host
+	InstallClientOptions struct {
+		Host       string
+		Source     string
+		FSName     string
+		MountPoint string
+		Systemd    bool
+		Insecure   bool // skip verifying certificate of https mirror
+	}
 
-func (s *step2InstallPackage) Execute(ctx *context.Context) error {
-	steps := []task.Step{}
-	curveadm := s.curveadm
-	release := s.release
-	if release == comm.OS_RELEASE_DEBIAN ||
-		release == comm.OS_RELEASE_UBUNTU {
-		steps = append(steps, &step.Dpkg{
-			Install:     path.Join(s.root, PACKAGE_ITEM_NAME_CLIENT_DEB),
-			ExecOptions: curveadm.ExecOptions(),
-		})
-	} else if release == comm.OS_RELEASE_CENTOS {
-		steps = append(steps, &step.Rpm{
-			Hash:        true,
-			Install:     path.Join(s.root, PACKAGE_ITEM_NAME_CLIENT_RPM),
-			NoDeps:      true,
-			Verbose:     true,
-			ExecOptions: curveadm.ExecOptions(),
-		})
-	} else {
-		return errno.ERR_UNSUPPORT_LINUX_OS_REELASE.
-			F("os release: %s", release)
+	step2UploadPackage struct {
+		source string
+		dest   string
 	}
 
-	for _, step := range steps {
-		err := step.Execute(ctx)
-		if err != nil {
-			return errno.ERR_INSTALL_PFSD_PACKAGE_FAILED.E(err)
-		}
+	step2RemoveInstaller struct {
+		containerId *string
+		execOptions module.ExecOptions
+	}
+)
+
+func (s *step2UploadPackage) Execute(ctx *context.Context) error {
+	err := ctx.Module().File().Upload(s.source, s.dest)
+	if err != nil {
+		return errno.ERR_UPLOAD_FILE_TO_REMOTE_BY_SSH_FAILED.E(err)
+	}
+	return nil
+}
+
+func (s *step2RemoveInstaller) Execute(ctx *context.Context) error {
+	if len(*s.containerId) == 0 {
+		return nil
+	}
+	_, err := ctx.Module().DockerCli().RemoveContainer(*s.containerId).
+		AddOption("--force").
+		Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_REMOVE_CONTAINER_FAILED.E(err)
 	}
 	return nil
 }
@@ -134,55 +168,144 @@ func getRelease(curveadm *cli.DingoAdm) string {
 	return v.(string)
 }
 
+func IsHTTPPackageSource(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+// getPackageSourceType returns where the client package comes from, see InstallClientOptions
+func getPackageSourceType(source string) string {
+	if len(source) == 0 || source == CLIENT_PACKAGE_SOURCE_IMAGE {
+		return CLIENT_PACKAGE_SOURCE_IMAGE
+	} else if IsHTTPPackageSource(source) {
+		return CLIENT_PACKAGE_SOURCE_HTTP
+	}
+	return CLIENT_PACKAGE_SOURCE_LOCAL
+}
+
+// systemdQuote quotes argument of command line in systemd unit, which expands
+// specifier '%' and environment variable '$' besides C-style escapes
+func systemdQuote(arg string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "%", "%%", "$", "$$", "\n", `\n`)
+	return `"` + r.Replace(arg) + `"`
+}
+
+// systemdEscape escapes specifier '%' of value in systemd unit which is not command line
+func systemdEscape(value string) string {
+	return strings.NewReplacer("%", "%%", "\n", " ").Replace(value)
+}
+
+// GetClientSystemdUnit returns the systemd unit name of client which mounted on mount point
+func GetClientSystemdUnit(mountPoint string) string {
+	return fmt.Sprintf(FORMAT_CLIENT_SYSTEMD_UNIT, utils.MD5Sum(mountPoint)[:12])
+}
+
+func genClientSystemdUnit(cc *configure.ClientConfig, options InstallClientOptions) string {
+	binary := path.Join(CLIENT_INSTALL_PREFIX, "sbin", CLIENT_BINARY_NAME)
+	config := path.Join(CLIENT_INSTALL_PREFIX, "conf", "client.conf")
+	mdsaddr := cc.GetClusterMDSAddr(configure.FS_TYPE_VKS_V2)
+	mountPoint := systemdQuote(options.MountPoint)
+	return fmt.Sprintf(FORMAT_CLIENT_SYSTEMD_UNIT_DEF,
+		systemdEscape(options.FSName), systemdEscape(options.MountPoint),
+		mountPoint,
+		binary, config, systemdQuote(fmt.Sprintf("mds://%s/%s", mdsaddr, options.FSName)), mountPoint,
+		mountPoint)
+}
+
 func NewInstallClientTask(curveadm *cli.DingoAdm, cc *configure.ClientConfig) (*task.Task, error) {
-	host := curveadm.MemStorage().Get(comm.KEY_CLIENT_HOST).(string)
-	hc, err := curveadm.GetHost(host)
+	options := curveadm.MemStorage().Get(comm.KEY_INSTALL_CLIENT_OPTIONS).(InstallClientOptions)
+	hc, err := curveadm.GetHost(options.Host)
 	if err != nil {
 		return nil, err
 	}
 
 	// new task
-	kind := cc.GetKind()
-	release := getRelease(curveadm)
-	subname := fmt.Sprintf("host=%s release=%s", host, release)
-	name := utils.Choose(kind == KIND_CURVEBS, "CurveBS", "CurveFS")
-	t := task.NewTask(fmt.Sprintf("Install %s Client", name), subname, hc.GetSSHConfig())
+	source := utils.Choose(len(options.Source) > 0, options.Source, CLIENT_PACKAGE_SOURCE_IMAGE)
+	sourceType := getPackageSourceType(source)
+	subname := fmt.Sprintf("host=%s source=%s", options.Host, source)
+	t := task.NewTask("Install DingoFS Client", subname, hc.GetSSHConfig())
 
 	// add step to task
-	var input, output string
+	var input, output, containerId string
 	randStr := utils.RandString(10)
-	tarball := fmt.Sprintf("/tmp/pfsd-%s.tar.gz", randStr)
-	root := fmt.Sprintf("/tmp/pfsd-%s", randStr)
-	url := utils.Choose(kind == KIND_CURVEBS, URL_CURVEBS_PACKAGE, URL_CURVEFS_PACKAGE)
-	name = utils.Choose(kind == KIND_CURVEBS, "curve", "curvefs")
+	tarball := fmt.Sprintf("/tmp/dingofs-client-%s.tar.gz", randStr)
+	root := fmt.Sprintf("/tmp/dingofs-client-%s", randStr)
+	template := path.Join(root, "conf", CLIENT_CONFIG_TEMPLATE_NAME)
 
 	t.AddStep(&step.CreateDirectory{
 		Paths:       []string{root},
 		ExecOptions: curveadm.ExecOptions(),
 	})
-	t.AddStep(&step.Curl{
-		Url:         url,
-		Insecure:    true,
-		Output:      tarball,
-		Silent:      true,
+	// 1) prepare package in root directory
+	if sourceType == CLIENT_PACKAGE_SOURCE_IMAGE {
+		name := fmt.Sprintf(FORMAT_CLIENT_INSTALLER_NAME, randStr)
+		t.AddStep(&step.PullImage{
+			Image:       cc.GetContainerImage(),
+			ExecOptions: curveadm.ExecOptions(),
+		})
+		t.AddStep(&step.CreateContainer{
+			Image:       cc.GetContainerImage(),
+			Entrypoint:  "true",
+			Name:        name,
+			Out:         &containerId,
+			ExecOptions: curveadm.ExecOptions(),
+		})
+		t.AddStep(&step.CopyFromContainer{
+			ContainerId:      name,
+			ContainerSrcPath: CLIENT_INSTALL_PREFIX,
+			HostDestPath:     root,
+			ExcludeParent:    true,
+			ExecOptions:      curveadm.ExecOptions(),
+		})
+		t.AddStep(&step.CopyFromContainer{
+			ContainerId:      name,
+			ContainerSrcPath: CLIENT_IMAGE_CONFIG_TEMPLATE,
+			HostDestPath:     template,
+			ExecOptions:      curveadm.ExecOptions(),
+		})
+		t.AddPostStep(&step2RemoveInstaller{
+			containerId: &containerId,
+			execOptions: curveadm.ExecOptions(),
+		})
+	} else {
+		if sourceType == CLIENT_PACKAGE_SOURCE_HTTP {
+			t.AddStep(&step.Curl{
+				Url:         source,
+				Insecure:    options.Insecure,
+				Output:      tarball,
+				Silent:      true,
+				ExecOptions: curveadm.ExecOptions(),
+			})
+		} else {
+			t.AddStep(&step2UploadPackage{
+				source: source,
+				dest:   tarball,
+			})
+		}
+		t.AddStep(&step.Tar{
+			Archive:         tarball,
+			Directory:       root,
+			Extract:         true,
+			StripComponents: 1,
+			UnGzip:          true,
+			ExecOptions:     curveadm.ExecOptions(),
+		})
+	}
+
+	// 2) install binary and generate config from client.yaml
+	t.AddStep(&step.CreateDirectory{
+		Paths: []string{
+			CLIENT_INSTALL_PREFIX,
+			path.Join(CLIENT_INSTALL_PREFIX, "logs"),
+			path.Join(CLIENT_INSTALL_PREFIX, "data"),
+		},
 		ExecOptions: curveadm.ExecOptions(),
 	})
-	t.AddStep(&step.Tar{
-		Archive:         tarball,
-		Directory:       root,
-		Extract:         true,
-		StripComponents: 1,
-		UnGzip:          true,
-		Verbose:         true,
-		ExecOptions:     curveadm.ExecOptions(),
-	})
-	t.AddStep(&step2InstallPackage{
-		root:     root,
-		release:  release,
-		curveadm: curveadm,
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf("cp -a %s/. %s", root, CLIENT_INSTALL_PREFIX),
+		ExecOptions: curveadm.ExecOptions(),
 	})
 	t.AddStep(&step.ReadFile{
-		HostSrcPath: fmt.Sprintf("/etc/%s/conf/client.conf.template", name),
+		HostSrcPath: template,
 		Content:     &input,
 		ExecOptions: curveadm.ExecOptions(),
 	})
@@ -194,11 +317,31 @@ func NewInstallClientTask(curveadm *cli.DingoAdm, cc *configure.ClientConfig) (*
 	})
 	t.AddStep(&step.InstallFile{
 		Content:      &output,
-		HostDestPath: fmt.Sprintf("/etc/%s/client.conf", name),
+		HostDestPath: path.Join(CLIENT_INSTALL_PREFIX, "conf", "client.conf"),
 		ExecOptions:  curveadm.ExecOptions(),
 	})
+
+	// 3) create systemd unit which mounts filesystem on boot
+	if options.Systemd {
+		unit := genClientSystemdUnit(cc, options)
+		name := GetClientSystemdUnit(options.MountPoint)
+		t.AddStep(&step.InstallFile{
+			Content:      &unit,
+			HostDestPath: path.Join(CLIENT_SYSTEMD_UNIT_DIR, name),
+			ExecOptions:  curveadm.ExecOptions(),
+		})
+		t.AddStep(&step.Command{
+			Command:     "systemctl daemon-reload",
+			ExecOptions: curveadm.ExecOptions(),
+		})
+		t.AddStep(&step.Command{
+			Command:     fmt.Sprintf("systemctl enable --now %s", name),
+			ExecOptions: curveadm.ExecOptions(),
+		})
+	}
 	t.AddPostStep(&step.RemoveFile{
-		Files: []string{tarball, root},
+		Files:       []string{tarball, root},
+		ExecOptions: curveadm.ExecOptions(),
 	})
 
 	return t, nil
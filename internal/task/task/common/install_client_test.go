@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPackageSourceType(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		source string
+		expect string
+	}{
+		{"", CLIENT_PACKAGE_SOURCE_IMAGE},
+		{"image", CLIENT_PACKAGE_SOURCE_IMAGE},
+		{"http://mirror/dingofs.tar.gz", CLIENT_PACKAGE_SOURCE_HTTP},
+		{"https://mirror/dingofs.tar.gz", CLIENT_PACKAGE_SOURCE_HTTP},
+		{"/path/to/dingofs.tar.gz", CLIENT_PACKAGE_SOURCE_LOCAL},
+		{"dingofs.tar.gz", CLIENT_PACKAGE_SOURCE_LOCAL},
+		{"ftp://mirror/dingofs.tar.gz", CLIENT_PACKAGE_SOURCE_LOCAL},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.expect, getPackageSourceType(tt.source), tt.source)
+	}
+}
+
+func TestSystemdQuote(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		arg    string
+		expect string
+	}{
+		{"/mnt/fs1", `"/mnt/fs1"`},
+		{"/mnt/my fs", `"/mnt/my fs"`},
+		{`/mnt/a"b`, `"/mnt/a\"b"`},
+		{`/mnt/a\b`, `"/mnt/a\\b"`},
+		{"/mnt/100%", `"/mnt/100%%"`},
+		{"/mnt/$HOME", `"/mnt/$$HOME"`},
+		{"/mnt/a'b", `"/mnt/a'b"`},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.expect, systemdQuote(tt.arg), tt.arg)
+	}
+}
+
+func TestGenClientSystemdUnit(t *testing.T) {
+	assert := assert.New(t)
+
+	cc, err := configure.ParseClientConfigData(`
+kind: dingofs
+mds.addr: 10.0.0.1:7400,10.0.0.2:7400
+`, configure.FS_TYPE_VKS_V2)
+	assert.Nil(err)
+
+	unit := genClientSystemdUnit(cc, InstallClientOptions{
+		FSName:     "fs1",
+		MountPoint: "/mnt/fs1",
+	})
+	lines := strings.Split(unit, "\n")
+	assert.Contains(lines, "Description=DingoFS client of filesystem fs1 mounted on /mnt/fs1")
+	assert.Contains(lines, `ExecStartPre=/bin/mkdir -p "/mnt/fs1"`)
+	assert.Contains(lines, `ExecStart=/dingofs/client/sbin/dingo-client --flagfile /dingofs/client/conf/client.conf `+
+		`"mds://10.0.0.1:7400,10.0.0.2:7400/fs1" "/mnt/fs1"`)
+	assert.Contains(lines, `ExecStop=/bin/sh -c 'fusermount -u "$$0" || umount -l "$$0"' "/mnt/fs1"`)
+
+	// mount point with space, quote and specifier
+	unit = genClientSystemdUnit(cc, InstallClientOptions{
+		FSName:     "fs1",
+		MountPoint: `/mnt/my "fs" 100%`,
+	})
+	lines = strings.Split(unit, "\n")
+	assert.Contains(lines, `Description=DingoFS client of filesystem fs1 mounted on /mnt/my "fs" 100%%`)
+	assert.Contains(lines, `ExecStartPre=/bin/mkdir -p "/mnt/my \"fs\" 100%%"`)
+	assert.Contains(lines, `ExecStop=/bin/sh -c 'fusermount -u "$$0" || umount -l "$$0"' "/mnt/my \"fs\" 100%%"`)
+}
@@ -0,0 +1,536 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/scripts"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	K8S_TARGET                 = "k8s"
+	K8S_DEFAULT_NAMESPACE      = "default"
+	K8S_DEFAULT_CLUSTER_DOMAIN = "cluster.local"
+
+	K8S_LABEL_NAME       = "app.kubernetes.io/name"
+	K8S_LABEL_COMPONENT  = "app.kubernetes.io/component"
+	K8S_LABEL_INSTANCE   = "app.kubernetes.io/instance"
+	K8S_LABEL_MANAGED_BY = "app.kubernetes.io/managed-by"
+	K8S_LABEL_SERVICE_ID = "dingoadm.io/service-id"
+	K8S_LABEL_HOST       = "dingoadm.io/host"
+
+	K8S_CONFIG_VOLUME          = "dingoadm-config"
+	K8S_RENDERED_CONFIG_VOLUME = "dingoadm-rendered-config"
+	K8S_CONFIG_MOUNT_DIR       = "/dingoadm/config"
+	K8S_RENDERED_CONFIG_DIR    = "/dingoadm/rendered"
+	K8S_CONFIG_OVERRIDES_KEY   = "overrides"
+	K8S_CONFIG_MERGE_SCRIPT    = "merge_config.sh"
+)
+
+type (
+	K8sRenderOptions struct {
+		Namespace     string
+		ClusterDomain string
+	}
+
+	// K8sManifest is all kubernetes objects of one service, written into one file
+	K8sManifest struct {
+		Name    string
+		Objects []interface{}
+	}
+
+	k8sMeta struct {
+		Name      string            `yaml:"name,omitempty"`
+		Namespace string            `yaml:"namespace,omitempty"`
+		Labels    map[string]string `yaml:"labels,omitempty"`
+	}
+
+	k8sConfigMap struct {
+		APIVersion string            `yaml:"apiVersion"`
+		Kind       string            `yaml:"kind"`
+		Metadata   k8sMeta           `yaml:"metadata"`
+		Data       map[string]string `yaml:"data"`
+	}
+
+	k8sServicePort struct {
+		Name       string `yaml:"name"`
+		Port       int    `yaml:"port"`
+		TargetPort int    `yaml:"targetPort"`
+	}
+
+	k8sServiceSpec struct {
+		ClusterIP                string            `yaml:"clusterIP"`
+		PublishNotReadyAddresses bool              `yaml:"publishNotReadyAddresses"`
+		Selector                 map[string]string `yaml:"selector"`
+		Ports                    []k8sServicePort  `yaml:"ports,omitempty"`
+	}
+
+	k8sService struct {
+		APIVersion string         `yaml:"apiVersion"`
+		Kind       string         `yaml:"kind"`
+		Metadata   k8sMeta        `yaml:"metadata"`
+		Spec       k8sServiceSpec `yaml:"spec"`
+	}
+
+	k8sEnv struct {
+		Name  string `yaml:"name"`
+		Value string `yaml:"value"`
+	}
+
+	k8sContainerPort struct {
+		Name          string `yaml:"name"`
+		ContainerPort int    `yaml:"containerPort"`
+	}
+
+	k8sVolumeMount struct {
+		Name      string `yaml:"name"`
+		MountPath string `yaml:"mountPath"`
+		SubPath   string `yaml:"subPath,omitempty"`
+		ReadOnly  bool   `yaml:"readOnly,omitempty"`
+	}
+
+	k8sSecurityContext struct {
+		Privileged bool `yaml:"privileged"`
+	}
+
+	k8sContainer struct {
+		Name            string              `yaml:"name"`
+		Image           string              `yaml:"image"`
+		Command         []string            `yaml:"command,omitempty"`
+		Args            []string            `yaml:"args,omitempty"`
+		Env             []k8sEnv            `yaml:"env,omitempty"`
+		Ports           []k8sContainerPort  `yaml:"ports,omitempty"`
+		VolumeMounts    []k8sVolumeMount    `yaml:"volumeMounts,omitempty"`
+		SecurityContext *k8sSecurityContext `yaml:"securityContext,omitempty"`
+	}
+
+	k8sHostPath struct {
+		Path string `yaml:"path"`
+		Type string `yaml:"type"`
+	}
+
+	k8sConfigMapSource struct {
+		Name string `yaml:"name"`
+	}
+
+	k8sEmptyDir struct{}
+
+	k8sVolume struct {
+		Name      string              `yaml:"name"`
+		HostPath  *k8sHostPath        `yaml:"hostPath,omitempty"`
+		ConfigMap *k8sConfigMapSource `yaml:"configMap,omitempty"`
+		EmptyDir  *k8sEmptyDir        `yaml:"emptyDir,omitempty"`
+	}
+
+	k8sPodSpec struct {
+		NodeSelector   map[string]string `yaml:"nodeSelector,omitempty"`
+		InitContainers []k8sContainer    `yaml:"initContainers,omitempty"`
+		Containers     []k8sContainer    `yaml:"containers"`
+		Volumes        []k8sVolume       `yaml:"volumes,omitempty"`
+	}
+
+	k8sPodTemplate struct {
+		Metadata k8sMeta    `yaml:"metadata"`
+		Spec     k8sPodSpec `yaml:"spec"`
+	}
+
+	k8sLabelSelector struct {
+		MatchLabels map[string]string `yaml:"matchLabels"`
+	}
+
+	k8sStatefulSetSpec struct {
+		ServiceName         string           `yaml:"serviceName"`
+		Replicas            int              `yaml:"replicas"`
+		PodManagementPolicy string           `yaml:"podManagementPolicy"`
+		Selector            k8sLabelSelector `yaml:"selector"`
+		Template            k8sPodTemplate   `yaml:"template"`
+	}
+
+	k8sStatefulSet struct {
+		APIVersion string             `yaml:"apiVersion"`
+		Kind       string             `yaml:"kind"`
+		Metadata   k8sMeta            `yaml:"metadata"`
+		Spec       k8sStatefulSetSpec `yaml:"spec"`
+	}
+
+	k8sRenderer struct {
+		dingoadm *cli.DingoAdm
+		options  K8sRenderOptions
+		names    map[string]string // dc id -> statefulset name
+		dns      map[string]string // dc id -> stable dns name
+		replacer *strings.Replacer // replace ip:port with dns:port
+	}
+)
+
+var (
+	// envs whose value is the address which other services connect to
+	k8sHostEnvs = map[string]bool{
+		ENV_DINGO_SERVER_HOST:              true,
+		ENV_DINGOSTORE_RAFT_HOST:           true,
+		ENV_DINGODB_EXECUTOR_HOSTNAME:      true,
+		ENV_DINGOSTORE_DISKANN_SERVER_HOST: true,
+	}
+)
+
+func getK8sServicePorts(dc *topology.DeployConfig) []k8sServicePort {
+	ports := []k8sServicePort{}
+	add := func(name string, port int) {
+		if port > 0 {
+			ports = append(ports, k8sServicePort{Name: name, Port: port, TargetPort: port})
+		}
+	}
+
+	switch dc.GetRole() {
+	case topology.ROLE_COORDINATOR,
+		topology.ROLE_STORE,
+		topology.ROLE_DINGODB_DOCUMENT,
+		topology.ROLE_DINGODB_INDEX:
+		add("server", dc.GetDingoServerPort())
+		add("raft", dc.GetDingoStoreRaftPort())
+	case topology.ROLE_DINGODB_DISKANN:
+		add("server", dc.GetDingoServerPort())
+	case topology.ROLE_FS_MDS:
+		if dc.GetCtx().Lookup(topology.CTX_KEY_MDS_VERSION) == topology.CTX_VAL_MDS_V2 {
+			add("server", dc.GetDingoServerPort())
+		} else {
+			add("server", dc.GetListenPort())
+			add("dummy", dc.GetListenDummyPort())
+		}
+	case topology.ROLE_DINGODB_EXECUTOR:
+		add("server", dc.GetDingoDBServerPort())
+		add("mysql", dc.GetDingoDBMySQLPort())
+		add("metrics", dc.GetDingoDBMetricsPort())
+	case topology.ROLE_DINGODB_WEB:
+		add("server", dc.GetDingoDBServerPort())
+		add("export", dc.GetDingoDBExportPort())
+	case topology.ROLE_DINGODB_PROXY:
+		add("server", dc.GetDingoDBServerPort())
+	case topology.ROLE_ETCD:
+		add("peer", dc.GetListenPort())
+		add("client", dc.GetListenClientPort())
+	default:
+		add("server", dc.GetListenPort())
+	}
+	return ports
+}
+
+// splitArguments splits container command like `--role mds --args='-a=1 -b=2'`,
+// the single quotes only group words as shell does.
+func splitArguments(cmd string) []string {
+	args := []string{}
+	var current strings.Builder
+	inQuote, hasWord := false, false
+	for _, c := range cmd {
+		switch {
+		case c == '\'':
+			inQuote = !inQuote
+			hasWord = true
+		case c == ' ' && !inQuote:
+			if hasWord {
+				args = append(args, current.String())
+				current.Reset()
+				hasWord = false
+			}
+		default:
+			current.WriteRune(c)
+			hasWord = true
+		}
+	}
+	if hasWord {
+		args = append(args, current.String())
+	}
+	return args
+}
+
+func newK8sRenderer(dingoadm *cli.DingoAdm, dcs []*topology.DeployConfig,
+	options K8sRenderOptions) *k8sRenderer {
+	r := &k8sRenderer{
+		dingoadm: dingoadm,
+		options:  options,
+		names:    map[string]string{},
+		dns:      map[string]string{},
+	}
+
+	pairs := map[string]string{}
+	for _, dc := range dcs {
+		serviceId := dingoadm.GetServiceId(dc.GetId())
+		name := fmt.Sprintf("%s-%s-%s", dc.GetKind(), dc.GetRole(), serviceId)
+		dns := fmt.Sprintf("%s-0.%s.%s.svc.%s", name, name, options.Namespace, options.ClusterDomain)
+		r.names[dc.GetId()] = name
+		r.dns[dc.GetId()] = dns
+		for _, port := range getK8sServicePorts(dc) {
+			pairs[fmt.Sprintf("%s:%d", dc.GetListenIp(), port.Port)] = fmt.Sprintf("%s:%d", dns, port.Port)
+			if dc.GetHostname() != dc.GetListenIp() {
+				pairs[fmt.Sprintf("%s:%d", dc.GetHostname(), port.Port)] = fmt.Sprintf("%s:%d", dns, port.Port)
+			}
+		}
+	}
+
+	// longer address first, avoid "ip:650" matching "ip:6500"
+	olds := []string{}
+	for old := range pairs {
+		olds = append(olds, old)
+	}
+	sort.Slice(olds, func(i, j int) bool {
+		if len(olds[i]) != len(olds[j]) {
+			return len(olds[i]) > len(olds[j])
+		}
+		return olds[i] < olds[j]
+	})
+	oldnew := []string{}
+	for _, old := range olds {
+		oldnew = append(oldnew, old, pairs[old])
+	}
+	r.replacer = strings.NewReplacer(oldnew...)
+	return r
+}
+
+func (r *k8sRenderer) labels(dc *topology.DeployConfig) map[string]string {
+	return map[string]string{
+		K8S_LABEL_NAME:       dc.GetKind(),
+		K8S_LABEL_COMPONENT:  dc.GetRole(),
+		K8S_LABEL_INSTANCE:   r.dingoadm.ClusterName(),
+		K8S_LABEL_MANAGED_BY: "dingoadm",
+		K8S_LABEL_SERVICE_ID: r.dingoadm.GetServiceId(dc.GetId()),
+	}
+}
+
+func (r *k8sRenderer) meta(dc *topology.DeployConfig, name string) k8sMeta {
+	return k8sMeta{
+		Name:      name,
+		Namespace: r.options.Namespace,
+		Labels:    r.labels(dc),
+	}
+}
+
+// envs reuse the container environments, addresses are replaced by stable dns names
+func (r *k8sRenderer) envs(dc *topology.DeployConfig) []k8sEnv {
+	envs := []k8sEnv{}
+	index := map[string]int{}
+	for _, item := range GetEnvironments(dc) {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		name, value := kv[0], r.replacer.Replace(kv[1])
+		if k8sHostEnvs[name] {
+			value = r.dns[dc.GetId()]
+		}
+		if i, ok := index[name]; ok { // the latter wins, as docker does
+			envs[i].Value = value
+			continue
+		}
+		index[name] = len(envs)
+		envs = append(envs, k8sEnv{Name: name, Value: value})
+	}
+	return envs
+}
+
+// overrides renders the topology service config by NewMutate,
+// which merged into config templates by init container
+func (r *k8sRenderer) overrides(dc *topology.DeployConfig) (string, error) {
+	serviceConfig := dc.GetServiceConfig()
+	keys := []string{}
+	for key := range serviceConfig {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	delimiter := getConfigDelimiter(dc.GetRole())
+	mutate := NewMutate(dc, delimiter, false)
+	lines := []string{}
+	for _, key := range keys {
+		out, err := mutate("", getConfigFileKey(dc, key), "")
+		if err != nil {
+			return "", errno.ERR_RENDER_K8S_MANIFEST_FAILED.E(err)
+		}
+		lines = append(lines, r.replacer.Replace(out))
+	}
+	if len(lines) == 0 {
+		return "", nil
+	}
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+func (r *k8sRenderer) render(dc *topology.DeployConfig) (K8sManifest, error) {
+	name := r.names[dc.GetId()]
+	labels := r.labels(dc)
+	selector := map[string]string{K8S_LABEL_SERVICE_ID: labels[K8S_LABEL_SERVICE_ID]}
+	manifest := K8sManifest{Name: name}
+
+	// 1) service: headless, provides stable dns name for peers
+	ports := getK8sServicePorts(dc)
+	manifest.Objects = append(manifest.Objects, k8sService{
+		APIVersion: "v1",
+		Kind:       "Service",
+		Metadata:   r.meta(dc, name),
+		Spec: k8sServiceSpec{
+			ClusterIP:                "None",
+			PublishNotReadyAddresses: true,
+			Selector:                 selector,
+			Ports:                    ports,
+		},
+	})
+
+	// 2) container: image, command, envs and volumes same as `deploy`
+	container := k8sContainer{
+		Name:            dc.GetRole(),
+		Image:           dc.GetContainerImage(),
+		Args:            splitArguments(getContainerCMD(dc)),
+		Env:             r.envs(dc),
+		SecurityContext: &k8sSecurityContext{Privileged: true},
+	}
+	for _, port := range ports {
+		container.Ports = append(container.Ports, k8sContainerPort{
+			Name:          port.Name,
+			ContainerPort: port.Port,
+		})
+	}
+	podSpec := k8sPodSpec{
+		NodeSelector: map[string]string{K8S_LABEL_HOST: dc.GetHost()},
+	}
+	for i, volume := range getMountVolumes(dc) {
+		volumeName := fmt.Sprintf("volume-%d", i)
+		podSpec.Volumes = append(podSpec.Volumes, k8sVolume{
+			Name:     volumeName,
+			HostPath: &k8sHostPath{Path: volume.HostPath, Type: "DirectoryOrCreate"},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, k8sVolumeMount{
+			Name:      volumeName,
+			MountPath: volume.ContainerPath,
+		})
+	}
+
+	// 3) configmap: service config overrides, merged into templates by init container
+	overrides, err := r.overrides(dc)
+	if err != nil {
+		return manifest, err
+	}
+	confFiles := dc.GetProjectLayout().ServiceConfFiles
+	if len(overrides) > 0 && len(confFiles) > 0 {
+		configMapName := fmt.Sprintf("%s-config", name)
+		manifest.Objects = append(manifest.Objects, k8sConfigMap{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Metadata:   r.meta(dc, configMapName),
+			Data: map[string]string{
+				K8S_CONFIG_OVERRIDES_KEY: overrides,
+				K8S_CONFIG_MERGE_SCRIPT:  scripts.MERGE_CONFIG,
+			},
+		})
+
+		delimiter := getConfigDelimiter(dc.GetRole())
+		commands := []string{}
+		for _, conf := range confFiles {
+			commands = append(commands, fmt.Sprintf("bash %s/%s %s %s/%s %s %s/%s",
+				K8S_CONFIG_MOUNT_DIR, K8S_CONFIG_MERGE_SCRIPT, utils.ShellQuote(delimiter),
+				K8S_CONFIG_MOUNT_DIR, K8S_CONFIG_OVERRIDES_KEY, conf.SourcePath,
+				K8S_RENDERED_CONFIG_DIR, conf.Name))
+			container.VolumeMounts = append(container.VolumeMounts, k8sVolumeMount{
+				Name:      K8S_RENDERED_CONFIG_VOLUME,
+				MountPath: conf.TargetPath,
+				SubPath:   conf.Name,
+			})
+		}
+		podSpec.InitContainers = append(podSpec.InitContainers, k8sContainer{
+			Name:    "sync-config",
+			Image:   dc.GetContainerImage(),
+			Command: []string{"/bin/bash", "-c", strings.Join(commands, " && ")},
+			VolumeMounts: []k8sVolumeMount{
+				{Name: K8S_CONFIG_VOLUME, MountPath: K8S_CONFIG_MOUNT_DIR, ReadOnly: true},
+				{Name: K8S_RENDERED_CONFIG_VOLUME, MountPath: K8S_RENDERED_CONFIG_DIR},
+			},
+		})
+		podSpec.Volumes = append(podSpec.Volumes,
+			k8sVolume{Name: K8S_CONFIG_VOLUME, ConfigMap: &k8sConfigMapSource{Name: configMapName}},
+			k8sVolume{Name: K8S_RENDERED_CONFIG_VOLUME, EmptyDir: &k8sEmptyDir{}})
+	}
+	podSpec.Containers = []k8sContainer{container}
+
+	// 4) statefulset: one replica per service, pod name "<name>-0"
+	manifest.Objects = append(manifest.Objects, k8sStatefulSet{
+		APIVersion: "apps/v1",
+		Kind:       "StatefulSet",
+		Metadata:   r.meta(dc, name),
+		Spec: k8sStatefulSetSpec{
+			ServiceName:         name,
+			Replicas:            1,
+			PodManagementPolicy: "Parallel",
+			Selector:            k8sLabelSelector{MatchLabels: selector},
+			Template: k8sPodTemplate{
+				Metadata: k8sMeta{Labels: labels},
+				Spec:     podSpec,
+			},
+		},
+	})
+	return manifest, nil
+}
+
+// RenderK8sManifests converts deploy configs into kubernetes StatefulSets/Services/ConfigMaps
+func RenderK8sManifests(dingoadm *cli.DingoAdm, dcs []*topology.DeployConfig,
+	options K8sRenderOptions) ([]K8sManifest, error) {
+	services := []*topology.DeployConfig{}
+	for _, dc := range dcs {
+		if dc.GetKind() == topology.KIND_CURVEBS {
+			return nil, errno.ERR_RENDER_K8S_MANIFEST_FAILED.F("unsupport cluster kind: %s", dc.GetKind())
+		} else if dc.GetRole() == topology.ROLE_FS_MDS_CLI {
+			continue // tool container, not a service
+		}
+		services = append(services, dc)
+	}
+
+	r := newK8sRenderer(dingoadm, services, options)
+	manifests := []K8sManifest{}
+	for _, dc := range services {
+		manifest, err := r.render(dc)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, manifest)
+	}
+	return manifests, nil
+}
+
+// Encode encodes all objects into multi-document YAML
+func (m K8sManifest) Encode() (string, error) {
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	for _, object := range m.Objects {
+		if err := encoder.Encode(object); err != nil {
+			return "", errno.ERR_RENDER_K8S_MANIFEST_FAILED.E(err)
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return "", errno.ERR_RENDER_K8S_MANIFEST_FAILED.E(err)
+	}
+	return buffer.String(), nil
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/stretchr/testify/assert"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files of k8s manifests")
+
+const (
+	TOPOLOGY_K8S_STORE = `
+kind: dingo-store
+global:
+  container_image: dingodatabase/dingo-store:latest
+  log_dir: /tmp/dingo-store/logs/${service_role}
+  data_dir: /tmp/dingo-store/data/${service_role}
+
+coordinator_services:
+  config:
+    server.port: 6500
+    raft.port: 7500
+  deploy:
+    - host: host1
+
+store_services:
+  config:
+    server.port: 6600
+    raft.port: 7600
+  deploy:
+    - host: host1
+`
+
+	TOPOLOGY_K8S_MDSV2 = `
+kind: dingofs
+global:
+  container_image: dingodatabase/dingofs:mdsv2-latest
+  log_dir: /tmp/dingofs/logs/${service_role}
+
+mds_services:
+  config:
+    server.port: 6900
+    heartbeat_interval_s: 10
+  deploy:
+    - host: host1
+`
+)
+
+func TestRenderK8sManifests(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name     string
+		topology string
+	}{
+		{"store", TOPOLOGY_K8S_STORE},
+		{"mdsv2", TOPOLOGY_K8S_MDSV2},
+	}
+
+	for _, tt := range tests {
+		ctx := topology.NewContext()
+		ctx.Add("host1", "10.0.0.1")
+		dcs, err := topology.ParseTopology(tt.topology, ctx)
+		assert.Nil(err)
+
+		manifests, err := RenderK8sManifests(&cli.DingoAdm{}, dcs, K8sRenderOptions{
+			Namespace:     K8S_DEFAULT_NAMESPACE,
+			ClusterDomain: K8S_DEFAULT_CLUSTER_DOMAIN,
+		})
+		assert.Nil(err)
+
+		out := ""
+		for _, manifest := range manifests {
+			content, err := manifest.Encode()
+			assert.Nil(err)
+			out += "# " + manifest.Name + "\n---\n" + content
+		}
+
+		golden := filepath.Join("testdata", "k8s_"+tt.name+".golden")
+		if *updateGolden {
+			assert.Nil(os.WriteFile(golden, []byte(out), 0644))
+		}
+		expect, err := os.ReadFile(golden)
+		assert.Nil(err)
+		assert.Equal(string(expect), out, tt.name)
+	}
+}
@@ -0,0 +1,354 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dingodb/dingoadm/pkg/module"
+)
+
+const (
+	COMMAND_GET_REGION_MAP  = "cd %s && ./dingodb_cli GetRegionMap 2>&1"
+	COMMAND_TRANSFER_LEADER = "cd %s && ./dingodb_cli TransferLeaderRegion --id=%d --store_id=%d 2>&1"
+
+	DEFAULT_STORE_LEADER_TIMEOUT = 5 * time.Minute
+	STORE_LEADER_POLL_INTERVAL   = 3 * time.Second
+
+	STORE_LEADER_MODE_TRANSFER = "transfer"
+	STORE_LEADER_MODE_BALANCE  = "balance"
+)
+
+var (
+	// e.g. 'id: 80001', 'store_id: 33001', 'leader_store_id: 33001'
+	REGEX_REGION_FIELD = regexp.MustCompile(`^(id|store_id|leader_store_id):\s*(\d+)`)
+)
+
+type (
+	Region struct {
+		Id     int
+		Leader int
+		Peers  []int
+	}
+
+	// LeaderMove transfers leader of region to store
+	LeaderMove struct {
+		Region int
+		From   int
+		To     int
+	}
+
+	step2WaitStoreLeader struct {
+		mode        string
+		storeId     int   // store which leaders transferred from, only for transfer mode
+		stores      []int // stores which take part in balance
+		excludes    []int // stores never be the target of transfer
+		binDir      string
+		containerId string
+		timeout     time.Duration
+		progress    func(string)
+		execOptions module.ExecOptions
+	}
+)
+
+// ParseRegionMap parses regions from the output of 'dingodb_cli GetRegionMap',
+// which is protobuf text format like:
+//
+//	regions {
+//	  id: 80001
+//	  definition {
+//	    peers {
+//	      store_id: 33001
+//	    }
+//	  }
+//	  leader_store_id: 33001
+//	}
+func ParseRegionMap(out string) []Region {
+	regions := []Region{}
+	stack := []string{}
+	var region *Region
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasSuffix(line, "{"):
+			name := strings.TrimSpace(strings.TrimSuffix(line, "{"))
+			stack = append(stack, name)
+			if name == "regions" {
+				region = &Region{}
+			}
+		case line == "}":
+			if len(stack) == 0 {
+				continue
+			}
+			if stack[len(stack)-1] == "regions" && region != nil {
+				regions = append(regions, *region)
+				region = nil
+			}
+			stack = stack[:len(stack)-1]
+		case region != nil:
+			mu := REGEX_REGION_FIELD.FindStringSubmatch(line)
+			if len(mu) != 3 {
+				continue
+			}
+			n, _ := strconv.Atoi(mu[2])
+			parent := stack[len(stack)-1]
+			if mu[1] == "id" && parent == "regions" {
+				region.Id = n
+			} else if mu[1] == "leader_store_id" && parent == "regions" {
+				region.Leader = n
+			} else if mu[1] == "store_id" && parent == "peers" {
+				region.Peers = append(region.Peers, n)
+			}
+		}
+	}
+	return regions
+}
+
+func countLeaders(regions []Region) map[int]int {
+	leaders := map[int]int{}
+	for _, region := range regions {
+		if region.Leader != 0 {
+			leaders[region.Leader]++
+		}
+	}
+	return leaders
+}
+
+func containsStore(stores []int, store int) bool {
+	for _, s := range stores {
+		if s == store {
+			return true
+		}
+	}
+	return false
+}
+
+// PlanLeaderTransfer moves all leaders of the store to its peer which holds the fewest leaders,
+// the region which has no available peer is returned separately
+func PlanLeaderTransfer(regions []Region, storeId int, excludes []int) ([]LeaderMove, []int) {
+	leaders := countLeaders(regions)
+	moves := []LeaderMove{}
+	stuck := []int{}
+	for _, region := range regions {
+		if region.Leader != storeId {
+			continue
+		}
+		target := 0
+		for _, peer := range region.Peers {
+			if peer == storeId || containsStore(excludes, peer) {
+				continue
+			} else if target == 0 || leaders[peer] < leaders[target] {
+				target = peer
+			}
+		}
+		if target == 0 {
+			stuck = append(stuck, region.Id)
+			continue
+		}
+		leaders[target]++
+		moves = append(moves, LeaderMove{Region: region.Id, From: storeId, To: target})
+	}
+	return moves, stuck
+}
+
+// PlanLeaderBalance moves leaders from the store above the average to its peer below it,
+// until no more move makes the leader distribution of stores more even
+func PlanLeaderBalance(regions []Region, stores []int) []LeaderMove {
+	leaders := countLeaders(regions)
+	total := 0
+	for _, store := range stores {
+		total += leaders[store]
+	}
+	if len(stores) == 0 || total == 0 {
+		return nil
+	}
+	upper := (total + len(stores) - 1) / len(stores)
+
+	sort.Slice(regions, func(i, j int) bool { return regions[i].Id < regions[j].Id })
+	moves := []LeaderMove{}
+	for _, region := range regions {
+		from := region.Leader
+		if !containsStore(stores, from) || leaders[from] <= upper {
+			continue
+		}
+		target := 0
+		for _, peer := range region.Peers {
+			if peer == from || !containsStore(stores, peer) {
+				continue
+			} else if target == 0 || leaders[peer] < leaders[target] {
+				target = peer
+			}
+		}
+		if target == 0 || leaders[target]+1 > upper {
+			continue
+		}
+		leaders[from]--
+		leaders[target]++
+		moves = append(moves, LeaderMove{Region: region.Id, From: from, To: target})
+	}
+	return moves
+}
+
+func (s *step2WaitStoreLeader) exec(ctx *context.Context, command string) (string, error) {
+	cmd := ctx.Module().DockerCli().ContainerExec(s.containerId, fmt.Sprintf("sh -c %s", utils.ShellQuote(command)))
+	return cmd.Execute(s.execOptions)
+}
+
+func (s *step2WaitStoreLeader) plan(ctx *context.Context) ([]LeaderMove, error) {
+	out, err := s.exec(ctx, fmt.Sprintf(COMMAND_GET_REGION_MAP, s.binDir))
+	if err != nil {
+		return nil, errno.ERR_QUERY_REGION_MAP_FAILED.E(err)
+	}
+
+	regions := ParseRegionMap(out)
+	if s.mode == STORE_LEADER_MODE_BALANCE {
+		return PlanLeaderBalance(regions, s.stores), nil
+	}
+	moves, stuck := PlanLeaderTransfer(regions, s.storeId, s.excludes)
+	if len(stuck) > 0 {
+		return nil, errno.ERR_NO_STORE_TO_TRANSFER_LEADER.
+			F("store %d, regions %v", s.storeId, stuck)
+	}
+	return moves, nil
+}
+
+// Execute transfers leaders and polls the region map until leaders converged,
+// the transfer which not take effect will be issued again in next round
+func (s *step2WaitStoreLeader) Execute(ctx *context.Context) error {
+	deadline := time.Now().Add(s.timeout)
+	total := -1
+	for {
+		moves, err := s.plan(ctx)
+		if err != nil {
+			return err
+		}
+		if total < 0 {
+			total = len(moves)
+		}
+		s.progress(fmt.Sprintf("[leaders %d/%d]", max(total-len(moves), 0), total))
+		if len(moves) == 0 {
+			return nil
+		} else if time.Now().After(deadline) {
+			return errno.ERR_WAIT_STORE_LEADER_TIMEOUT.
+				F("%d leaders not moved after %s", len(moves), s.timeout)
+		}
+
+		for _, move := range moves {
+			// NOTE: transfer maybe failed because of raft state changed, retry it in next round
+			s.exec(ctx, fmt.Sprintf(COMMAND_TRANSFER_LEADER, s.binDir, move.Region, move.To))
+		}
+		time.Sleep(STORE_LEADER_POLL_INTERVAL)
+	}
+}
+
+// getCoordinatorContainer returns the first coordinator which has container to run dingodb_cli
+func getCoordinatorContainer(dingoadm *cli.DingoAdm) (*topology.DeployConfig, string, error) {
+	dcs := dingoadm.MemStorage().Get(comm.KEY_ALL_DEPLOY_CONFIGS).([]*topology.DeployConfig)
+	for _, dc := range dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_COORDINATOR) {
+		containerId, err := dingoadm.GetContainerId(dingoadm.GetServiceId(dc.GetId()))
+		if err == nil && len(containerId) > 0 && containerId != comm.CLEANED_CONTAINER_ID {
+			return dc, containerId, nil
+		}
+	}
+	return nil, "", errno.ERR_SERVICE_CONTAINER_ID_NOT_FOUND.S("no coordinator container found")
+}
+
+func getStoreLeaderTimeout(dingoadm *cli.DingoAdm) time.Duration {
+	v := dingoadm.MemStorage().Get(comm.KEY_STORE_LEADER_TIMEOUT)
+	if v == nil || v.(time.Duration) <= 0 {
+		return DEFAULT_STORE_LEADER_TIMEOUT
+	}
+	return v.(time.Duration)
+}
+
+func newStoreLeaderTask(dingoadm *cli.DingoAdm, name, subname string,
+	s *step2WaitStoreLeader) (*task.Task, error) {
+	dc, containerId, err := getCoordinatorContainer(dingoadm)
+	if err != nil {
+		return nil, err
+	}
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	t := task.NewTask(name, subname, hc.GetSSHConfig())
+
+	// add step to task
+	s.binDir = dc.GetProjectLayout().DingoStoreBinDir
+	s.containerId = containerId
+	s.timeout = getStoreLeaderTimeout(dingoadm)
+	s.progress = t.SetProgress
+	s.execOptions = dingoadm.ExecOptions()
+	t.AddStep(s)
+
+	return t, nil
+}
+
+// NewTransferStoreLeaderTask transfers all region leaders out of the store by dingodb_cli
+// in coordinator container, and waits until the store holds no leader
+func NewTransferStoreLeaderTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	if dc.GetRole() != topology.ROLE_STORE {
+		return nil, nil
+	}
+
+	excludes := []int{}
+	if v := dingoadm.MemStorage().Get(comm.KEY_STORE_LEADER_EXCLUDES); v != nil {
+		excludes = v.([]int)
+	}
+	subname := fmt.Sprintf("host=%s role=%s storeId=%d",
+		dc.GetHost(), dc.GetRole(), dc.GetDingoInstanceId())
+	return newStoreLeaderTask(dingoadm, "Transfer Store Leader", subname, &step2WaitStoreLeader{
+		mode:     STORE_LEADER_MODE_TRANSFER,
+		storeId:  dc.GetDingoInstanceId(),
+		excludes: excludes,
+	})
+}
+
+// NewBalanceStoreLeaderTask balances region leaders among all stores by dingodb_cli
+// in coordinator container, and waits until the leaders distributed evenly
+func NewBalanceStoreLeaderTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	dcs := dingoadm.MemStorage().Get(comm.KEY_ALL_DEPLOY_CONFIGS).([]*topology.DeployConfig)
+	stores := []int{}
+	for _, store := range dingoadm.FilterDeployConfigByRole(dcs, topology.ROLE_STORE) {
+		stores = append(stores, store.GetDingoInstanceId())
+	}
+
+	subname := fmt.Sprintf("host=%s role=%s stores=%d", dc.GetHost(), dc.GetRole(), len(stores))
+	return newStoreLeaderTask(dingoadm, "Balance Store Leader", subname, &step2WaitStoreLeader{
+		mode:   STORE_LEADER_MODE_BALANCE,
+		stores: stores,
+	})
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func regionMap(regions ...Region) string {
+	lines := []string{}
+	for _, region := range regions {
+		lines = append(lines, "regions {", fmt.Sprintf("  id: %d", region.Id), "  definition {")
+		for _, peer := range region.Peers {
+			lines = append(lines, "    peers {", fmt.Sprintf("      store_id: %d", peer), "    }")
+		}
+		lines = append(lines, "  }", fmt.Sprintf("  leader_store_id: %d", region.Leader), "}")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestParseRegionMap(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		out    string
+		expect []Region
+	}{
+		{
+			regionMap(Region{80001, 33001, []int{33001, 33002, 33003}}, Region{80002, 0, []int{33002}}),
+			[]Region{{80001, 33001, []int{33001, 33002, 33003}}, {80002, 0, []int{33002}}},
+		},
+		// id and store_id out of region or peers are ignored
+		{
+			"regions {\n  id: 1\n  definition {\n    id: 9\n    store_id: 7\n  }\n  leader_store_id: 2\n}",
+			[]Region{{1, 2, nil}},
+		},
+		// malformed output
+		{"Connect to coordinator failed", []Region{}},
+		{"", []Region{}},
+		{"regions {\n  id: 1\n  leader_store_id: 2\n", []Region{}}, // truncated
+		{"}\n}\n" + regionMap(Region{1, 2, []int{2}}), []Region{{1, 2, []int{2}}}},
+	}
+
+	for _, t := range tests {
+		assert.Equal(t.expect, ParseRegionMap(t.out))
+	}
+}
+
+func TestPlanLeaderTransfer(t *testing.T) {
+	assert := assert.New(t)
+
+	peers := []int{1, 2, 3}
+	regions := []Region{{1, 1, peers}, {2, 1, peers}, {3, 2, peers}}
+	tests := []struct {
+		storeId  int
+		excludes []int
+		moves    []LeaderMove
+		stuck    []int
+	}{
+		// no leaders on the store
+		{3, nil, []LeaderMove{}, []int{}},
+		// move to the peer which holds the fewest leaders
+		{1, nil, []LeaderMove{{1, 1, 3}, {2, 1, 2}}, []int{}},
+		{1, []int{3}, []LeaderMove{{1, 1, 2}, {2, 1, 2}}, []int{}},
+		// all other stores excluded
+		{1, []int{2, 3}, []LeaderMove{}, []int{1, 2}},
+	}
+
+	for _, t := range tests {
+		moves, stuck := PlanLeaderTransfer(regions, t.storeId, t.excludes)
+		assert.Equal(t.moves, moves)
+		assert.Equal(t.stuck, stuck)
+	}
+}
+
+func TestPlanLeaderBalance(t *testing.T) {
+	assert := assert.New(t)
+
+	peers := []int{1, 2, 3}
+	leaderOn := func(store, n int, peers []int) []Region {
+		regions := []Region{}
+		for i := 1; i <= n; i++ {
+			regions = append(regions, Region{i, store, peers})
+		}
+		return regions
+	}
+	tests := []struct {
+		regions []Region
+		stores  []int
+		expect  []LeaderMove
+	}{
+		{leaderOn(1, 3, peers), nil, nil},
+		{leaderOn(0, 3, peers), peers, nil},
+		// balanced
+		{[]Region{{1, 1, peers}, {2, 2, peers}, {3, 3, peers}}, peers, []LeaderMove{}},
+		// uneven
+		{leaderOn(1, 6, peers), peers, []LeaderMove{{1, 1, 2}, {2, 1, 3}, {3, 1, 2}, {4, 1, 3}}},
+		{leaderOn(1, 5, peers), peers, []LeaderMove{{1, 1, 2}, {2, 1, 3}, {3, 1, 2}}},
+		// store not take part in balance never be the target
+		{leaderOn(1, 4, peers), []int{1, 2}, []LeaderMove{{1, 1, 2}, {2, 1, 2}}},
+		// no peer to move to
+		{leaderOn(1, 3, []int{1}), peers, []LeaderMove{}},
+	}
+
+	for _, t := range tests {
+		assert.Equal(t.expect, PlanLeaderBalance(t.regions, t.stores))
+	}
+}
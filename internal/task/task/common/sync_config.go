@@ -48,6 +48,7 @@ const (
 	CURVE_CRONTAB_FILE      = "/tmp/curve_crontab"
 	CONFIG_DEFAULT_ENV_FILE = "/etc/profile"
 	STORE_BUILD_BIN_DIR     = "/opt/dingo-store/build/bin"
+	STORE_GFLAGS_KEY_PREFIX = "gflags."
 )
 
 func NewMutate(dc *topology.DeployConfig, delimiter string, forceRender bool) step.Mutate {
@@ -65,7 +66,7 @@ func NewMutate(dc *topology.DeployConfig, delimiter string, forceRender bool) st
 		if dc.GetRole() == topology.ROLE_COORDINATOR || dc.GetRole() == topology.ROLE_STORE {
 			// key is like -xxx , replace  '-' to 'gflags.'
 			if strings.HasPrefix(key, comm.STORE_GFLAGS_PREFIX) {
-				muteKey = fmt.Sprintf("gflags.%s", strings.TrimPrefix(key, comm.STORE_GFLAGS_PREFIX))
+				muteKey = STORE_GFLAGS_KEY_PREFIX + strings.TrimPrefix(key, comm.STORE_GFLAGS_PREFIX)
 			}
 		} else if dc.GetRole() == topology.ROLE_FS_MDS {
 			// key is like --xxx , trim '--'
@@ -96,6 +97,28 @@ func NewMutate(dc *topology.DeployConfig, delimiter string, forceRender bool) st
 	}
 }
 
+func getConfigDelimiter(role string) string {
+	if role == topology.ROLE_ETCD || role == topology.ROLE_DINGODB_EXECUTOR ||
+		role == topology.ROLE_DINGODB_WEB || role == topology.ROLE_DINGODB_PROXY {
+		return CONFIG_DELIMITER_COLON
+	}
+	return CONFIG_DELIMITER_ASSIGN
+}
+
+// getConfigFileKey returns the key which service config key written in config file,
+// e.g. 'gflags.xxx' is '-xxx' for dingo-store and 'xxx' is '--xxx' for mds v2
+func getConfigFileKey(dc *topology.DeployConfig, key string) string {
+	role := dc.GetRole()
+	if (role == topology.ROLE_COORDINATOR || role == topology.ROLE_STORE) &&
+		strings.HasPrefix(key, STORE_GFLAGS_KEY_PREFIX) {
+		return comm.STORE_GFLAGS_PREFIX + strings.TrimPrefix(key, STORE_GFLAGS_KEY_PREFIX)
+	} else if role == topology.ROLE_FS_MDS &&
+		dc.GetCtx().Lookup(topology.CTX_KEY_MDS_VERSION) == topology.CTX_VAL_MDS_V2 {
+		return comm.MDSV2_CONFIG_PREFIX + key
+	}
+	return key
+}
+
 func newCrontab(uuid string, dc *topology.DeployConfig, reportScriptPath string) string {
 	var period, command string
 	if dc.GetReportUsage() == true {
@@ -156,11 +179,7 @@ func NewSyncConfigTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task
 	layout := dc.GetProjectLayout()
 	role := dc.GetRole()
 
-	delimiter := CONFIG_DELIMITER_ASSIGN
-	if role == topology.ROLE_ETCD || role == topology.ROLE_DINGODB_EXECUTOR ||
-		role == topology.ROLE_DINGODB_WEB || role == topology.ROLE_DINGODB_PROXY {
-		delimiter = CONFIG_DELIMITER_COLON
-	}
+	delimiter := getConfigDelimiter(role)
 
 	t.AddStep(&step.ListContainers{ // gurantee container exist
 		ShowAll:     true,
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package fs
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/storage"
+)
+
+const (
+	CLIENT_ACTION_MOUNT   = "mount"
+	CLIENT_ACTION_UMOUNT  = "umount"
+	CLIENT_ACTION_REMOUNT = "remount"
+	CLIENT_ACTION_KEEP    = "keep"
+)
+
+// ClientChange is the action which converges a mount point of client
+// to the state declared in client inventory
+type ClientChange struct {
+	Action     string
+	Id         string // client id in storage, empty if not mounted
+	Host       string
+	FSName     string
+	MountPoint string
+	Persistent bool
+	Reason     string
+	Config     *configure.ClientConfig
+	FSType     string
+}
+
+func (c ClientChange) Key() string {
+	return c.Host + ":" + c.MountPoint
+}
+
+// MountTarget returns target for mounting (or umounting) the client
+func (c ClientChange) MountTarget() MountTarget {
+	return MountTarget{
+		Config: c.Config,
+		Options: MountOptions{
+			Host:        c.Host,
+			MountFSName: c.FSName,
+			MountFSType: c.FSType,
+			MountPoint:  c.MountPoint,
+			Persistent:  c.Persistent,
+		},
+	}
+}
+
+func diffClient(mount configure.ClientMount, auxInfo AuxInfo, config string) []string {
+	reasons := []string{}
+	if mount.FSName != auxInfo.FSName {
+		reasons = append(reasons, "fsname: "+auxInfo.FSName+" -> "+mount.FSName)
+	}
+	if mount.Persistent != auxInfo.Persistent {
+		reasons = append(reasons, "persistent changed")
+	}
+	if mount.Config.GetData() != config {
+		reasons = append(reasons, "config changed")
+	}
+	return reasons
+}
+
+// DiffClients compares mount points declared in client inventory with
+// dingofs clients in storage, configs is the stored config of clients (key: client id).
+// Clients not in inventory are left alone unless prune is set, and even then only
+// the clients on hosts and of fsnames which the inventory declares are umounted.
+func DiffClients(mounts []configure.ClientMount,
+	clients []storage.Client,
+	configs map[string]string,
+	prune bool) []ClientChange {
+	type current struct {
+		client  storage.Client
+		auxInfo AuxInfo
+	}
+	m := map[string]current{}
+	for _, client := range clients {
+		auxInfo := AuxInfo{}
+		if client.Kind != topology.KIND_DINGOFS ||
+			json.Unmarshal([]byte(client.AuxInfo), &auxInfo) != nil {
+			continue
+		}
+		m[client.Host+":"+auxInfo.MountPoint] = current{client, auxInfo}
+	}
+
+	changes := []ClientChange{}
+	hosts, fsnames := map[string]bool{}, map[string]bool{}
+	for _, mount := range mounts {
+		hosts[mount.Host] = true
+		fsnames[mount.FSName] = true
+		change := ClientChange{
+			Action:     CLIENT_ACTION_MOUNT,
+			Host:       mount.Host,
+			FSName:     mount.FSName,
+			MountPoint: mount.MountPoint,
+			Persistent: mount.Persistent,
+			Config:     mount.Config,
+			FSType:     mount.FSType,
+		}
+		cur, ok := m[change.Key()]
+		if ok {
+			delete(m, change.Key())
+			change.Id = cur.client.Id
+			reasons := diffClient(mount, cur.auxInfo, configs[cur.client.Id])
+			if len(reasons) == 0 {
+				change.Action = CLIENT_ACTION_KEEP
+			} else {
+				change.Action = CLIENT_ACTION_REMOUNT
+				change.Reason = strings.Join(reasons, ", ")
+			}
+		}
+		changes = append(changes, change)
+	}
+	for _, cur := range m {
+		if !prune || !hosts[cur.client.Host] || !fsnames[cur.auxInfo.FSName] {
+			continue
+		}
+		changes = append(changes, ClientChange{
+			Action:     CLIENT_ACTION_UMOUNT,
+			Id:         cur.client.Id,
+			Host:       cur.client.Host,
+			FSName:     cur.auxInfo.FSName,
+			MountPoint: cur.auxInfo.MountPoint,
+			Persistent: cur.auxInfo.Persistent,
+			Reason:     "not in inventory",
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		c1, c2 := changes[i], changes[j]
+		if c1.Host != c2.Host {
+			return c1.Host < c2.Host
+		}
+		return c1.MountPoint < c2.MountPoint
+	})
+	return changes
+}
@@ -0,0 +1,50 @@
+package fs
+
+import (
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDingoFSClient(id, host, fsname, mountPoint string) storage.Client {
+	return storage.Client{
+		Id:      id,
+		Kind:    topology.KIND_DINGOFS,
+		Host:    host,
+		AuxInfo: `{"fsname":"` + fsname + `","mount_point":"` + mountPoint + `"}`,
+	}
+}
+
+func TestDiffClients_Prune(t *testing.T) {
+	assert := assert.New(t)
+	mounts := []configure.ClientMount{
+		{Host: "host1", FSName: "fs1", MountPoint: "/mnt/a"},
+	}
+	clients := []storage.Client{
+		newDingoFSClient("c1", "host1", "fs1", "/mnt/b"), // declared host and fsname
+		newDingoFSClient("c2", "host1", "fs2", "/mnt/c"), // other fsname
+		newDingoFSClient("c3", "host2", "fs1", "/mnt/d"), // other host
+	}
+
+	actions := func(changes []ClientChange) map[string]string {
+		m := map[string]string{}
+		for _, change := range changes {
+			m[change.Key()] = change.Action
+		}
+		return m
+	}
+
+	changes := DiffClients(mounts, clients, map[string]string{}, false)
+	assert.Equal(map[string]string{
+		"host1:/mnt/a": CLIENT_ACTION_MOUNT,
+	}, actions(changes))
+
+	changes = DiffClients(mounts, clients, map[string]string{}, true)
+	assert.Equal(map[string]string{
+		"host1:/mnt/a": CLIENT_ACTION_MOUNT,
+		"host1:/mnt/b": CLIENT_ACTION_UMOUNT,
+	}, actions(changes))
+}
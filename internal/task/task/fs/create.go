@@ -23,6 +23,8 @@ import (
 	"github.com/dingodb/dingoadm/cli/cli"
 	"github.com/dingodb/dingoadm/internal/common"
 	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/task/context"
 	"github.com/dingodb/dingoadm/internal/task/step"
 	"github.com/dingodb/dingoadm/internal/task/task"
@@ -37,6 +39,48 @@ func TrimContainerId(containerId *string) step.LambdaType {
 	}
 }
 
+// genClientCreateFSCommand creates filesystem with the storage and quota configured in client.yaml
+func genClientCreateFSCommand(cc *configure.ClientConfig, options MountOptions) string {
+	createOptions := FSOptions{
+		FSName:      options.MountFSName,
+		StorageType: utils.Choose(len(cc.GetStorageType()) > 0, cc.GetStorageType(), STORAGE_TYPE_S3),
+		Storage:     map[string]string{},
+	}
+	if createOptions.StorageType == STORAGE_TYPE_S3 {
+		createOptions.Storage = map[string]string{
+			"s3.ak":         cc.GetS3AccessKey(),
+			"s3.sk":         cc.GetS3SecretKey(),
+			"s3.endpoint":   cc.GetS3Address(),
+			"s3.bucketname": cc.GetS3BucketName(),
+		}
+	}
+
+	mdsaddr := cc.GetClusterMDSAddr(options.MountFSType)
+	toolPath := topology.GetDingoFSProjectLayout().FSToolsBinaryPath
+	command := GenCreateFSCommand(toolPath, mdsaddr, createOptions)
+	capacity, inodes := int64(cc.GetQuotaCapacity()), int64(cc.GetQuotaInodes())
+	if capacity <= 0 {
+		capacity = QUOTA_UNSET
+	}
+	if inodes <= 0 {
+		inodes = QUOTA_UNSET
+	}
+	config := GenConfigFSCommand(toolPath, mdsaddr, options.MountFSName, capacity, inodes)
+	if len(config) > 0 {
+		command = fmt.Sprintf("%s && %s", command, config)
+	}
+	return command
+}
+
+func checkCreateFSStatus(success *bool, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if !*success {
+			return errno.ERR_CREATE_FILESYSTEM_FAILED.S(*out)
+		}
+		return nil
+	}
+}
+
 func NewCreateDingoFSTask(dingoadm *cli.DingoAdm, cc *configure.ClientConfig) (*task.Task, error) {
 	options := dingoadm.MemStorage().Get(common.KEY_MOUNT_OPTIONS).(MountOptions)
 	hc, err := dingoadm.GetHost(options.Host)
@@ -49,6 +93,7 @@ func NewCreateDingoFSTask(dingoadm *cli.DingoAdm, cc *configure.ClientConfig) (*
 
 	var containerId, out string
 	var success bool
+	temporary := true
 	// add create fs step to task
 	t.AddStep(&step.CreateContainer{
 		Image:      cc.GetContainerImage(),
@@ -56,6 +101,7 @@ func NewCreateDingoFSTask(dingoadm *cli.DingoAdm, cc *configure.ClientConfig) (*
 		Command:    "-c \"while true; do sleep 3600; done\"",
 		Init:       true,
 		Name:       containeName,
+		Network:    "host",
 		Privileged: true,
 		Restart:    "no",
 		//--ulimit core=-1: Sets the core dump file size limit to -1, meaning there’s no restriction on the core dump size.
@@ -66,18 +112,25 @@ func NewCreateDingoFSTask(dingoadm *cli.DingoAdm, cc *configure.ClientConfig) (*
 	t.AddStep(&step.Lambda{
 		Lambda: TrimContainerId(&containerId),
 	})
+	t.AddStep(&step.StartContainer{
+		ContainerId: &containerId,
+		ExecOptions: dingoadm.ExecOptions(),
+	})
 	t.AddStep(&step.ContainerExec{
 		ContainerId: &containerId,
-		Command:     fmt.Sprintf("bash %s/create_dingofs.sh %s %s %s %s"),
+		Command:     fmt.Sprintf("sh -c %s", utils.ShellQuote(genClientCreateFSCommand(cc, options)+" 2>&1")),
 		Success:     &success,
 		Out:         &out,
 		ExecOptions: dingoadm.ExecOptions(),
 	})
-	t.AddStep(&step.StopContainer{
-		ContainerId: containerId,
-		ExecOptions: dingoadm.ExecOptions(),
+	t.AddStep(&step.Lambda{
+		Lambda: checkCreateFSStatus(&success, &out),
+	})
+	t.AddPostStep(&step2RemoveToolContainer{
+		containerId: &containerId,
+		temporary:   &temporary,
+		execOptions: dingoadm.ExecOptions(),
 	})
 
 	return t, nil
-
 }
@@ -0,0 +1,513 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package fs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	comm "github.com/dingodb/dingoadm/internal/common"
+	"github.com/dingodb/dingoadm/internal/configure/topology"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/storage"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dingodb/dingoadm/pkg/module"
+)
+
+const (
+	FS_OP_LIST      = "list"
+	FS_OP_INFO      = "info"
+	FS_OP_CREATE    = "create"
+	FS_OP_DELETE    = "delete"
+	FS_OP_QUOTA_SET = "quota-set"
+	FS_OP_QUOTA_GET = "quota-get"
+	FS_OP_USAGE     = "usage"
+
+	STORAGE_TYPE_S3    = "s3"
+	STORAGE_TYPE_RADOS = "rados"
+
+	// QUOTA_UNSET means the quota keeps unchanged
+	QUOTA_UNSET = -1
+
+	FORMAT_TOOL_CONTAINER_NAME = "dingofs-tool-%s"
+)
+
+type (
+	FSOptions struct {
+		Op          string
+		FSName      string
+		StorageType string
+		Storage     map[string]string // storage flags of dingofs tool, e.g. s3.ak, rados.mon
+		Capacity    int64             // GiB
+		Inodes      int64
+	}
+
+	FSInfo struct {
+		Id          string
+		Name        string
+		StorageType string
+		Status      string
+		Capacity    uint64
+		MaxBytes    uint64
+		UsedBytes   uint64
+		MaxInodes   uint64
+		UsedInodes  uint64
+	}
+
+	// FSClient is the client which mounted filesystem, recorded in clients table
+	FSClient struct {
+		Id          string
+		Host        string
+		MountPoint  string
+		ContainerId string
+	}
+
+	FSResult struct {
+		FSInfos []FSInfo
+		Output  string // raw output of dingofs tool
+	}
+
+	step2PrepareToolContainer struct {
+		dingoadm           *cli.DingoAdm
+		dc                 *topology.DeployConfig
+		serviceContainerId string
+		containerId        *string
+		temporary          *bool
+		execOptions        module.ExecOptions
+	}
+
+	step2RemoveToolContainer struct {
+		containerId *string
+		temporary   *bool
+		execOptions module.ExecOptions
+	}
+
+	step2RunFSTool struct {
+		dingoadm    *cli.DingoAdm
+		options     FSOptions
+		containerId *string
+		toolPath    string
+		mdsaddr     string
+		execOptions module.ExecOptions
+	}
+)
+
+// GetMountedClients groups dingofs clients by the filesystem they mounted
+func GetMountedClients(clients []storage.Client) map[string][]FSClient {
+	m := map[string][]FSClient{}
+	for _, client := range clients {
+		auxInfo := AuxInfo{}
+		if client.Kind != topology.KIND_DINGOFS ||
+			json.Unmarshal([]byte(client.AuxInfo), &auxInfo) != nil ||
+			len(auxInfo.FSName) == 0 {
+			continue
+		}
+		m[auxInfo.FSName] = append(m[auxInfo.FSName], FSClient{
+			Id:          client.Id,
+			Host:        client.Host,
+			MountPoint:  auxInfo.MountPoint,
+			ContainerId: client.ContainerId,
+		})
+	}
+	return m
+}
+
+// GenCreateFSCommand generates command for creating filesystem by dingofs tool
+func GenCreateFSCommand(tool, mdsaddr string, options FSOptions) string {
+	args := []string{
+		"create fs",
+		"--fsname=" + utils.ShellQuote(options.FSName),
+		"--storagetype=" + options.StorageType,
+	}
+	keys := []string{}
+	for key := range options.Storage {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		args = append(args, fmt.Sprintf("--%s=%s", key, utils.ShellQuote(options.Storage[key])))
+	}
+	return genToolCommand(tool, mdsaddr, args...)
+}
+
+// GenConfigFSCommand generates command for setting filesystem quota,
+// returns empty string if none of quotas specified
+func GenConfigFSCommand(tool, mdsaddr, fsname string, capacity, inodes int64) string {
+	args := []string{"config fs", "--fsname=" + utils.ShellQuote(fsname)}
+	if capacity != QUOTA_UNSET {
+		args = append(args, fmt.Sprintf("--capacity=%d", capacity))
+	}
+	if inodes != QUOTA_UNSET {
+		args = append(args, fmt.Sprintf("--inodes=%d", inodes))
+	}
+	if len(args) == 2 {
+		return ""
+	}
+	return genToolCommand(tool, mdsaddr, args...)
+}
+
+func genToolCommand(tool, mdsaddr string, args ...string) string {
+	command := fmt.Sprintf("%s %s", tool, strings.Join(args, " "))
+	if len(mdsaddr) > 0 {
+		command = fmt.Sprintf("%s --mdsaddr=%s", command, mdsaddr)
+	}
+	return command
+}
+
+func normalizeKey(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", ""))
+}
+
+// flattenFields collects all scalar fields of object (including nested objects),
+// the outer field wins if the same key appears more than once
+func flattenFields(m map[string]interface{}, fields map[string]interface{}) {
+	nested := []map[string]interface{}{}
+	for key, value := range m {
+		if sub, ok := value.(map[string]interface{}); ok {
+			nested = append(nested, sub)
+			continue
+		}
+		key = normalizeKey(key)
+		if _, ok := fields[key]; !ok {
+			fields[key] = value
+		}
+	}
+	for _, sub := range nested {
+		flattenFields(sub, fields)
+	}
+}
+
+func getString(fields map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if value, ok := fields[key]; ok && value != nil {
+			return fmt.Sprintf("%v", value)
+		}
+	}
+	return ""
+}
+
+func getUint(fields map[string]interface{}, keys ...string) uint64 {
+	for _, key := range keys {
+		n, err := strconv.ParseUint(getString(fields, key), 10, 64)
+		if err == nil {
+			return n
+		}
+	}
+	return 0
+}
+
+func decodeToolOutput(out string) (interface{}, bool) {
+	// NOTE: dingofs tool may print some prompts before the json document
+	idx := strings.IndexAny(out, "{[")
+	if idx < 0 {
+		return nil, false
+	}
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewBufferString(out[idx:]))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+func newFSInfo(fields map[string]interface{}) FSInfo {
+	return FSInfo{
+		Id:          getString(fields, "fsid", "id"),
+		Name:        getString(fields, "fsname"),
+		StorageType: strings.ToLower(getString(fields, "storagetype", "fstype")),
+		Status:      getString(fields, "status"),
+		Capacity:    getUint(fields, "capacity"),
+	}
+}
+
+func collectFSInfos(v interface{}, infos *[]FSInfo) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key := range value {
+			if normalizeKey(key) == "fsname" {
+				fields := map[string]interface{}{}
+				flattenFields(value, fields)
+				*infos = append(*infos, newFSInfo(fields))
+				return
+			}
+		}
+		for _, sub := range value {
+			collectFSInfos(sub, infos)
+		}
+	case []interface{}:
+		for _, sub := range value {
+			collectFSInfos(sub, infos)
+		}
+	}
+}
+
+// ParseFSInfos parses filesystems from json output of dingofs tool,
+// e.g. `dingo list fs --format=json`
+func ParseFSInfos(out string) []FSInfo {
+	infos := []FSInfo{}
+	if v, ok := decodeToolOutput(out); ok {
+		collectFSInfos(v, &infos)
+	}
+	return infos
+}
+
+// ParseFSQuota fills quota of filesystem from json output of `dingo config get`
+func ParseFSQuota(out string, info *FSInfo) bool {
+	v, ok := decodeToolOutput(out)
+	if !ok {
+		return false
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	fields := map[string]interface{}{}
+	flattenFields(m, fields)
+	info.MaxBytes = getUint(fields, "maxbytes", "capacity")
+	info.UsedBytes = getUint(fields, "usedbytes")
+	info.MaxInodes = getUint(fields, "maxinodes", "inodes")
+	info.UsedInodes = getUint(fields, "usedinodes")
+	return true
+}
+
+func (s *step2PrepareToolContainer) Execute(ctx *context.Context) error {
+	// (1) prefer the running mds-client container
+	if len(s.serviceContainerId) > 0 {
+		out, err := ctx.Module().DockerCli().InspectContainer(s.serviceContainerId).
+			AddOption("--format '{{.State.Status}}'").
+			Execute(s.execOptions)
+		if err == nil && strings.TrimSpace(out) == "running" {
+			*s.containerId = s.serviceContainerId
+			return nil
+		}
+	}
+
+	// (2) create temporary container with the same image
+	name := fmt.Sprintf(FORMAT_TOOL_CONTAINER_NAME, utils.RandString(8))
+	out, err := ctx.Module().DockerCli().
+		CreateContainer(s.dc.GetContainerImage(), "-c \"while true; do sleep 3600; done\"").
+		AddOption("--entrypoint bash").
+		AddOption("--name %s", name).
+		AddOption("--network host").
+		Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_CREATE_CONTAINER_FAILED.E(err)
+	}
+	items := strings.Split(strings.TrimSpace(out), "\n")
+	*s.containerId = items[len(items)-1]
+	*s.temporary = true
+
+	_, err = ctx.Module().DockerCli().StartContainer(*s.containerId).Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_START_CONTAINER_FAILED.E(err)
+	}
+	return nil
+}
+
+func (s *step2RemoveToolContainer) Execute(ctx *context.Context) error {
+	if !*s.temporary || len(*s.containerId) == 0 {
+		return nil
+	}
+	_, err := ctx.Module().DockerCli().RemoveContainer(*s.containerId).
+		AddOption("--force").
+		Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_REMOVE_CONTAINER_FAILED.E(err)
+	}
+	return nil
+}
+
+func (s *step2RunFSTool) run(ctx *context.Context, args ...string) (string, error) {
+	return s.exec(ctx, genToolCommand(s.toolPath, s.mdsaddr, args...))
+}
+
+func (s *step2RunFSTool) exec(ctx *context.Context, command string) (string, error) {
+	cmd := ctx.Module().DockerCli().ContainerExec(*s.containerId,
+		fmt.Sprintf("sh -c %s", utils.ShellQuote(command+" 2>&1")))
+	out, err := cmd.Execute(s.execOptions)
+	if err != nil {
+		return out, errno.ERR_RUN_DINGOFS_TOOL_FAILED.S(out)
+	}
+	return out, nil
+}
+
+func (s *step2RunFSTool) fillQuota(ctx *context.Context, info *FSInfo) {
+	out, err := s.run(ctx, "config get", "--fsname="+utils.ShellQuote(info.Name), "--format=json")
+	if err == nil {
+		ParseFSQuota(out, info)
+	}
+}
+
+func (s *step2RunFSTool) list(ctx *context.Context) (FSResult, error) {
+	out, err := s.run(ctx, "list fs", "--format=json")
+	if err != nil {
+		return FSResult{}, err
+	}
+	infos := ParseFSInfos(out)
+	for i := range infos {
+		s.fillQuota(ctx, &infos[i])
+	}
+	return FSResult{FSInfos: infos, Output: out}, nil
+}
+
+func (s *step2RunFSTool) query(ctx *context.Context) (FSResult, error) {
+	fsname := s.options.FSName
+	out, err := s.run(ctx, "query fs", "--fsname="+utils.ShellQuote(fsname), "--format=json")
+	if err != nil {
+		return FSResult{}, errno.ERR_FILESYSTEM_NOT_FOUND.F("fsname=%s", fsname).S(out)
+	}
+	infos := ParseFSInfos(out)
+	if len(infos) == 0 {
+		infos = append(infos, FSInfo{Name: fsname})
+	}
+	s.fillQuota(ctx, &infos[0])
+	return FSResult{FSInfos: infos[:1], Output: out}, nil
+}
+
+func (s *step2RunFSTool) create(ctx *context.Context) (FSResult, error) {
+	options := s.options
+	out, err := s.exec(ctx, GenCreateFSCommand(s.toolPath, s.mdsaddr, options))
+	if err != nil {
+		return FSResult{}, errno.ERR_CREATE_FILESYSTEM_FAILED.S(out)
+	}
+	result, err := s.setQuota(ctx)
+	result.Output = strings.TrimSpace(out + "\n" + result.Output)
+	return result, err
+}
+
+func (s *step2RunFSTool) delete(ctx *context.Context) (FSResult, error) {
+	out, err := s.run(ctx, "delete fs", "--fsname="+utils.ShellQuote(s.options.FSName), "--noconfirm")
+	if err != nil {
+		return FSResult{}, errno.ERR_DELETE_FILESYSTEM_FAILED.S(out)
+	}
+	return FSResult{Output: out}, nil
+}
+
+func (s *step2RunFSTool) setQuota(ctx *context.Context) (FSResult, error) {
+	options := s.options
+	command := GenConfigFSCommand(s.toolPath, s.mdsaddr, options.FSName, options.Capacity, options.Inodes)
+	if len(command) == 0 {
+		return FSResult{}, nil
+	}
+	out, err := s.exec(ctx, command)
+	return FSResult{Output: out}, err
+}
+
+func (s *step2RunFSTool) getQuota(ctx *context.Context) (FSResult, error) {
+	fsname := s.options.FSName
+	out, err := s.run(ctx, "config get", "--fsname="+utils.ShellQuote(fsname), "--format=json")
+	if err != nil {
+		return FSResult{}, errno.ERR_FILESYSTEM_NOT_FOUND.F("fsname=%s", fsname).S(out)
+	}
+	info := FSInfo{Name: fsname}
+	ParseFSQuota(out, &info)
+	return FSResult{FSInfos: []FSInfo{info}, Output: out}, nil
+}
+
+func (s *step2RunFSTool) Execute(ctx *context.Context) error {
+	var result FSResult
+	var err error
+	switch s.options.Op {
+	case FS_OP_LIST:
+		result, err = s.list(ctx)
+	case FS_OP_INFO:
+		result, err = s.query(ctx)
+	case FS_OP_CREATE:
+		result, err = s.create(ctx)
+	case FS_OP_DELETE:
+		result, err = s.delete(ctx)
+	case FS_OP_QUOTA_SET:
+		result, err = s.setQuota(ctx)
+	case FS_OP_QUOTA_GET:
+		result, err = s.getQuota(ctx)
+	case FS_OP_USAGE:
+		if len(s.options.FSName) == 0 {
+			result, err = s.list(ctx)
+		} else {
+			result, err = s.getQuota(ctx)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	s.dingoadm.MemStorage().Set(comm.KEY_FS_RESULT, result)
+	return nil
+}
+
+// NewRunFSToolTask runs dingofs tool in mds-client container for managing filesystems,
+// a temporary container is used instead if the mds-client container is not running
+func NewRunFSToolTask(dingoadm *cli.DingoAdm, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingoadm.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	mdsaddr, err := dc.GetVariables().Get("cluster_mdsv2_addr")
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	options := dingoadm.MemStorage().Get(comm.KEY_FS_OPTIONS).(FSOptions)
+	serviceContainerId, _ := dingoadm.GetContainerId(dingoadm.GetServiceId(dc.GetId()))
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(serviceContainerId))
+	if len(options.FSName) > 0 {
+		subname = fmt.Sprintf("%s fsname=%s", subname, options.FSName)
+	}
+	t := task.NewTask("Run DingoFS Tool", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var containerId string
+	var temporary bool
+	t.AddStep(&step2PrepareToolContainer{
+		dingoadm:           dingoadm,
+		dc:                 dc,
+		serviceContainerId: serviceContainerId,
+		containerId:        &containerId,
+		temporary:          &temporary,
+		execOptions:        dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step2RunFSTool{
+		dingoadm:    dingoadm,
+		options:     options,
+		containerId: &containerId,
+		toolPath:    dc.GetProjectLayout().FSToolsBinaryPath,
+		mdsaddr:     mdsaddr,
+		execOptions: dingoadm.ExecOptions(),
+	})
+	t.AddPostStep(&step2RemoveToolContainer{
+		containerId: &containerId,
+		temporary:   &temporary,
+		execOptions: dingoadm.ExecOptions(),
+	})
+
+	return t, nil
+}
@@ -0,0 +1,127 @@
+package fs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFSInfos(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		out    string
+		expect []FSInfo
+	}{
+		// list with nested fields
+		{
+			`{"fsInfos":[{"fsId":1,"fsName":"fs1","status":"NORMAL","capacity":1073741824,` +
+				`"storageInfo":{"storageType":"S3"}},{"fs_id":2,"fs_name":"fs2","storage_type":"RADOS"}]}`,
+			[]FSInfo{
+				{Id: "1", Name: "fs1", StorageType: "s3", Status: "NORMAL", Capacity: 1073741824},
+				{Id: "2", Name: "fs2", StorageType: "rados"},
+			},
+		},
+		// prompts before json document
+		{
+			"connecting mds...\n[{\"fsName\":\"fs1\",\"id\":\"3\",\"fsType\":\"s3\"}]",
+			[]FSInfo{{Id: "3", Name: "fs1", StorageType: "s3"}},
+		},
+		// outer field wins
+		{
+			`{"fsName":"fs1","status":"NORMAL","detail":{"status":"DELETING","capacity":100}}`,
+			[]FSInfo{{Name: "fs1", Status: "NORMAL", Capacity: 100}},
+		},
+		// no filesystem
+		{`{"fsInfos":[]}`, []FSInfo{}},
+		{"no filesystem found", []FSInfo{}},
+		{`{"fsName":`, []FSInfo{}},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.expect, ParseFSInfos(tt.out), tt.out)
+	}
+}
+
+func TestParseFSQuota(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		out    string
+		ok     bool
+		expect FSInfo
+	}{
+		{
+			`{"quota":{"maxBytes":107374182400,"usedBytes":1024,"maxInodes":1000000,"usedInodes":10}}`,
+			true,
+			FSInfo{MaxBytes: 107374182400, UsedBytes: 1024, MaxInodes: 1000000, UsedInodes: 10},
+		},
+		// capacity and inodes as quota
+		{
+			"quota of fs1:\n{\"capacity\":100,\"inodes\":200}",
+			true,
+			FSInfo{MaxBytes: 100, MaxInodes: 200},
+		},
+		// quota unlimited
+		{`{}`, true, FSInfo{}},
+		// not a json object
+		{`[1,2]`, false, FSInfo{}},
+		{"failed to get quota", false, FSInfo{}},
+	}
+	for _, tt := range tests {
+		info := FSInfo{}
+		assert.Equal(tt.ok, ParseFSQuota(tt.out, &info), tt.out)
+		assert.Equal(tt.expect, info, tt.out)
+	}
+}
+
+func TestGenCreateFSCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		mdsaddr string
+		options FSOptions
+		expect  string
+	}{
+		{
+			"10.0.0.1:7400",
+			FSOptions{
+				FSName:      "fs1",
+				StorageType: STORAGE_TYPE_S3,
+				Storage:     map[string]string{"s3.sk": "sk", "s3.ak": "ak", "s3.endpoint": "http://s3"},
+			},
+			"dingo create fs --fsname='fs1' --storagetype=s3 --s3.ak='ak' --s3.endpoint='http://s3' " +
+				"--s3.sk='sk' --mdsaddr=10.0.0.1:7400",
+		},
+		{
+			"",
+			FSOptions{
+				FSName:      "fs'1",
+				StorageType: STORAGE_TYPE_RADOS,
+				Storage:     map[string]string{"rados.key": "a b"},
+			},
+			`dingo create fs --fsname='fs'\''1' --storagetype=rados --rados.key='a b'`,
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.expect, GenCreateFSCommand("dingo", tt.mdsaddr, tt.options))
+	}
+}
+
+func TestGenConfigFSCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		capacity int64
+		inodes   int64
+		expect   string
+	}{
+		{100, 1000, "dingo config fs --fsname='fs1' --capacity=100 --inodes=1000 --mdsaddr=10.0.0.1:7400"},
+		{100, QUOTA_UNSET, "dingo config fs --fsname='fs1' --capacity=100 --mdsaddr=10.0.0.1:7400"},
+		{QUOTA_UNSET, 1000, "dingo config fs --fsname='fs1' --inodes=1000 --mdsaddr=10.0.0.1:7400"},
+		{0, 0, "dingo config fs --fsname='fs1' --capacity=0 --inodes=0 --mdsaddr=10.0.0.1:7400"}, // 0 means unlimited
+		{QUOTA_UNSET, QUOTA_UNSET, ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.expect, GenConfigFSCommand("dingo", "10.0.0.1:7400", "fs1", tt.capacity, tt.inodes))
+	}
+}
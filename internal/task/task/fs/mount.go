@@ -49,6 +49,11 @@ import (
 const (
 	FORMAT_MOUNT_OPTION = "type=bind,source=%s,target=%s,bind-propagation=rshared"
 
+	// persistent client container restarts after host reboot or fuse crash,
+	// the stale mount point is cleaned by client_mount.sh before re-mount
+	PERSISTENT_RESTART_POLICY = "unless-stopped"
+	CLIENT_MOUNT_SCRIPT_PATH  = "/client_mount.sh"
+
 	KEY_CURVEBS_CLUSTER = "curvebs.cluster"
 )
 
@@ -58,6 +63,7 @@ type (
 		MountFSName string
 		MountFSType string
 		MountPoint  string
+		Persistent  bool
 	}
 
 	step2InsertClient struct {
@@ -70,7 +76,16 @@ type (
 	AuxInfo struct {
 		FSName     string `json:"fsname"`
 		MountPoint string `json:"mount_point,"`
+		MetricPort int    `json:"metric_port,omitempty"`
 		Config     string `json:"config,omitempty"` // TODO(P1)
+		Persistent bool   `json:"persistent,omitempty"`
+	}
+
+	// MountTarget is a mount point of client with its own options,
+	// which mount or umount many clients in one playbook step
+	MountTarget struct {
+		Config  *configure.ClientConfig
+		Options MountOptions
 	}
 )
 
@@ -102,6 +117,11 @@ func getMountCommand(cc *configure.ClientConfig, mountFSName string, mountPoint
 
 }
 
+// GetClientVolumes returns volumes which mounted into client container
+func GetClientVolumes(cc *configure.ClientConfig) []step.Volume {
+	return getMountVolumes(cc)
+}
+
 func getMountVolumes(cc *configure.ClientConfig) []step.Volume {
 	volumes := []step.Volume{}
 	prefix := configure.GetFSClientPrefix()
@@ -260,6 +280,8 @@ func (s *step2InsertClient) Execute(ctx *context.Context) error {
 	auxInfo := &AuxInfo{
 		FSName:     options.MountFSName,
 		MountPoint: options.MountPoint,
+		MetricPort: config.GetDummyPort(),
+		Persistent: options.Persistent,
 	}
 	bytes, err := json.Marshal(auxInfo)
 	if err != nil {
@@ -293,8 +315,18 @@ func checkStartContainerStatus(success *bool, out *string) step.LambdaType {
 
 func NewMountFSTask(dingoadm *cli.DingoAdm, cc *configure.ClientConfig) (*task.Task, error) {
 	options := dingoadm.MemStorage().Get(comm.KEY_MOUNT_OPTIONS).(MountOptions)
-	useNewDingo := dingoadm.MemStorage().Get(comm.KEY_USE_NEW_DINGO).(bool)
 	fstype := dingoadm.MemStorage().Get(comm.KEY_FSTYPE).(string)
+	return newMountFSTask(dingoadm, cc, options, fstype)
+}
+
+func NewMountTargetTask(dingoadm *cli.DingoAdm, v interface{}) (*task.Task, error) {
+	target := v.(MountTarget)
+	return newMountFSTask(dingoadm, target.Config, target.Options, target.Options.MountFSType)
+}
+
+func newMountFSTask(dingoadm *cli.DingoAdm, cc *configure.ClientConfig,
+	options MountOptions, fstype string) (*task.Task, error) {
+	useNewDingo := dingoadm.MemStorage().Get(comm.KEY_USE_NEW_DINGO).(bool)
 	hc, err := dingoadm.GetHost(options.Host)
 	if err != nil {
 		return nil, err
@@ -376,6 +408,7 @@ func NewMountFSTask(dingoadm *cli.DingoAdm, cc *configure.ClientConfig) (*task.T
 		Ulimits:           []string{"core=-1"},
 		Pid:               cc.GetContainerPid(),
 		Privileged:        true,
+		Restart:           utils.Choose(options.Persistent, PERSISTENT_RESTART_POLICY, ""),
 		Out:               &containerId,
 		ExecOptions:       dingoadm.ExecOptions(),
 	})
@@ -419,6 +452,12 @@ func NewMountFSTask(dingoadm *cli.DingoAdm, cc *configure.ClientConfig) (*task.T
 		Content:           &mountfsScriptSource,
 		ExecOptions:       dingoadm.ExecOptions(),
 	})
+	t.AddStep(&step.InstallFile{ // install client_mount.sh which cleans stale mount point on startup
+		ContainerId:       &containerId,
+		ContainerDestPath: CLIENT_MOUNT_SCRIPT_PATH,
+		Content:           &scripts.CLIENT_MOUNT,
+		ExecOptions:       dingoadm.ExecOptions(),
+	})
 	t.AddStep(&step.StartContainer{
 		ContainerId: &containerId,
 		Success:     &success,
@@ -132,6 +132,14 @@ func (s *step2RemoveContainer) Execute(ctx *context.Context) error {
 
 func NewUmountFSTask(dingoadm *cli.DingoAdm, v interface{}) (*task.Task, error) {
 	options := dingoadm.MemStorage().Get(comm.KEY_MOUNT_OPTIONS).(MountOptions)
+	return newUmountFSTask(dingoadm, options)
+}
+
+func NewUmountTargetTask(dingoadm *cli.DingoAdm, v interface{}) (*task.Task, error) {
+	return newUmountFSTask(dingoadm, v.(MountTarget).Options)
+}
+
+func newUmountFSTask(dingoadm *cli.DingoAdm, options MountOptions) (*task.Task, error) {
 	fsId := dingoadm.GetFilesystemId(options.Host, options.MountPoint)
 	hc, err := dingoadm.GetHost(options.Host)
 	if err != nil {
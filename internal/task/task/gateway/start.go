@@ -23,13 +23,15 @@
 package gateway
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 
 	"github.com/dingodb/dingoadm/internal/configure"
 
 	"github.com/dingodb/dingoadm/cli/cli"
-	comm "github.com/dingodb/dingoadm/internal/common"
 	"github.com/dingodb/dingoadm/internal/configure/topology"
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/task/context"
@@ -37,29 +39,130 @@ import (
 	"github.com/dingodb/dingoadm/internal/task/step"
 	"github.com/dingodb/dingoadm/internal/task/task"
 	"github.com/dingodb/dingoadm/internal/task/task/checker"
+	"github.com/dingodb/dingoadm/internal/task/task/common"
 	"github.com/dingodb/dingoadm/internal/utils"
 )
 
-func NewStartGatewayTask(curveadm *cli.DingoAdm, gc *configure.GatewayConfig) (*task.Task, error) {
-	host := curveadm.MemStorage().Get(comm.GATEWAY_HOST).(string)
-	hc, err := curveadm.GetHost(host)
+const (
+	// certificate and key of gateway are loaded from default certs directory of minio
+	GATEWAY_CERTS_DIR     = "/root/.minio/certs"
+	GATEWAY_TLS_CERT_FILE = GATEWAY_CERTS_DIR + "/public.crt"
+	GATEWAY_TLS_KEY_FILE  = GATEWAY_CERTS_DIR + "/private.key"
+	FORMAT_FILTER_SPORT   = "( sport = :%d )"
+	FORMAT_GATEWAY_NAME   = "dingofs-gateway-%s"
+)
+
+// checkMountPoint checks the filesystem is mounted on mount point of gateway
+func checkMountPoint(host, mountPoint string, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		for _, line := range strings.Split(*out, "\n") {
+			if line == "state="+common.CLIENT_MOUNT_STATE_MOUNTED {
+				return nil
+			}
+		}
+		return errno.ERR_GATEWAY_FS_NOT_FOUND.
+			F("host=%s mountPoint=%s: %s", host, mountPoint, strings.ReplaceAll(*out, "\n", " "))
+	}
+}
+
+// checkLegacyGateway checks no gateway started on the mount point before
+// gateway had name, whose container named by md5sum of mount point
+func checkLegacyGateway(host, mountPoint string, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if len(strings.TrimSpace(*out)) > 0 {
+			return errno.ERR_GATEWAY_ALREADY_STARTED.
+				F("host=%s mountPoint=%s containerId=%s", host, mountPoint, strings.TrimSpace(*out))
+		}
+		return nil
+	}
+}
+
+// addPrecheckSteps checks ports of gateway not in use, filesystem mounted and
+// certificate exists on host before container created
+func addPrecheckSteps(t *task.Task, dingoadm *cli.DingoAdm, gc *configure.GatewayConfig) {
+	options := dingoadm.ExecOptions()
+	var legacy string
+	t.AddStep(&step.ListContainers{
+		ShowAll:     true,
+		Format:      `"{{.ID}}"`,
+		Filter:      fmt.Sprintf("name=^%s$", fmt.Sprintf(FORMAT_GATEWAY_NAME, utils.MD5Sum(gc.GetMountPoint()))),
+		Out:         &legacy,
+		ExecOptions: options,
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: checkLegacyGateway(gc.GetHost(), gc.GetMountPoint(), &legacy),
+	})
+
+	for _, address := range []string{gc.GetListenAddr(), gc.GetConsoleAddr()} {
+		_, p, _ := net.SplitHostPort(address)
+		port, _ := strconv.Atoi(p)
+		var success bool
+		var out string
+		t.AddStep(&step.SocketStatistics{
+			Filter:      fmt.Sprintf(FORMAT_FILTER_SPORT, port),
+			Listening:   true,
+			NoHeader:    true,
+			Success:     &success,
+			Out:         &out,
+			ExecOptions: options,
+		})
+		t.AddStep(&step.Lambda{
+			Lambda: checker.CheckPortInUse(&success, &out, gc.GetHost(), port),
+		})
+	}
+
+	var out string
+	scriptPath := utils.RandFilename("/tmp") + ".sh"
+	t.AddStep(&step.InstallFile{
+		HostDestPath: scriptPath,
+		Content:      &scripts.CLIENT_MOUNT,
+		ExecOptions:  options,
+	})
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf("bash %s check %s", scriptPath, utils.ShellQuote(gc.GetMountPoint())),
+		Out:         &out,
+		ExecOptions: options,
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: checkMountPoint(gc.GetHost(), gc.GetMountPoint(), &out),
+	})
+
+	if gc.HasTLS() {
+		t.AddStep(&step.Stat{
+			Files:       []string{gc.GetTLSCertFile(), gc.GetTLSKeyFile()},
+			ExecOptions: options,
+		})
+	}
+}
+
+func getTLSVolumes(gc *configure.GatewayConfig) []step.Volume {
+	if !gc.HasTLS() {
+		return nil
+	}
+	return []step.Volume{
+		{HostPath: gc.GetTLSCertFile(), ContainerPath: GATEWAY_TLS_CERT_FILE},
+		{HostPath: gc.GetTLSKeyFile(), ContainerPath: GATEWAY_TLS_KEY_FILE},
+	}
+}
+
+func NewStartGatewayTask(dingoadm *cli.DingoAdm, gc *configure.GatewayConfig) (*task.Task, error) {
+	host := gc.GetHost()
+	hc, err := dingoadm.GetHost(host)
 	if err != nil {
 		return nil, err
 	}
 
 	// new task
-	mdsaddr := curveadm.MemStorage().Get(comm.MDSADDR).(string)
-	gatewayListenAddr := curveadm.MemStorage().Get(comm.GATEWAY_LISTEN_ADDR).(string)
-	gatewayConsoleAddr := curveadm.MemStorage().Get(comm.GATEWAY_CONSOLE_ADDR).(string)
-	mountPoint := curveadm.MemStorage().Get(comm.GATEWAY_MOUNTPOINT).(string)
-
-	subname := fmt.Sprintf("gatewayListenAddr=%s gatewayConsoleAddr=%s mountPoint=%s", gatewayListenAddr, gatewayConsoleAddr, mountPoint)
-	t := task.NewTask("Bootstrap S3 ateway service", subname, hc.GetSSHConfig())
+	name := gc.GetName()
+	mountPoint := gc.GetMountPoint()
+	subname := fmt.Sprintf("host=%s name=%s listenAddr=%s consoleAddr=%s mountPoint=%s",
+		host, name, gc.GetListenAddr(), gc.GetConsoleAddr(), mountPoint)
+	t := task.NewTask("Bootstrap S3 Gateway Service", subname, hc.GetSSHConfig())
 
 	// add step to task
 	var containerId, out string
 	var success bool
-	containerName := fmt.Sprintf("dingofs-gateway-%s", utils.MD5Sum(mountPoint))
+	containerName := fmt.Sprintf(FORMAT_GATEWAY_NAME, utils.MD5Sum(name))
 	containerMountPath := fmt.Sprintf("%s/client/mnt%s", topology.GetDingoFSProjectLayout().ProjectRootDir, mountPoint)
 	startGatewayScript := scripts.START_GATEWAY
 	startGatewayScriptPath := "/gateway.sh"
@@ -67,50 +170,58 @@ func NewStartGatewayTask(curveadm *cli.DingoAdm, gc *configure.GatewayConfig) (*
 	t.AddStep(&step.EngineInfo{
 		Success:     &success,
 		Out:         &out,
-		ExecOptions: curveadm.ExecOptions(),
+		ExecOptions: dingoadm.ExecOptions(),
 	})
 	t.AddStep(&step.Lambda{
-		Lambda: checker.CheckEngineInfo(host, curveadm.ExecOptions().ExecWithEngine, &success, &out),
+		Lambda: checker.CheckEngineInfo(host, dingoadm.ExecOptions().ExecWithEngine, &success, &out),
 	})
-
-	// todo add check same gateway service have existed
+	addPrecheckSteps(t, dingoadm, gc)
 
 	t.AddStep(&step.PullImage{
 		Image:       gc.GetContainerImage(),
-		ExecOptions: curveadm.ExecOptions(),
+		ExecOptions: dingoadm.ExecOptions(),
 	})
 	t.AddStep(&step.CreateContainer{
-		Image:      gc.GetContainerImage(),
-		Command:    getStartGatewayCommand(mdsaddr, gatewayListenAddr, gatewayConsoleAddr, containerMountPath),
-		Entrypoint: "/bin/bash",
-		Envs:       getEnvironments(gc),
-		Init:       true,
-		Name:       containerName,
-		Mount:      fmt.Sprintf("type=bind,source=%s,target=%s,bind-propagation=rshared", mountPoint, containerMountPath),
-		//Volumes:           getMountVolumes(cc),
+		Image:       gc.GetContainerImage(),
+		Command:     getStartGatewayCommand(gc.GetDingofsMDSAddr(), gc.GetListenAddr(), gc.GetConsoleAddr(), containerMountPath),
+		Entrypoint:  "/bin/bash",
+		Envs:        getEnvironments(gc),
+		Init:        true,
+		Name:        containerName,
+		Mount:       fmt.Sprintf("type=bind,source=%s,target=%s,bind-propagation=rshared", mountPoint, containerMountPath),
+		Volumes:     getTLSVolumes(gc),
 		Ulimits:     []string{"core=-1", "nofile=65535:65535"},
 		Out:         &containerId,
-		ExecOptions: curveadm.ExecOptions(),
+		ExecOptions: dingoadm.ExecOptions(),
 	})
 
-	//todo save gateway info to db
-
 	t.AddStep(&step.InstallFile{ // install gateway.sh shell
 		ContainerId:       &containerId,
 		ContainerDestPath: startGatewayScriptPath,
 		Content:           &startGatewayScript,
-		ExecOptions:       curveadm.ExecOptions(),
+		ExecOptions:       dingoadm.ExecOptions(),
 	})
 
 	t.AddStep(&step.StartContainer{
 		ContainerId: &containerId,
 		Success:     &success,
 		Out:         &out,
-		ExecOptions: curveadm.ExecOptions(),
+		ExecOptions: dingoadm.ExecOptions(),
 	})
 	t.AddStep(&step.Lambda{
 		Lambda: checkStartContainerStatus(&success, &out),
 	})
+	t.AddStep(&step.Lambda{
+		Lambda: saveGatewayInfo(dingoadm, configure.GatewayInfo{
+			Name:        name,
+			Host:        host,
+			MountPoint:  mountPoint,
+			FSName:      gc.GetFSName(),
+			ListenAddr:  gc.GetListenAddr(),
+			ConsoleAddr: gc.GetConsoleAddr(),
+			TLS:         gc.HasTLS(),
+		}, &containerId),
+	})
 
 	return t, nil
 
@@ -125,6 +236,7 @@ func getEnvironments(gc *configure.GatewayConfig) []string {
 		"LD_PRELOAD=/usr/local/lib/libjemalloc.so",
 		fmt.Sprintf("MINIO_ROOT_USER=%s", gc.GetS3RootUser()),
 		fmt.Sprintf("MINIO_ROOT_PASSWORD=%s", gc.GetS3RootPassword()),
+		"MINIO_PROMETHEUS_AUTH_TYPE=public", // scraped by monitor without token
 	}
 
 	return envs
@@ -140,3 +252,19 @@ func checkStartContainerStatus(success *bool, out *string) step.LambdaType {
 		return errno.ERR_START_GATEWAY_FAILED.S(*out)
 	}
 }
+
+// saveGatewayInfo records the gateway, which discovered by monitor as scrape target
+func saveGatewayInfo(curveadm *cli.DingoAdm, info configure.GatewayInfo, containerId *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		info.ContainerId = *containerId
+		bytes, err := json.Marshal(info)
+		if err != nil {
+			return errno.ERR_REPLACE_GATEWAY_FAILED.E(err)
+		}
+		err = curveadm.Storage().ReplaceGateway(info.Name, string(bytes))
+		if err != nil {
+			return errno.ERR_REPLACE_GATEWAY_FAILED.E(err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+)
+
+type (
+	step2RemoveGatewayContainer struct {
+		status      *string
+		containerId string
+		dingoadm    *cli.DingoAdm
+	}
+)
+
+func (s *step2RemoveGatewayContainer) Execute(ctx *context.Context) error {
+	if len(*s.status) == 0 { // container already removed
+		return nil
+	}
+
+	steps := []task.Step{}
+	if strings.HasPrefix(*s.status, "Up") {
+		steps = append(steps, &step.StopContainer{
+			ContainerId: s.containerId,
+			ExecOptions: s.dingoadm.ExecOptions(),
+		})
+	}
+	steps = append(steps, &step.RemoveContainer{
+		ContainerId: s.containerId,
+		ExecOptions: s.dingoadm.ExecOptions(),
+	})
+
+	for _, step := range steps {
+		err := step.Execute(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteGatewayInfo removes the gateway record, so monitor stops scraping it
+func deleteGatewayInfo(dingoadm *cli.DingoAdm, name string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		err := dingoadm.Storage().DeleteGateway(name)
+		if err != nil {
+			return errno.ERR_DELETE_GATEWAY_FAILED.E(err)
+		}
+		return nil
+	}
+}
+
+func NewStopGatewayTask(dingoadm *cli.DingoAdm, v interface{}) (*task.Task, error) {
+	info := v.(configure.GatewayInfo)
+	hc, err := dingoadm.GetHost(info.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s name=%s mountPoint=%s", info.Host, info.Name, info.MountPoint)
+	t := task.NewTask("Stop S3 Gateway Service", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var status string
+	t.AddStep(&step.ListContainers{
+		ShowAll:     true,
+		Format:      "'{{.Status}}'",
+		Filter:      fmt.Sprintf("id=%s", info.ContainerId),
+		Out:         &status,
+		ExecOptions: dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step2RemoveGatewayContainer{
+		status:      &status,
+		containerId: info.ContainerId,
+		dingoadm:    dingoadm,
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: deleteGatewayInfo(dingoadm, info.Name),
+	})
+
+	return t, nil
+}
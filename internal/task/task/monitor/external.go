@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package monitor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/scripts"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+)
+
+const (
+	GRAFANA_API_DATASOURCE = "%s/api/datasources/name/%s"
+	GRAFANA_API_DASHBOARD  = "%s/api/dashboards/db"
+	GRAFANA_API_TIMEOUT    = 10 * time.Second
+	PROMETHEUS_UID_HOLDER  = "${PROMETHEUS_UID}"
+)
+
+// NewPublishTargetTask writes targets into file_sd directory of external prometheus
+func NewPublishTargetTask(dingoadm *cli.DingoAdm, cfg *configure.MonitorConfig) (*task.Task, error) {
+	if cfg.GetRole() != configure.ROLE_EXTERNAL_PROMETHEUS ||
+		cfg.GetSDType() != configure.SD_TYPE_FILE {
+		return nil, nil
+	}
+	hc, err := dingoadm.GetHost(cfg.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	target, err := configure.ParseExternalTarget(dingoadm, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	sdFile := cfg.GetSDFile(dingoadm.ClusterName())
+	subname := fmt.Sprintf("host=%s file=%s", cfg.GetHost(), sdFile)
+	t := task.NewTask("Publish Prometheus Target", subname, hc.GetSSHConfig())
+
+	// add step to task
+	t.AddStep(&step.CreateDirectory{
+		Paths:       []string{filepath.Dir(sdFile)},
+		ExecOptions: dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step.InstallFile{
+		HostDestPath: sdFile,
+		Content:      &target,
+		ExecOptions:  dingoadm.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+func grafanaRequest(cfg *configure.MonitorConfig, method, u string, body []byte) ([]byte, int, error) {
+	req, err := http.NewRequest(method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.GetGrafanaToken())
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Timeout: GRAFANA_API_TIMEOUT}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	return data, resp.StatusCode, err
+}
+
+func getDatasourceUid(cfg *configure.MonitorConfig) (string, error) {
+	baseURL := strings.TrimSuffix(cfg.GetGrafanaURL(), "/")
+	u := fmt.Sprintf(GRAFANA_API_DATASOURCE, baseURL, url.PathEscape(cfg.GetGrafanaDatasource()))
+	data, code, err := grafanaRequest(cfg, http.MethodGet, u, nil)
+	if err != nil {
+		return "", errno.ERR_GET_GRAFANA_DATASOURCE_FAILED.E(err)
+	} else if code != http.StatusOK {
+		return "", errno.ERR_GET_GRAFANA_DATASOURCE_FAILED.
+			F("datasource: %s, status: %d, response: %s", cfg.GetGrafanaDatasource(), code, data)
+	}
+
+	datasource := struct {
+		Uid string `json:"uid"`
+	}{}
+	if err := json.Unmarshal(data, &datasource); err != nil {
+		return "", errno.ERR_GET_GRAFANA_DATASOURCE_FAILED.E(err)
+	}
+	return datasource.Uid, nil
+}
+
+func provisionDashboard(cfg *configure.MonitorConfig, content string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		uid, err := getDatasourceUid(cfg)
+		if err != nil {
+			return err
+		}
+
+		// NOTE: id must be null for importing, dashboard is identified by its uid
+		dashboard := map[string]interface{}{}
+		content = strings.ReplaceAll(content, PROMETHEUS_UID_HOLDER, uid)
+		if err := json.Unmarshal([]byte(content), &dashboard); err != nil {
+			return errno.ERR_PROVISION_GRAFANA_DASHBOARD_FAILED.E(err)
+		}
+		delete(dashboard, "id")
+		body, err := json.Marshal(map[string]interface{}{
+			"dashboard": dashboard,
+			"overwrite": true,
+			"message":   "provisioned by dingoadm",
+		})
+		if err != nil {
+			return errno.ERR_PROVISION_GRAFANA_DASHBOARD_FAILED.E(err)
+		}
+
+		u := fmt.Sprintf(GRAFANA_API_DASHBOARD, strings.TrimSuffix(cfg.GetGrafanaURL(), "/"))
+		data, code, err := grafanaRequest(cfg, http.MethodPost, u, body)
+		if err != nil {
+			return errno.ERR_PROVISION_GRAFANA_DASHBOARD_FAILED.E(err)
+		} else if code != http.StatusOK {
+			return errno.ERR_PROVISION_GRAFANA_DASHBOARD_FAILED.
+				F("status: %d, response: %s", code, data)
+		}
+		return nil
+	}
+}
+
+// NewProvisionDashboardTask imports bundled dashboards into external grafana by its HTTP API
+func NewProvisionDashboardTask(dingoadm *cli.DingoAdm, cfg *configure.MonitorConfig) (*task.Task, error) {
+	if cfg.GetRole() != configure.ROLE_EXTERNAL_GRAFANA {
+		return nil, nil
+	}
+
+	// new task
+	subname := fmt.Sprintf("url=%s datasource=%s", cfg.GetGrafanaURL(), cfg.GetGrafanaDatasource())
+	t := task.NewTask("Provision Grafana Dashboard", subname, nil)
+
+	// add step to task
+	t.AddStep(&step.Lambda{
+		Lambda: provisionDashboard(cfg, scripts.GRAFANA_SERVER_METRIC),
+	})
+
+	return t, nil
+}
@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package monitor
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingoadm/cli/cli"
+	"github.com/dingodb/dingoadm/internal/configure"
+	"github.com/dingodb/dingoadm/internal/task/scripts"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	"github.com/dingodb/dingoadm/internal/task/task/common"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+)
+
+const (
+	CLIENT_TARGET_FILE = "client_target.json"
+	SERVER_TARGET_FILE = "server_target.json"
+	ALERT_RULES_FILE   = "dingo_alerts.yml"
+
+	// prometheus reloads prometheus.yml and rule files on SIGHUP
+	COMMAND_RELOAD_PROMETHEUS = "kill -HUP 1"
+)
+
+// NewSyncClientTargetTask writes clients and gateways recorded in storage into
+// file_sd target of prometheus, which picked up by prometheus without restart
+func NewSyncClientTargetTask(dingoadm *cli.DingoAdm, cfg *configure.MonitorConfig) (*task.Task, error) {
+	role := cfg.GetRole()
+	if role != ROLE_PROMETHEUS {
+		return nil, nil
+	}
+	serviceId := dingoadm.GetServiceId(cfg.GetId())
+	containerId, err := dingoadm.GetContainerId(serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	host := cfg.GetHost()
+	hc, err := dingoadm.GetHost(host)
+	if err != nil {
+		return nil, err
+	}
+	target, err := configure.ParseClientTarget(dingoadm)
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		host, role, tui.TrimContainerId(containerId))
+	t := task.NewTask("Sync Client Target", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var out string
+	confDir := cfg.GetConfDir()
+	t.AddStep(&step.InstallFile{
+		HostDestPath: fmt.Sprintf("%s/%s", confDir, CLIENT_TARGET_FILE),
+		Content:      &target,
+		ExecOptions:  dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step.InstallFile{
+		HostDestPath: fmt.Sprintf("%s/sync_client_target.sh", confDir),
+		Content:      &scripts.SYNC_CLIENT_TARGET,
+		ExecOptions:  dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step.Command{
+		Command: fmt.Sprintf("bash %s/sync_client_target.sh %s/prometheus.yml %s",
+			confDir, confDir, CLIENT_TARGET_FILE),
+		Out:         &out,
+		ExecOptions: dingoadm.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+// NewSyncServerTargetTask writes services in topology into file_sd target of prometheus
+// with hosts in maintenance labeled, and installs alert rules which exclude them
+func NewSyncServerTargetTask(dingoadm *cli.DingoAdm, cfg *configure.MonitorConfig) (*task.Task, error) {
+	role := cfg.GetRole()
+	if role != ROLE_PROMETHEUS {
+		return nil, nil
+	}
+	serviceId := dingoadm.GetServiceId(cfg.GetId())
+	containerId, err := dingoadm.GetContainerId(serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	host := cfg.GetHost()
+	hc, err := dingoadm.GetHost(host)
+	if err != nil {
+		return nil, err
+	}
+	target, err := configure.ParseServerTarget(dingoadm)
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		host, role, tui.TrimContainerId(containerId))
+	t := task.NewTask("Sync Server Target", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var out string
+	confDir := cfg.GetConfDir()
+	t.AddStep(&step.InstallFile{
+		HostDestPath: fmt.Sprintf("%s/%s", confDir, SERVER_TARGET_FILE),
+		Content:      &target,
+		ExecOptions:  dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step.InstallFile{
+		HostDestPath: fmt.Sprintf("%s/%s", confDir, ALERT_RULES_FILE),
+		Content:      &scripts.ALERT_RULES,
+		ExecOptions:  dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step.InstallFile{
+		HostDestPath: fmt.Sprintf("%s/sync_server_target.sh", confDir),
+		Content:      &scripts.SYNC_SERVER_TARGET,
+		ExecOptions:  dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step.Command{
+		Command: fmt.Sprintf("bash %s/sync_server_target.sh %s/prometheus.yml %s %s",
+			confDir, confDir, SERVER_TARGET_FILE, ALERT_RULES_FILE),
+		Out:         &out,
+		ExecOptions: dingoadm.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+// NewReloadPrometheusTask makes prometheus reload prometheus.yml and alert rules
+// changed by target sync, which file_sd never picks up
+func NewReloadPrometheusTask(dingoadm *cli.DingoAdm, cfg *configure.MonitorConfig) (*task.Task, error) {
+	role := cfg.GetRole()
+	if role != ROLE_PROMETHEUS {
+		return nil, nil
+	}
+	serviceId := dingoadm.GetServiceId(cfg.GetId())
+	containerId, err := dingoadm.GetContainerId(serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	host := cfg.GetHost()
+	hc, err := dingoadm.GetHost(host)
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		host, role, tui.TrimContainerId(containerId))
+	t := task.NewTask("Reload Prometheus Config", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var out string
+	t.AddStep(&step.ListContainers{
+		ShowAll:     true,
+		Format:      `"{{.ID}}"`,
+		Filter:      fmt.Sprintf("id=%s", containerId),
+		Out:         &out,
+		ExecOptions: dingoadm.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: common.CheckContainerExist(host, role, containerId, &out),
+	})
+	t.AddStep(&step.ContainerExec{
+		ContainerId: &containerId,
+		Command:     COMMAND_RELOAD_PROMETHEUS,
+		Out:         &out,
+		ExecOptions: dingoadm.ExecOptions(),
+	})
+
+	return t, nil
+}
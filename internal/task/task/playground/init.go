@@ -99,7 +99,7 @@ func checkContainerExist(name string, out *string) step.LambdaType {
 }
 
 func prepare(dcs []*topology.DeployConfig, poolset configure.Poolset) (string, error) {
-	pool, err := configure.GenerateDefaultClusterPool(dcs, poolset)
+	pool, err := configure.GenerateDefaultClusterPool(dcs, poolset, nil)
 	if err != nil {
 		return "", err
 	}
@@ -30,6 +30,8 @@ package task
 
 import (
 	"errors"
+	"reflect"
+	"sync/atomic"
 
 	"github.com/dingodb/dingoadm/internal/errno"
 	"github.com/dingodb/dingoadm/internal/task/context"
@@ -47,6 +49,12 @@ type (
 		Execute(ctx *context.Context) error
 	}
 
+	// NamedStep is the step which names itself, e.g. lambda step
+	// named by its function, the step named by its type otherwise
+	NamedStep interface {
+		Name() string
+	}
+
 	Task struct {
 		tid       string // task id
 		ptid      string // parent task id
@@ -56,6 +64,8 @@ type (
 		postSteps []Step
 		sshConfig *module.SSHConfig
 		context   context.Context
+		progress  atomic.Value // progress reported by step, e.g. "3/10"
+		failed    string       // name of the step which failed in last execution
 	}
 )
 
@@ -98,6 +108,35 @@ func (t *Task) SetSubname(name string) {
 	t.subname = name
 }
 
+// SetProgress reports the progress of long running step, which shown in progress bar
+func (t *Task) SetProgress(progress string) {
+	t.progress.Store(progress)
+}
+
+func (t *Task) Progress() string {
+	v := t.progress.Load()
+	if v == nil {
+		return ""
+	}
+	return v.(string)
+}
+
+// FailedStep returns name of the step which failed in last execution
+func (t *Task) FailedStep() string {
+	return t.failed
+}
+
+func stepName(step Step) string {
+	if s, ok := step.(NamedStep); ok {
+		return s.Name()
+	}
+	typ := reflect.TypeOf(step)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Name()
+}
+
 func (t *Task) AddStep(step Step) {
 	t.steps = append(t.steps, step)
 }
@@ -116,10 +155,12 @@ func (t *Task) executePost(ctx *context.Context) {
 }
 
 func (t *Task) Execute() error {
+	t.failed = ""
 	var sshClient *module.SSHClient
 	if t.sshConfig != nil {
 		client, err := module.NewSSHClient(*t.sshConfig)
 		if err != nil {
+			t.failed = "SSHConnect"
 			return errno.ERR_SSH_CONNECT_FAILED.E(err)
 		}
 		sshClient = client
@@ -137,6 +178,7 @@ func (t *Task) Execute() error {
 		if err == ERR_TASK_DONE || err == ERR_SKIP_TASK {
 			break
 		} else if err != nil {
+			t.failed = stepName(step)
 			return err
 		}
 	}
@@ -0,0 +1,47 @@
+package task_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dingodb/dingoadm/internal/task/context"
+	"github.com/dingodb/dingoadm/internal/task/step"
+	"github.com/dingodb/dingoadm/internal/task/task"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStep struct {
+	err error
+}
+
+func (s *fakeStep) Execute(ctx *context.Context) error {
+	return s.err
+}
+
+func checkHealthy(healthy bool) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if !healthy {
+			return errors.New("unhealthy")
+		}
+		return nil
+	}
+}
+
+func TestTaskFailedStep(t *testing.T) {
+	assert := assert.New(t)
+
+	tsk := task.NewTask("Start Service", "", nil)
+	tsk.AddStep(&fakeStep{})
+	assert.Nil(tsk.Execute())
+	assert.Equal("", tsk.FailedStep())
+
+	tsk.AddStep(&fakeStep{err: errors.New("failed")})
+	assert.NotNil(tsk.Execute())
+	assert.Equal("fakeStep", tsk.FailedStep())
+
+	tsk = task.NewTask("Start Service", "", nil)
+	tsk.AddStep(&step.Lambda{Lambda: checkHealthy(true)})
+	tsk.AddStep(&step.Lambda{Lambda: checkHealthy(false)})
+	assert.NotNil(tsk.Execute())
+	assert.Equal("task_test.checkHealthy", tsk.FailedStep())
+}
@@ -32,20 +32,34 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dingodb/dingoadm/internal/task/task"
 	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	log "github.com/dingodb/dingoadm/pkg/log/glg"
 	"github.com/fatih/color"
 	"github.com/vbauerster/mpb/v7"
 	"github.com/vbauerster/mpb/v7/decor"
 )
 
+const (
+	MAX_RETRY_BACKOFF = time.Minute
+)
+
 type (
 	ExecOptions struct {
 		Concurrency   uint
 		SilentMainBar bool
 		SilentSubBar  bool
 		SkipError     bool
+		Retries       int           // retry the failed task at most N times
+		RetryBackoff  time.Duration // wait before the first retry, doubled after each retry
+	}
+
+	// Failure is the task which failed after all retries
+	Failure struct {
+		Task *task.Task
+		Err  error
 	}
 
 	Tasks struct {
@@ -55,6 +69,7 @@ type (
 		progress *mpb.Progress
 		mainBar  *mpb.Bar
 		subBar   map[string]*mpb.Bar
+		failures []Failure
 		sync.Mutex
 	}
 )
@@ -68,6 +83,7 @@ func NewTasks() *Tasks {
 		progress: mpb.New(mpb.WithWaitGroup(&wg)),
 		mainBar:  nil,
 		subBar:   map[string]*mpb.Bar{},
+		failures: []Failure{},
 	}
 }
 
@@ -135,6 +151,15 @@ func (ts *Tasks) displayInstances(t *task.Task) func(static decor.Statistics) st
 	}
 }
 
+func (ts *Tasks) displayProgress(t *task.Task) func(static decor.Statistics) string {
+	return func(static decor.Statistics) string {
+		if static.Completed || len(t.Progress()) == 0 {
+			return ""
+		}
+		return t.Progress() + " "
+	}
+}
+
 func (ts *Tasks) addMainBar() {
 	ts.mainBar = ts.progress.Add(1, nil,
 		mpb.PrependDecorators(
@@ -157,6 +182,7 @@ func (ts *Tasks) addSubBar(t *task.Task) {
 			decor.Name(t.Subname()+" "),
 			decor.Any(ts.displayInstances(t), decor.WCSyncWidthR),
 			decor.Name(" "),
+			decor.Any(ts.displayProgress(t)),
 			decor.OnComplete(decor.Spinner([]string{}), ""),
 			decor.Any(ts.displayStatus()),
 		),
@@ -176,6 +202,40 @@ func (ts *Tasks) initOptions(options ExecOptions) ExecOptions {
 	return options
 }
 
+// executeTask executes the task, and retries it with exponential backoff if failed
+func (ts *Tasks) executeTask(t *task.Task, options ExecOptions) error {
+	err := t.Execute()
+	backoff := options.RetryBackoff
+	for i := 1; i <= options.Retries && err != nil; i++ {
+		t.SetProgress(fmt.Sprintf("(retry %d/%d)", i, options.Retries))
+		log.Warn("Retry task",
+			log.Field("name", t.Name()),
+			log.Field("subname", strings.TrimSpace(t.Subname())),
+			log.Field("retry", fmt.Sprintf("%d/%d", i, options.Retries)),
+			log.Field("backoff", backoff),
+			log.Field("error", err))
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > MAX_RETRY_BACKOFF {
+			backoff = MAX_RETRY_BACKOFF
+		}
+		err = t.Execute()
+	}
+	return err
+}
+
+func (ts *Tasks) addFailure(t *task.Task, err error) {
+	ts.Lock()
+	defer ts.Unlock()
+	ts.failures = append(ts.failures, Failure{Task: t, Err: err})
+}
+
+// Failures returns the failed tasks in the order they failed
+func (ts *Tasks) Failures() []Failure {
+	ts.Lock()
+	defer ts.Unlock()
+	return ts.failures
+}
+
 func (ts *Tasks) setMainBarStatus() {
 	ts.Lock()
 	defer ts.Unlock()
@@ -249,8 +309,11 @@ func (ts *Tasks) Execute(options ExecOptions) error {
 			if bar != nil {
 				id = bar.ID()
 			}
-			err := t.Execute()
+			err := ts.executeTask(t, options)
 			ts.monitor.set(id, err)
+			if err != nil && err != task.ERR_SKIP_TASK {
+				ts.addFailure(t, err)
+			}
 		}(t)
 	}
 
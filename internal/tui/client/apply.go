@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package service
+
+import (
+	"github.com/dingodb/dingoadm/internal/task/task/fs"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+)
+
+const (
+	CLIENT_APPLY_RESULT_DONE   = "done"
+	CLIENT_APPLY_RESULT_FAILED = "failed"
+)
+
+func clientActionDecorate(action string) string {
+	switch action {
+	case fs.CLIENT_ACTION_MOUNT:
+		return color.GreenString(action)
+	case fs.CLIENT_ACTION_UMOUNT:
+		return color.RedString(action)
+	case fs.CLIENT_ACTION_REMOUNT:
+		return color.YellowString(action)
+	}
+	return action
+}
+
+func clientResultDecorate(result string) string {
+	if result == CLIENT_APPLY_RESULT_FAILED {
+		return color.RedString(result)
+	}
+	return color.GreenString(result)
+}
+
+// FormatClientChanges lists actions of client apply,
+// the result column is shown only if results (key: host:mountpoint) is not nil
+func FormatClientChanges(changes []fs.ClientChange, results map[string]string) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Action",
+		"Id",
+		"Host",
+		"FS Name",
+		"Mount Point",
+		"Persistent",
+		"Reason",
+	}
+	if results != nil {
+		title = append(title, "Result")
+	}
+	first, second := tui.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, change := range changes {
+		line := []interface{}{
+			tui.DecorateMessage{Message: change.Action, Decorate: clientActionDecorate},
+			utils.Choose(len(change.Id) > 0, change.Id, "-"),
+			change.Host,
+			change.FSName,
+			change.MountPoint,
+			utils.Choose(change.Persistent, "yes", "no"),
+			utils.Choose(len(change.Reason) > 0, change.Reason, "-"),
+		}
+		if results != nil {
+			result := utils.Choose(len(results[change.Key()]) > 0, results[change.Key()], "-")
+			line = append(line, tui.DecorateMessage{Message: result, Decorate: clientResultDecorate})
+		}
+		lines = append(lines, line)
+	}
+
+	return tui.FixedFormat(lines, 2)
+}
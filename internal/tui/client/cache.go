@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dustin/go-humanize"
+)
+
+func formatCacheSize(size int64) string {
+	if size < 0 {
+		return "-"
+	}
+	return humanize.IBytes(uint64(size))
+}
+
+func formatHitRatio(ratio float64) string {
+	if ratio < 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f%%", ratio*100)
+}
+
+// FormatCacheStatus lists cache directories of dingofs clients, one line for each directory
+func FormatCacheStatus(statuses []task.ClientCacheStatus) string {
+	sort.Slice(statuses, func(i, j int) bool {
+		s1, s2 := statuses[i], statuses[j]
+		if s1.Host != s2.Host {
+			return s1.Host < s2.Host
+		}
+		return s1.MountPoint < s2.MountPoint
+	})
+
+	lines := [][]interface{}{}
+	title := []string{
+		"Id",
+		"Host",
+		"FS Name",
+		"Mount Point",
+		"Container",
+		"Cache Dir",
+		"Host Path",
+		"Size",
+		"Free / Total",
+		"Hit Ratio",
+		"Warmup",
+		"Error",
+	}
+	first, second := tui.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, status := range statuses {
+		dirs := status.Dirs
+		if len(dirs) == 0 {
+			dirs = []task.CacheDirStatus{{Dir: "-"}}
+		}
+		for _, dir := range dirs {
+			lines = append(lines, []interface{}{
+				status.Id,
+				status.Host,
+				status.FSName,
+				status.MountPoint,
+				utils.Choose(len(status.Container) > 0, status.Container, "-"),
+				dir.Dir,
+				utils.Choose(len(dir.HostPath) > 0, dir.HostPath, "-"),
+				utils.Choose(len(dir.HostPath) > 0, formatCacheSize(dir.Size), "-"),
+				utils.Choose(len(dir.HostPath) > 0 && dir.Avail >= 0,
+					formatCacheSize(dir.Avail)+" / "+formatCacheSize(dir.Total), "-"),
+				formatHitRatio(status.HitRatio),
+				utils.Choose(len(status.Warmup) > 0, strings.Join(status.Warmup, ","), "-"),
+				utils.Choose(len(status.Error) > 0, status.Error, "-"),
+			})
+		}
+	}
+
+	return tui.FixedFormat(lines, 2)
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package service
+
+import (
+	"sort"
+	"time"
+
+	task "github.com/dingodb/dingoadm/internal/task/task/common"
+	tui "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+)
+
+func mountStateDecorate(state string) string {
+	switch state {
+	case task.CLIENT_MOUNT_STATE_MOUNTED:
+		return color.GreenString(state)
+	case task.CLIENT_MOUNT_STATE_NOT_MOUNTED, task.CLIENT_MOUNT_STATE_ABSENT:
+		return color.YellowString(state)
+	}
+	return color.RedString(state)
+}
+
+// FormatMountStatus lists mount points of dingofs clients, sorted by host and mount point
+func FormatMountStatus(statuses []task.ClientMountStatus) string {
+	sort.Slice(statuses, func(i, j int) bool {
+		s1, s2 := statuses[i], statuses[j]
+		if s1.Host != s2.Host {
+			return s1.Host < s2.Host
+		}
+		return s1.MountPoint < s2.MountPoint
+	})
+
+	lines := [][]interface{}{}
+	title := []string{
+		"Id",
+		"Host",
+		"FS Name",
+		"Mount Point",
+		"Container Id",
+		"Container",
+		"Mount State",
+		"Persistent",
+		"Remounted",
+		"Error",
+	}
+	first, second := tui.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, status := range statuses {
+		lines = append(lines, []interface{}{
+			status.Id,
+			status.Host,
+			status.FSName,
+			status.MountPoint,
+			tui.TrimContainerId(status.ContainerId),
+			utils.Choose(len(status.Container) > 0, status.Container, "-"),
+			tui.DecorateMessage{
+				Message:  utils.Choose(len(status.State) > 0, status.State, "unknown"),
+				Decorate: mountStateDecorate,
+			},
+			utils.Choose(status.Persistent, "yes", "no"),
+			utils.Choose(status.Remounted, "yes", "no"),
+			utils.Choose(len(status.Error) > 0, status.Error, "-"),
+		})
+	}
+
+	return tui.FixedFormat(lines, 2)
+}
+
+// FormatReloadStatus lists dingofs clients which reloaded config and their downtime
+func FormatReloadStatus(statuses []task.ClientMountStatus) string {
+	sort.Slice(statuses, func(i, j int) bool {
+		s1, s2 := statuses[i], statuses[j]
+		if s1.Host != s2.Host {
+			return s1.Host < s2.Host
+		}
+		return s1.MountPoint < s2.MountPoint
+	})
+
+	lines := [][]interface{}{}
+	title := []string{
+		"Id",
+		"Host",
+		"FS Name",
+		"Mount Point",
+		"Mount State",
+		"Reloaded",
+		"Downtime",
+		"Error",
+	}
+	first, second := tui.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, status := range statuses {
+		lines = append(lines, []interface{}{
+			status.Id,
+			status.Host,
+			status.FSName,
+			status.MountPoint,
+			tui.DecorateMessage{
+				Message:  utils.Choose(len(status.State) > 0, status.State, "unknown"),
+				Decorate: mountStateDecorate,
+			},
+			utils.Choose(status.Remounted, "yes", "no"),
+			utils.Choose(status.Remounted, status.Downtime.Round(time.Millisecond).String(), "-"),
+			utils.Choose(len(status.Error) > 0, status.Error, "-"),
+		})
+	}
+
+	return tui.FixedFormat(lines, 2)
+}
@@ -45,11 +45,17 @@ const (
   - Service id: {{.id}} ("*" means all id)
   - Service role: {{.role}} ("*" means all roles)
   - Service host: {{.host}} ("*" means all hosts)
+{{- if .labels}}
+  - Host labels: {{.labels}}
+{{- end}}
 `
 
 	PROMPT_CLEAN_SERVICE = `{{.warning}}
   - Service role: {{.role}} ("*" means all roles)
   - Service host: {{.host}} ("*" means all hosts)
+{{- if .labels}}
+  - Host labels: {{.labels}}
+{{- end}}
   - Clean items : [{{.items}}]
 `
 
@@ -153,56 +159,118 @@ func PromptMigrate() string {
 	return prompt.Build()
 }
 
-func PromptStartService(id, role, host string) string {
+// only show host labels in prompt when specified
+func setLabels(prompt *Prompt, labels string) {
+	if len(labels) > 0 && labels != "*" {
+		prompt.data["labels"] = labels
+	}
+}
+
+func PromptStartService(id, role, host, labels string) string {
 	prompt := NewPrompt(color.YellowString(PROMPT_COMMON_WARNING) + DEFAULT_CONFIRM_PROMPT)
 	prompt.data["warning"] = "WARNING: service items which matched will start"
 	prompt.data["id"] = id
 	prompt.data["role"] = role
 	prompt.data["host"] = host
+	setLabels(prompt, labels)
 	return prompt.Build()
 }
 
-func PromptStopService(id, role, host string) string {
+func PromptStopService(id, role, host, labels string) string {
 	prompt := NewPrompt(color.YellowString(PROMPT_COMMON_WARNING) + DEFAULT_CONFIRM_PROMPT)
 	prompt.data["warning"] = "WARNING: stop service may cause client IO be hang"
 	prompt.data["id"] = id
 	prompt.data["role"] = role
 	prompt.data["host"] = host
+	setLabels(prompt, labels)
+	return prompt.Build()
+}
+
+func PromptEnterMaintenance(host string) string {
+	prompt := NewPrompt(color.YellowString(PROMPT_WARNING) + DEFAULT_CONFIRM_PROMPT)
+	prompt.data["warning"] = fmt.Sprintf("WARNING: all services on host '%s' will be stopped,\n"+
+		"and region leaders on it will be transferred to other stores", host)
+	return prompt.Build()
+}
+
+// PromptMaintenanceHosts warns the operation which targets services on hosts in maintenance
+func PromptMaintenanceHosts(hosts []string) string {
+	prompt := NewPrompt(color.YellowString(PROMPT_WARNING))
+	prompt.data["warning"] = fmt.Sprintf("WARNING: host [%s] in maintenance, "+
+		"exit maintenance by 'dingoadm maintenance exit' instead", strings.Join(hosts, ", "))
+	return prompt.Build()
+}
+
+func PromptDeleteFS(fsname string) string {
+	prompt := NewPrompt(color.YellowString(PROMPT_WARNING) + DEFAULT_CONFIRM_PROMPT)
+	prompt.data["warning"] = fmt.Sprintf("WARNING: filesystem '%s' and all data in it will be deleted", fsname)
+	return prompt.Build()
+}
+
+func PromptRemountClient(id string) string {
+	prompt := NewPrompt(color.YellowString(PROMPT_WARNING) + DEFAULT_CONFIRM_PROMPT)
+	prompt.data["warning"] = fmt.Sprintf("WARNING: client '%s' is healthy, "+
+		"remount it will interrupt the applications which using the mount point", id)
+	return prompt.Build()
+}
+
+func PromptApplyClients(mount, umount, remount int) string {
+	prompt := NewPrompt(color.YellowString(PROMPT_WARNING) + DEFAULT_CONFIRM_PROMPT)
+	prompt.data["warning"] = fmt.Sprintf("WARNING: %d client(s) will be mounted, %d umounted "+
+		"and %d remounted, umount or remount interrupts the applications which using the mount point",
+		mount, umount, remount)
+	return prompt.Build()
+}
+
+func PromptReloadClients(count int) string {
+	prompt := NewPrompt(color.YellowString(PROMPT_WARNING) + DEFAULT_CONFIRM_PROMPT)
+	prompt.data["warning"] = fmt.Sprintf("WARNING: %d client(s) will be remounted to reload config "+
+		"once no application using the mount point, the mount point is unavailable while remounting", count)
+	return prompt.Build()
+}
+
+func PromptCleanClientCache(count int) string {
+	prompt := NewPrompt(color.YellowString(PROMPT_WARNING) + DEFAULT_CONFIRM_PROMPT)
+	prompt.data["warning"] = fmt.Sprintf("WARNING: all cached data of %d client(s) will be deleted", count)
 	return prompt.Build()
 }
 
-func PromptRestartService(id, role, host string) string {
+func PromptRestartService(id, role, host, labels string) string {
 	prompt := NewPrompt(color.YellowString(PROMPT_COMMON_WARNING) + DEFAULT_CONFIRM_PROMPT)
 	prompt.data["warning"] = "WARNING: service items which matched will restart"
 	prompt.data["id"] = id
 	prompt.data["role"] = role
 	prompt.data["host"] = host
+	setLabels(prompt, labels)
 	return prompt.Build()
 }
 
-func PromptUpgradeService(id, role, host string) string {
+func PromptUpgradeService(id, role, host, labels string) string {
 	prompt := NewPrompt(color.YellowString(PROMPT_COMMON_WARNING) + DEFAULT_CONFIRM_PROMPT)
 	prompt.data["warning"] = "WARNING: service items which matched will upgrade"
 	prompt.data["id"] = id
 	prompt.data["role"] = role
 	prompt.data["host"] = host
+	setLabels(prompt, labels)
 	return prompt.Build()
 }
 
-func PromptReloadService(id, role, host string) string {
+func PromptReloadService(id, role, host, labels string) string {
 	prompt := NewPrompt(color.YellowString(PROMPT_COMMON_WARNING) + DEFAULT_CONFIRM_PROMPT)
 	prompt.data["warning"] = "WARNING: service items which matched will reload"
 	prompt.data["id"] = id
 	prompt.data["role"] = role
 	prompt.data["host"] = host
+	setLabels(prompt, labels)
 	return prompt.Build()
 }
 
-func PromptCleanService(role, host string, items []string) string {
+func PromptCleanService(role, host, labels string, items []string) string {
 	prompt := NewPrompt(color.YellowString(PROMPT_CLEAN_SERVICE) + DEFAULT_CONFIRM_PROMPT)
 	prompt.data["warning"] = "WARNING: service items which matched will be cleaned up"
 	prompt.data["role"] = role
 	prompt.data["host"] = host
+	setLabels(prompt, labels)
 	prompt.data["items"] = strings.Join(items, ",")
 	return prompt.Build()
 }
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package tui
+
+import (
+	"strconv"
+
+	"github.com/dingodb/dingoadm/internal/storage"
+	tuicommon "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/fatih/color"
+)
+
+const (
+	MIGRATION_STATUS_APPLIED = "applied"
+	MIGRATION_STATUS_PENDING = "pending"
+)
+
+func migrationStatusDecorate(message string) string {
+	if message == MIGRATION_STATUS_APPLIED {
+		return color.GreenString(message)
+	}
+	return color.YellowString(message)
+}
+
+// FormatSchemaStatus lists applied and pending database migrations ordered by version
+func FormatSchemaStatus(status storage.SchemaStatus) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Version",
+		"Description",
+		"Status",
+		"Applied Time",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, m := range status.Applied {
+		lines = append(lines, []interface{}{
+			strconv.Itoa(m.Version),
+			m.Description,
+			tuicommon.DecorateMessage{Message: MIGRATION_STATUS_APPLIED, Decorate: migrationStatusDecorate},
+			m.AppliedTime.Format("2006-01-02 15:04:05"),
+		})
+	}
+	for _, m := range status.Pending {
+		lines = append(lines, []interface{}{
+			strconv.Itoa(m.Version),
+			m.Description,
+			tuicommon.DecorateMessage{Message: MIGRATION_STATUS_PENDING, Decorate: migrationStatusDecorate},
+			"-",
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package tui
+
+import (
+	"sort"
+
+	"github.com/dingodb/dingoadm/internal/task/task/common"
+	tuicommon "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/fatih/color"
+)
+
+func formatDiskState(status common.DiskStatus) interface{} {
+	switch {
+	case len(status.Error) > 0:
+		return color.RedString(status.State)
+	case status.State == common.DISK_STATE_MOUNTED && status.InFSTab:
+		return color.GreenString(status.State)
+	}
+	return color.YellowString(utils.Choose(len(status.State) > 0, status.State, "unknown"))
+}
+
+func formatBytes(size uint64) string {
+	if size == 0 {
+		return "-"
+	}
+	return humanize.IBytes(size)
+}
+
+// FormatDiskStatus lists disks of service directories, sorted by host and service id
+func FormatDiskStatus(statuses []common.DiskStatus) string {
+	sort.Slice(statuses, func(i, j int) bool {
+		s1, s2 := statuses[i], statuses[j]
+		if s1.Host != s2.Host {
+			return s1.Host < s2.Host
+		} else if s1.Id != s2.Id {
+			return s1.Id < s2.Id
+		}
+		return s1.Dir < s2.Dir
+	})
+
+	lines := [][]interface{}{}
+	title := []string{
+		"Id",
+		"Role",
+		"Host",
+		"Dir",
+		"Device",
+		"Mount Point",
+		"FS Type",
+		"Size",
+		"Avail",
+		"Use%",
+		"FSTab",
+		"State",
+		"Error",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, status := range statuses {
+		lines = append(lines, []interface{}{
+			status.Id,
+			status.Role,
+			status.Host,
+			status.Dir,
+			status.Device,
+			status.MountPoint,
+			utils.Choose(len(status.FSType) > 0, status.FSType, "-"),
+			formatBytes(status.Size),
+			formatBytes(status.Avail),
+			utils.Choose(len(status.UsedPercent) > 0, status.UsedPercent, "-"),
+			utils.Choose(status.InFSTab, "yes", "no"),
+			formatDiskState(status),
+			utils.Choose(len(status.Error) > 0, status.Error, "-"),
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingoadm/internal/task/task/common"
+	tuicommon "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+)
+
+// FormatExecResult shows output of command which executed in one service
+func FormatExecResult(result common.ExecResult) string {
+	status := color.GreenString("SUCCESS")
+	if len(result.Error) > 0 {
+		status = color.RedString("FAIL")
+	} else if result.ExitCode != 0 {
+		status = color.RedString("EXIT %d", result.ExitCode)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s %s %s [%s]\n", color.YellowString(result.Id),
+		result.Role, result.Host, status))
+	sb.WriteString("---\n")
+	if len(result.Error) > 0 {
+		sb.WriteString(strings.TrimSuffix(result.Error, "\n") + "\n")
+	} else if len(result.Output) > 0 {
+		sb.WriteString(result.Output + "\n")
+	}
+	return sb.String()
+}
+
+// FormatExecSummary lists services which command not exited with zero
+func FormatExecSummary(results []common.ExecResult) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Id",
+		"Role",
+		"Host",
+		"Exit Code",
+		"Error",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, result := range results {
+		if result.ExitCode == 0 && len(result.Error) == 0 {
+			continue
+		}
+		exitCode := "-"
+		if result.ExitCode != common.EXEC_EXIT_CODE_ERROR {
+			exitCode = strconv.Itoa(result.ExitCode)
+		}
+		errmsg := strings.SplitN(strings.TrimSpace(result.Error), "\n", 2)[0]
+		lines = append(lines, []interface{}{
+			result.Id,
+			result.Role,
+			result.Host,
+			exitCode,
+			utils.Choose(len(errmsg) > 0, errmsg, "-"),
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
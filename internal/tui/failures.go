@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingoadm/internal/errno"
+	"github.com/dingodb/dingoadm/internal/playbook"
+	tuicommon "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/fatih/color"
+)
+
+// the clue maybe the multi-line output of command, which should keep in one line
+func formatFailureError(err error) (interface{}, string) {
+	code, clue := color.RedString("-"), err.Error()
+	if e, ok := err.(*errno.ErrorCode); ok {
+		code, clue = color.RedString("%d", e.GetCode()), e.GetClue()
+		if len(clue) == 0 {
+			clue = e.GetDescription()
+		}
+	}
+	return code, strings.Join(strings.Fields(clue), " ")
+}
+
+// FormatFailures lists the failed tasks of playbook which executed in continue-on-error mode
+func FormatFailures(failures []playbook.Failure, logpath string) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Host",
+		"Service Id",
+		"Task",
+		"Step",
+		"Error Code",
+		"Clue",
+		"Log Path",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, failure := range failures {
+		code, clue := formatFailureError(failure.Err)
+		lines = append(lines, []interface{}{
+			failure.Host,
+			failure.ServiceId,
+			failure.Task,
+			failure.Step,
+			code,
+			clue,
+			logpath,
+		})
+	}
+
+	output := tuicommon.FixedFormat(lines, 2)
+	return fmt.Sprintf("%s\n%s", color.RedString("Failed tasks (%d):", len(failures)), output)
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dingodb/dingoadm/internal/task/task/fs"
+	tuicommon "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+)
+
+func formatQuota(used, max uint64, format func(uint64) string) string {
+	if max == 0 {
+		return fmt.Sprintf("%s / unlimited", format(used))
+	}
+	return fmt.Sprintf("%s / %s", format(used), format(max))
+}
+
+func formatUsedPercent(used, max uint64) string {
+	if max == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f%%", float64(used)*100/float64(max))
+}
+
+func formatCount(n uint64) string {
+	return fmt.Sprintf("%d", n)
+}
+
+func sortFSInfos(infos []fs.FSInfo) {
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+}
+
+// FormatFSInfos lists filesystems with their quota and mounted clients
+func FormatFSInfos(infos []fs.FSInfo, clients map[string][]fs.FSClient) string {
+	sortFSInfos(infos)
+	lines := [][]interface{}{}
+	title := []string{
+		"Id",
+		"Name",
+		"Storage",
+		"Status",
+		"Capacity",
+		"Bytes (used/quota)",
+		"Inodes (used/quota)",
+		"Clients",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, info := range infos {
+		lines = append(lines, []interface{}{
+			utils.Choose(len(info.Id) > 0, info.Id, "-"),
+			info.Name,
+			utils.Choose(len(info.StorageType) > 0, info.StorageType, "-"),
+			utils.Choose(len(info.Status) > 0, info.Status, "-"),
+			formatBytes(info.Capacity),
+			formatQuota(info.UsedBytes, info.MaxBytes, formatBytes),
+			formatQuota(info.UsedInodes, info.MaxInodes, formatCount),
+			len(clients[info.Name]),
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
+
+// FormatFSClients lists clients which mounted the filesystem
+func FormatFSClients(clients []fs.FSClient) string {
+	sort.Slice(clients, func(i, j int) bool {
+		if clients[i].Host != clients[j].Host {
+			return clients[i].Host < clients[j].Host
+		}
+		return clients[i].MountPoint < clients[j].MountPoint
+	})
+
+	lines := [][]interface{}{}
+	title := []string{"Id", "Host", "Mount Point", "Container Id"}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, client := range clients {
+		lines = append(lines, []interface{}{
+			client.Id,
+			client.Host,
+			client.MountPoint,
+			tuicommon.TrimContainerId(client.ContainerId),
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
+
+// FormatFSUsage lists usage of filesystems against their quota
+func FormatFSUsage(infos []fs.FSInfo) string {
+	sortFSInfos(infos)
+	lines := [][]interface{}{}
+	title := []string{
+		"Name",
+		"Used Bytes",
+		"Max Bytes",
+		"Use%",
+		"Used Inodes",
+		"Max Inodes",
+		"IUse%",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, info := range infos {
+		lines = append(lines, []interface{}{
+			info.Name,
+			formatBytes(info.UsedBytes),
+			utils.Choose(info.MaxBytes > 0, formatBytes(info.MaxBytes), "unlimited"),
+			formatUsedPercent(info.UsedBytes, info.MaxBytes),
+			formatCount(info.UsedInodes),
+			utils.Choose(info.MaxInodes > 0, formatCount(info.MaxInodes), "unlimited"),
+			formatUsedPercent(info.UsedInodes, info.MaxInodes),
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
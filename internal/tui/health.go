@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package tui
+
+import (
+	"sort"
+
+	"github.com/dingodb/dingoadm/internal/task/task/common"
+	tuicommon "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/fatih/color"
+)
+
+var healthLevelOrder = map[string]int{
+	common.HEALTH_LEVEL_CRITICAL: 0,
+	common.HEALTH_LEVEL_WARNING:  1,
+}
+
+func formatHealthLevel(level string) interface{} {
+	if level == common.HEALTH_LEVEL_CRITICAL {
+		return color.RedString(level)
+	}
+	return color.YellowString(level)
+}
+
+// FormatHealthFindings lists findings of health check, critical first
+func FormatHealthFindings(findings []common.HealthFinding) string {
+	sort.SliceStable(findings, func(i, j int) bool {
+		f1, f2 := findings[i], findings[j]
+		if f1.Level != f2.Level {
+			return healthLevelOrder[f1.Level] < healthLevelOrder[f2.Level]
+		} else if f1.Item != f2.Item {
+			return f1.Item < f2.Item
+		}
+		return f1.Id < f2.Id
+	})
+
+	lines := [][]interface{}{}
+	title := []string{
+		"Level",
+		"Item",
+		"Id",
+		"Role",
+		"Host",
+		"Finding",
+		"Suggestion",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, finding := range findings {
+		lines = append(lines, []interface{}{
+			formatHealthLevel(finding.Level),
+			finding.Item,
+			finding.Id,
+			finding.Role,
+			finding.Host,
+			finding.Message,
+			finding.Suggestion,
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
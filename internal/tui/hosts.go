@@ -29,6 +29,7 @@
 package tui
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -36,10 +37,14 @@ import (
 	"github.com/dingodb/dingoadm/internal/tui/common"
 	tuicommon "github.com/dingodb/dingoadm/internal/tui/common"
 	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/dustin/go-humanize"
 )
 
 const (
 	FIELD_LIMIT_LENGTH = 30
+
+	BLOCK_DEVICE_TYPE_DISK = "disk"
+	FACTS_TIME_FORMAT      = "2006-01-02 15:04:05"
 )
 
 func FormatHosts(hcs []*configure.HostConfig, verbose bool) string {
@@ -92,3 +97,86 @@ func FormatHosts(hcs []*configure.HostConfig, verbose bool) string {
 
 	return common.FixedFormat(lines, 2)
 }
+
+func formatDisks(facts *configure.HostFacts, verbose bool) string {
+	disks := []string{}
+	for _, device := range facts.BlockDevices {
+		if device.Type != BLOCK_DEVICE_TYPE_DISK || device.Size == 0 {
+			continue
+		}
+		disks = append(disks, fmt.Sprintf("%s:%s", device.Name, humanize.IBytes(device.Size)))
+	}
+	if len(disks) == 0 {
+		return "-"
+	} else if !verbose {
+		return strconv.Itoa(len(disks))
+	}
+	return strings.Join(disks, ",")
+}
+
+func formatOpenPorts(facts *configure.HostFacts, verbose bool) string {
+	ports := []string{}
+	for _, port := range facts.OpenPorts {
+		ports = append(ports, strconv.Itoa(port))
+	}
+	if len(ports) == 0 {
+		return "-"
+	} else if !verbose {
+		return strconv.Itoa(len(ports))
+	}
+	return strings.Join(ports, ",")
+}
+
+func FormatHostFacts(facts []*configure.HostFacts, verbose bool) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Host",
+		"Hostname",
+		"OS",
+		"Kernel",
+		"Arch",
+		"CPU",
+		"Memory",
+		"NUMA",
+		"Disks",
+		"Docker",
+		"Time Sync",
+		"Open Ports",
+		"Gathered At",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, f := range facts {
+		os := utils.Choose(len(f.OSId) > 0, fmt.Sprintf("%s %s", f.OSId, f.OSVersion), "-")
+		if verbose && len(f.OSName) > 0 {
+			os = f.OSName
+		}
+		cpu := strconv.Itoa(f.CPUs)
+		if verbose && len(f.CPUModel) > 0 {
+			cpu = fmt.Sprintf("%d (%s)", f.CPUs, f.CPUModel)
+		}
+		timeSync := fmt.Sprintf("%s/%s",
+			utils.Choose(len(f.TimeSync.Service) > 0, f.TimeSync.Service, "-"),
+			utils.Choose(f.TimeSync.Synchronized, "Y", "N"))
+
+		lines = append(lines, []interface{}{
+			f.Host,
+			utils.Choose(len(f.Hostname) > 0, f.Hostname, "-"),
+			os,
+			f.Kernel,
+			f.Arch,
+			cpu,
+			humanize.IBytes(f.MemTotal),
+			strconv.Itoa(f.NUMANodes),
+			formatDisks(f, verbose),
+			utils.Choose(len(f.DockerVersion) > 0, f.DockerVersion, "-"),
+			timeSync,
+			formatOpenPorts(f, verbose),
+			f.GatheredAt.Local().Format(FACTS_TIME_FORMAT),
+		})
+	}
+
+	return common.FixedFormat(lines, 2)
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package tui
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingoadm/internal/configure"
+	tuicommon "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+)
+
+type placement struct {
+	pool    string
+	zone    string
+	servers []string
+}
+
+// FormatPoolPlacement shows which zone each server placed in, grouped by pool and zone
+func FormatPoolPlacement(topo configure.DingoFsClusterTopo) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Pool",
+		"Zone",
+		"Servers",
+		"Server Names",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	m := map[string]*placement{}
+	for _, server := range topo.Servers {
+		pool := utils.Choose(len(server.Pool) > 0, server.Pool, server.PhysicalPool)
+		key := pool + "/" + server.Zone
+		if _, ok := m[key]; !ok {
+			m[key] = &placement{pool: pool, zone: server.Zone}
+		}
+		m[key].servers = append(m[key].servers, server.Name)
+	}
+
+	placements := []*placement{}
+	for _, p := range m {
+		placements = append(placements, p)
+	}
+	sort.Slice(placements, func(i, j int) bool {
+		p1, p2 := placements[i], placements[j]
+		if p1.pool == p2.pool {
+			return p1.zone < p2.zone
+		}
+		return p1.pool < p2.pool
+	})
+
+	for _, p := range placements {
+		lines = append(lines, []interface{}{
+			p.pool,
+			p.zone,
+			strconv.Itoa(len(p.servers)),
+			strings.Join(p.servers, ","),
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * Project: dingoadm
+ * Created Date: 2026-10-19
+ */
+
+package tui
+
+import (
+	"strings"
+
+	"github.com/dingodb/dingoadm/internal/task/task/common"
+	tuicommon "github.com/dingodb/dingoadm/internal/tui/common"
+	"github.com/dingodb/dingoadm/internal/utils"
+	"github.com/fatih/color"
+)
+
+func decorateReloadPath(path string) string {
+	switch path {
+	case common.RELOAD_PATH_HOT:
+		return color.GreenString(path)
+	case common.RELOAD_PATH_RESTART:
+		return color.YellowString(path)
+	}
+	return path
+}
+
+// FormatReloadResults shows which path (unchanged, hot-reload or restart) each service took
+func FormatReloadResults(results []common.ReloadResult) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Id",
+		"Role",
+		"Host",
+		"Path",
+		"Changed Keys",
+		"Reason",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, result := range results {
+		keys := []string{}
+		for _, change := range result.Changes {
+			keys = append(keys, change.Key)
+		}
+		lines = append(lines, []interface{}{
+			result.Id,
+			result.Role,
+			result.Host,
+			tuicommon.DecorateMessage{Message: result.Path, Decorate: decorateReloadPath},
+			utils.Choose(len(keys) > 0, strings.Join(keys, ","), "-"),
+			utils.Choose(len(result.Reason) > 0, result.Reason, "-"),
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
@@ -236,6 +236,11 @@ func RandString(n int) string {
 	return string(b)
 }
 
+// ShellQuote quotes s as a single word for POSIX shell
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 func GetCurrentUser() string {
 	user, err := user.Current()
 	if err != nil {
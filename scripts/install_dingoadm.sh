@@ -68,6 +68,7 @@ log_level = error
 sudo_alias = "sudo"
 timeout = 300
 auto_upgrade = false
+retry_backoff = 2
 
 [ssh_connections]
 retries = 3
@@ -76,6 +77,13 @@ timeout = 10
 [database]
 url = "${g_db_path}"
 #url = "rqlite://ip:port"
+
+[step_retries]
+pull_image = 2
+
+[step_timeout]
+pull_image = 1800
+get_service_status = 30
 __EOF__
     fi
 }